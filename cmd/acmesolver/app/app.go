@@ -66,5 +66,7 @@ func NewACMESolverCommand(stopCh <-chan struct{}) *cobra.Command {
 	cmd.Flags().StringVar(&s.Token, "token", "", "the challenge token to verify against")
 	cmd.Flags().StringVar(&s.Key, "key", "", "the challenge key to respond with")
 
+	cmd.AddCommand(newSharedACMESolverCommand(stopCh))
+
 	return cmd
 }
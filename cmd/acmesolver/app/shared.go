@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/cert-manager/cert-manager/internal/cmd/util"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http/solver"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const sharedSolverResyncPeriod = 10 * time.Hour
+
+func newSharedACMESolverCommand(stopCh <-chan struct{}) *cobra.Command {
+	s := new(solver.SharedHTTP01Solver)
+
+	var (
+		apiServerHost string
+		kubeconfig    string
+		namespace     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "shared",
+		Short: "Long-running HTTP server that solves all pending HTTP01 challenges using a shared solver.",
+		Long: `Long-running HTTP server that solves all pending HTTP01 challenges using a shared solver.
+
+The shared solver watches Challenge resources and responds to requests for any
+pending HTTP01 challenge whose solver has 'http01.sharedSolver' configured.
+It requires permission to list and watch challenges.acme.cert-manager.io.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootCtx := util.ContextWithStopCh(context.Background(), stopCh)
+			rootCtx = logf.NewContext(rootCtx, logf.Log, "acmesolver")
+			log := logf.FromContext(rootCtx)
+
+			restConfig, err := clientcmd.BuildConfigFromFlags(apiServerHost, kubeconfig)
+			if err != nil {
+				return fmt.Errorf("error creating rest config: %w", err)
+			}
+			cl, err := cmclient.NewForConfig(restConfig)
+			if err != nil {
+				return fmt.Errorf("error creating cert-manager client: %w", err)
+			}
+
+			factory := cminformers.NewSharedInformerFactoryWithOptions(cl, sharedSolverResyncPeriod, cminformers.WithNamespace(namespace))
+			challengeInformer := factory.Acme().V1().Challenges().Informer()
+			if _, err := challengeInformer.AddEventHandler(s.ChallengeEventHandler()); err != nil {
+				return fmt.Errorf("error adding challenge event handler: %w", err)
+			}
+			factory.Start(stopCh)
+			if !cache.WaitForCacheSync(stopCh, challengeInformer.HasSynced) {
+				return fmt.Errorf("error waiting for challenge informer to sync")
+			}
+
+			completedCh := make(chan struct{})
+			go func() {
+				defer close(completedCh)
+				<-stopCh
+				// allow a timeout for graceful shutdown
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				if err := s.Shutdown(ctx); err != nil {
+					log.Error(err, "error shutting down acmesolver server")
+				}
+			}()
+
+			if err := s.Listen(log); err != nil {
+				return err
+			}
+
+			<-completedCh
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&s.ListenPort, "listen-port", 8089, "the port number to listen on for connections")
+	cmd.Flags().StringVar(&s.IssuerName, "issuer-name", "", "if set, only challenges for the issuer with this name will be solved")
+	cmd.Flags().StringVar(&s.IssuerKind, "issuer-kind", "", "if set, only challenges for issuers of this kind (Issuer or ClusterIssuer) will be solved")
+	cmd.Flags().StringVar(&namespace, "namespace", "", "if set, only challenges in this namespace will be solved. Defaults to all namespaces.")
+	cmd.Flags().StringVar(&apiServerHost, "master", "", "optional apiserver host address to connect to. If not specified, autoconfiguration will be attempted.")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to a kubeconfig. Only required if out-of-cluster.")
+
+	return cmd
+}
@@ -88,6 +88,21 @@ type InjectorControllerOptions struct {
 	// APIServices
 	EnableAPIServicesInjectable bool
 
+	// EnableConfigMapsInjectable determines whether cainjector
+	// will spin up a control loop to inject CA data to annotated
+	// ConfigMaps
+	EnableConfigMapsInjectable bool
+
+	// EnableSecretsInjectable determines whether cainjector
+	// will spin up a control loop to inject CA data to annotated
+	// Secrets
+	EnableSecretsInjectable bool
+
+	// GenericInjectables are additional kinds of resources that cainjector
+	// will inject CA data to, in the form
+	// <Kind>.<version>.<group>=<jsonpath>[=<encoding>]
+	GenericInjectables []string
+
 	// logger to be used by this controller
 	log logr.Logger
 }
@@ -123,6 +138,13 @@ func (o *InjectorControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&o.EnableMutatingWebhookConfigurationsInjectable, "enable-mutatingwebhookconfigurations-injectable", true, "Inject CA data to annotated MutatingWebhookConfigurations. This functionality is required for cainjector to work correctly as cert-manager's internal component")
 	fs.BoolVar(&o.EnableCustomResourceDefinitionsInjectable, "enable-customresourcedefinitions-injectable", true, "Inject CA data to annotated CustomResourceDefinitions. This functionality is not required if cainjecor is only used as cert-manager's internal component and setting it to false might slightly reduce memory consumption")
 	fs.BoolVar(&o.EnableAPIServicesInjectable, "enable-apiservices-injectable", true, "Inject CA data to annotated APIServices. This functionality is not required if cainjector is only used as cert-manager's internal component and setting it to false might reduce memory consumption")
+	fs.BoolVar(&o.EnableConfigMapsInjectable, "enable-configmaps-injectable", false, "Inject CA data to annotated ConfigMaps, at the key named by the cert-manager.io/inject-ca-key annotation (ca.crt by default). Enabling this makes cainjector watch all ConfigMaps, which increases memory consumption")
+	fs.BoolVar(&o.EnableSecretsInjectable, "enable-secrets-injectable", false, "Inject CA data to annotated Secrets, at the key named by the cert-manager.io/inject-ca-key annotation (ca.crt by default)")
+	fs.StringArrayVar(&o.GenericInjectables, "generic-injectable", nil, ""+
+		"Inject CA data to annotated resources of another kind, at the fields selected by a JSONPath. "+
+		"Takes the form <Kind>.<version>.<group>=<jsonpath>[=<encoding>], where encoding is either pem (the default) or base64, "+
+		"e.g. WebhookConfig.v1.example.com={.spec.webhooks[*].caBundle}=base64. "+
+		"Only field names, list indexes and the [*] wildcard are supported in the JSONPath. May be specified multiple times")
 	fs.StringVar(&o.PprofAddr, "profiler-address", cmdutil.DefaultProfilerAddr, "Address of the Go profiler (pprof) if enabled. This should never be exposed on a public interface.")
 
 	utilfeature.DefaultMutableFeatureGate.AddFlag(fs)
@@ -169,6 +191,15 @@ servers and webhook servers.`,
 }
 
 func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) error {
+	var genericInjectables []cainjector.GenericInjectable
+	for _, s := range o.GenericInjectables {
+		gi, err := cainjector.ParseGenericInjectable(s)
+		if err != nil {
+			return err
+		}
+		genericInjectables = append(genericInjectables, gi)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                        api.Scheme,
 		Namespace:                     o.Namespace,
@@ -260,7 +291,10 @@ func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) er
 			cainjector.ValidatingWebhookConfigurationName: o.EnableValidatingWebhookConfigurationsInjectable,
 			cainjector.APIServiceName:                     o.EnableAPIServicesInjectable,
 			cainjector.CustomResourceDefinitionName:       o.EnableCustomResourceDefinitionsInjectable,
+			cainjector.ConfigMapName:                      o.EnableConfigMapsInjectable,
+			cainjector.SecretName:                         o.EnableSecretsInjectable,
 		},
+		GenericInjectables: genericInjectables,
 	}
 	err = cainjector.RegisterAllInjectors(gctx, mgr, opts)
 	if err != nil {
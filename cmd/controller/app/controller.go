@@ -35,8 +35,11 @@ import (
 	"k8s.io/utils/clock"
 
 	"github.com/cert-manager/cert-manager/controller-binary/app/options"
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	cmdutil "github.com/cert-manager/cert-manager/internal/cmd/util"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/controller/sharding"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
@@ -47,18 +50,51 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/util/profiling"
 )
 
-func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
+func Run(opts *config.ControllerConfiguration, stopCh <-chan struct{}) error {
 	rootCtx, cancelContext := context.WithCancel(cmdutil.ContextWithStopCh(context.Background(), stopCh))
 	defer cancelContext()
 	rootCtx = logf.NewContext(rootCtx, logf.Log, "controller")
 	log := logf.FromContext(rootCtx)
 	g, rootCtx := errgroup.WithContext(rootCtx)
 
+	if opts.TracingConfig.Endpoint != "" {
+		shutdownTracing, err := tracing.Setup(rootCtx, tracing.Options{
+			Endpoint:               opts.TracingConfig.Endpoint,
+			Insecure:               opts.TracingConfig.Insecure,
+			SamplingRatePerMillion: *opts.TracingConfig.SamplingRatePerMillion,
+			ServiceName:            "cert-manager-controller",
+		})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			// allow a timeout for the remaining spans to be exported
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := shutdownTracing(ctx); err != nil {
+				log.Error(err, "failed to export remaining traces")
+			}
+		}()
+		log.V(logf.InfoLevel).Info("exporting traces", "endpoint", opts.TracingConfig.Endpoint)
+	}
+
 	ctxFactory, err := buildControllerContextFactory(rootCtx, opts)
 	if err != nil {
 		return err
 	}
 
+	if opts.ShardingConfig.Enabled {
+		sharder, err := buildSharder(ctxFactory, opts)
+		if err != nil {
+			return err
+		}
+		g.Go(func() error {
+			sharder.Run(rootCtx)
+			return nil
+		})
+	}
+
 	// Build the base controller context for the cert-manager controller manager
 	// used here.
 	ctx, err := ctxFactory.Build()
@@ -66,7 +102,7 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 		return err
 	}
 
-	enabledControllers := opts.EnabledControllers()
+	enabledControllers := options.EnabledControllers(opts)
 	log.Info(fmt.Sprintf("enabled controllers: %s", enabledControllers.List()))
 
 	// Start metrics server
@@ -75,6 +111,9 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 		return fmt.Errorf("failed to listen on prometheus address %s: %v", opts.MetricsListenAddress, err)
 	}
 	metricsServer := ctx.Metrics.NewServer(metricsLn)
+	// Report the metrics of the work queues of all controllers, which are
+	// created when the controllers are registered below.
+	controller.SetWorkqueueMetricsProvider(ctx.Metrics)
 
 	g.Go(func() error {
 		<-rootCtx.Done()
@@ -129,7 +168,12 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 	}
 
 	elected := make(chan struct{})
-	if opts.LeaderElect {
+	if opts.ShardingConfig.Enabled {
+		// All replicas run the controllers concurrently, each reconciling
+		// only the resources assigned to its shard.
+		log.V(logf.InfoLevel).Info("sharding enabled, not performing leader election")
+		close(elected)
+	} else if *opts.LeaderElectionConfig.Enabled {
 		g.Go(func() error {
 			log.V(logf.InfoLevel).Info("starting leader election")
 			ctx, err := ctxFactory.Build("leader-election")
@@ -201,10 +245,11 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			return err
 		}
 
+		workers := options.ControllerWorkers(opts, n)
 		g.Go(func() error {
-			log.V(logf.InfoLevel).Info("starting controller")
+			log.V(logf.InfoLevel).Info("starting controller", "workers", workers)
 
-			return iface.Run(opts.NumberOfConcurrentWorkers, rootCtx.Done())
+			return iface.Run(workers, rootCtx.Done())
 		})
 	}
 
@@ -212,6 +257,7 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 	ctx.SharedInformerFactory.Start(rootCtx.Done())
 	ctx.KubeSharedInformerFactory.Start(rootCtx.Done())
 	ctx.HTTP01ResourceMetadataInformersFactory.Start(rootCtx.Done())
+	ctx.UnmanagedCertificateInformerFactory.Start(rootCtx.Done())
 
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalGatewayAPISupport) {
 		ctx.GWShared.Start(rootCtx.Done())
@@ -228,10 +274,10 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 
 // buildControllerContextFactory builds a new controller ContextFactory which
 // can build controller contexts for each component.
-func buildControllerContextFactory(ctx context.Context, opts *options.ControllerOptions) (*controller.ContextFactory, error) {
+func buildControllerContextFactory(ctx context.Context, opts *config.ControllerConfiguration) (*controller.ContextFactory, error) {
 	log := logf.FromContext(ctx)
 
-	nameservers := opts.DNS01RecursiveNameservers
+	nameservers := opts.ACMEDNS01Config.RecursiveNameservers
 	if len(nameservers) == 0 {
 		nameservers = dnsutil.RecursiveNameservers
 	}
@@ -240,33 +286,33 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 		WithValues("nameservers", nameservers).
 		Info("configured acme dns01 nameservers")
 
-	http01SolverResourceRequestCPU, err := resource.ParseQuantity(opts.ACMEHTTP01SolverResourceRequestCPU)
+	http01SolverResourceRequestCPU, err := resource.ParseQuantity(opts.ACMEHTTP01Config.SolverResourceRequestCPU)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing ACMEHTTP01SolverResourceRequestCPU: %w", err)
 	}
 
-	http01SolverResourceRequestMemory, err := resource.ParseQuantity(opts.ACMEHTTP01SolverResourceRequestMemory)
+	http01SolverResourceRequestMemory, err := resource.ParseQuantity(opts.ACMEHTTP01Config.SolverResourceRequestMemory)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing ACMEHTTP01SolverResourceRequestMemory: %w", err)
 	}
 
-	http01SolverResourceLimitsCPU, err := resource.ParseQuantity(opts.ACMEHTTP01SolverResourceLimitsCPU)
+	http01SolverResourceLimitsCPU, err := resource.ParseQuantity(opts.ACMEHTTP01Config.SolverResourceLimitsCPU)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing ACMEHTTP01SolverResourceLimitsCPU: %w", err)
 	}
 
-	http01SolverResourceLimitsMemory, err := resource.ParseQuantity(opts.ACMEHTTP01SolverResourceLimitsMemory)
+	http01SolverResourceLimitsMemory, err := resource.ParseQuantity(opts.ACMEHTTP01Config.SolverResourceLimitsMemory)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing ACMEHTTP01SolverResourceLimitsMemory: %w", err)
 	}
 
-	ACMEHTTP01SolverRunAsNonRoot := opts.ACMEHTTP01SolverRunAsNonRoot
+	ACMEHTTP01SolverRunAsNonRoot := *opts.ACMEHTTP01Config.SolverRunAsNonRoot
 	acmeAccountRegistry := accounts.NewDefaultRegistry()
 
 	ctxFactory, err := controller.NewContextFactory(ctx, controller.ContextOptions{
-		Kubeconfig:         opts.Kubeconfig,
-		KubernetesAPIQPS:   opts.KubernetesAPIQPS,
-		KubernetesAPIBurst: opts.KubernetesAPIBurst,
+		Kubeconfig:         opts.KubeConfig,
+		KubernetesAPIQPS:   *opts.KubernetesAPIQPS,
+		KubernetesAPIBurst: *opts.KubernetesAPIBurst,
 		APIServerHost:      opts.APIServerHost,
 
 		Namespace: opts.Namespace,
@@ -280,32 +326,32 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 			HTTP01SolverResourceLimitsCPU:     http01SolverResourceLimitsCPU,
 			HTTP01SolverResourceLimitsMemory:  http01SolverResourceLimitsMemory,
 			ACMEHTTP01SolverRunAsNonRoot:      ACMEHTTP01SolverRunAsNonRoot,
-			HTTP01SolverImage:                 opts.ACMEHTTP01SolverImage,
+			HTTP01SolverImage:                 opts.ACMEHTTP01Config.SolverImage,
 			// Allows specifying a list of custom nameservers to perform HTTP01 checks on.
-			HTTP01SolverNameservers: opts.ACMEHTTP01SolverNameservers,
+			HTTP01SolverNameservers: opts.ACMEHTTP01Config.SolverNameservers,
 
 			DNS01Nameservers:        nameservers,
-			DNS01CheckRetryPeriod:   opts.DNS01CheckRetryPeriod,
-			DNS01CheckAuthoritative: !opts.DNS01RecursiveNameserversOnly,
+			DNS01CheckRetryPeriod:   opts.ACMEDNS01Config.CheckRetryPeriod.Duration,
+			DNS01CheckAuthoritative: !opts.ACMEDNS01Config.RecursiveNameserversOnly,
 
 			AccountRegistry: acmeAccountRegistry,
 		},
 
 		SchedulerOptions: controller.SchedulerOptions{
-			MaxConcurrentChallenges: opts.MaxConcurrentChallenges,
+			MaxConcurrentChallenges: *opts.MaxConcurrentChallenges,
 		},
 
 		IssuerOptions: controller.IssuerOptions{
-			ClusterIssuerAmbientCredentials: opts.ClusterIssuerAmbientCredentials,
+			ClusterIssuerAmbientCredentials: *opts.ClusterIssuerAmbientCredentials,
 			IssuerAmbientCredentials:        opts.IssuerAmbientCredentials,
 			ClusterResourceNamespace:        opts.ClusterResourceNamespace,
 		},
 
 		IngressShimOptions: controller.IngressShimOptions{
-			DefaultIssuerName:                 opts.DefaultIssuerName,
-			DefaultIssuerKind:                 opts.DefaultIssuerKind,
-			DefaultIssuerGroup:                opts.DefaultIssuerGroup,
-			DefaultAutoCertificateAnnotations: opts.DefaultAutoCertificateAnnotations,
+			DefaultIssuerName:                 opts.IngressShimConfig.DefaultIssuerName,
+			DefaultIssuerKind:                 opts.IngressShimConfig.DefaultIssuerKind,
+			DefaultIssuerGroup:                opts.IngressShimConfig.DefaultIssuerGroup,
+			DefaultAutoCertificateAnnotations: opts.IngressShimConfig.DefaultAutoCertificateAnnotations,
 		},
 
 		CertificateOptions: controller.CertificateOptions{
@@ -320,7 +366,32 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 	return ctxFactory, nil
 }
 
-func startLeaderElection(ctx context.Context, opts *options.ControllerOptions, leaderElectionClient kubernetes.Interface, recorder record.EventRecorder, callbacks leaderelection.LeaderCallbacks) error {
+// buildSharder builds the Sharder used to assign resources to this replica
+// and configures the controller context factory to use it.
+func buildSharder(ctxFactory *controller.ContextFactory, opts *config.ControllerConfiguration) (*sharding.Sharder, error) {
+	// Identity used to distinguish between multiple controller manager instances
+	id, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("error getting hostname: %v", err)
+	}
+
+	ctx, err := ctxFactory.Build("sharding")
+	if err != nil {
+		return nil, err
+	}
+
+	sharder := sharding.New(ctx.Client.CoordinationV1(), sharding.Options{
+		Namespace:     opts.LeaderElectionConfig.Namespace,
+		Group:         "cert-manager-controller-shard",
+		Identity:      id,
+		LeaseDuration: opts.ShardingConfig.LeaseDuration.Duration,
+		Clock:         ctx.Clock,
+	})
+	ctxFactory.SetSharder(sharder)
+	return sharder, nil
+}
+
+func startLeaderElection(ctx context.Context, opts *config.ControllerConfiguration, leaderElectionClient kubernetes.Interface, recorder record.EventRecorder, callbacks leaderelection.LeaderCallbacks) error {
 	// Identity used to distinguish between multiple controller manager instances
 	id, err := os.Hostname()
 	if err != nil {
@@ -336,7 +407,7 @@ func startLeaderElection(ctx context.Context, opts *options.ControllerOptions, l
 	// We only support leases for leader election. Previously we supported ConfigMap & Lease objects for leader
 	// election.
 	ml, err := resourcelock.New(resourcelock.LeasesResourceLock,
-		opts.LeaderElectionNamespace,
+		opts.LeaderElectionConfig.Namespace,
 		lockName,
 		leaderElectionClient.CoreV1(),
 		leaderElectionClient.CoordinationV1(),
@@ -349,9 +420,9 @@ func startLeaderElection(ctx context.Context, opts *options.ControllerOptions, l
 	// Try and become the leader and start controller manager loops
 	le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
 		Lock:            ml,
-		LeaseDuration:   opts.LeaderElectionLeaseDuration,
-		RenewDeadline:   opts.LeaderElectionRenewDeadline,
-		RetryPeriod:     opts.LeaderElectionRetryPeriod,
+		LeaseDuration:   opts.LeaderElectionConfig.LeaseDuration.Duration,
+		RenewDeadline:   opts.LeaderElectionConfig.RenewDeadline.Duration,
+		RetryPeriod:     opts.LeaderElectionConfig.RetryPeriod.Duration,
 		ReleaseOnCancel: true,
 		Callbacks:       callbacks,
 	})
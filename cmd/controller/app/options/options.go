@@ -17,18 +17,18 @@ limitations under the License.
 package options
 
 import (
-	"errors"
 	"fmt"
-	"net"
 	"strings"
-	"time"
 
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
+	cliflag "k8s.io/component-base/cli/flag"
 
-	cmdutil "github.com/cert-manager/cert-manager/internal/cmd/util"
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
+	configscheme "github.com/cert-manager/cert-manager/internal/apis/config/controller/scheme"
+	configvalidation "github.com/cert-manager/cert-manager/internal/apis/config/controller/validation"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
-	cm "github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	configv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
 	challengescontroller "github.com/cert-manager/cert-manager/pkg/controller/acmechallenges"
 	orderscontroller "github.com/cert-manager/cert-manager/pkg/controller/acmeorders"
 	shimgatewaycontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/gateways"
@@ -36,6 +36,7 @@ import (
 	cracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/acme"
 	crapprovercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/approver"
 	crcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/ca"
+	crpolicyapprovercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/policyapprover"
 	crselfsignedcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/selfsigned"
 	crvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/vault"
 	crvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/venafi"
@@ -48,123 +49,26 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/trigger"
 	csracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/acme"
 	csrcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/ca"
+	csrpolicyapprovercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/policyapprover"
 	csrselfsignedcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/selfsigned"
 	csrvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/vault"
 	csrvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/venafi"
 	clusterissuerscontroller "github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
+	clustertrustbundlescontroller "github.com/cert-manager/cert-manager/pkg/controller/clustertrustbundles"
+	expirymonitorcontroller "github.com/cert-manager/cert-manager/pkg/controller/expirymonitor"
 	issuerscontroller "github.com/cert-manager/cert-manager/pkg/controller/issuers"
+	notificationscontroller "github.com/cert-manager/cert-manager/pkg/controller/notifications"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
-	"github.com/cert-manager/cert-manager/pkg/util"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
-type ControllerOptions struct {
-	APIServerHost      string
-	Kubeconfig         string
-	KubernetesAPIQPS   float32
-	KubernetesAPIBurst int
-
-	ClusterResourceNamespace string
-	Namespace                string
-
-	LeaderElect                 bool
-	LeaderElectionNamespace     string
-	LeaderElectionLeaseDuration time.Duration
-	LeaderElectionRenewDeadline time.Duration
-	LeaderElectionRetryPeriod   time.Duration
-
-	controllers []string
-
-	ACMEHTTP01SolverImage                 string
-	ACMEHTTP01SolverResourceRequestCPU    string
-	ACMEHTTP01SolverResourceRequestMemory string
-	ACMEHTTP01SolverResourceLimitsCPU     string
-	ACMEHTTP01SolverResourceLimitsMemory  string
-	ACMEHTTP01SolverRunAsNonRoot          bool
-	// Allows specifying a list of custom nameservers to perform HTTP01 checks on.
-	ACMEHTTP01SolverNameservers []string
-
-	ClusterIssuerAmbientCredentials bool
-	IssuerAmbientCredentials        bool
-
-	// Default issuer/certificates details consumed by ingress-shim
-	DefaultIssuerName                 string
-	DefaultIssuerKind                 string
-	DefaultIssuerGroup                string
-	DefaultAutoCertificateAnnotations []string
-
-	// Allows specifying a list of custom nameservers to perform DNS checks on.
-	DNS01RecursiveNameservers []string
-	// Allows controlling if recursive nameservers are only used for all checks.
-	// Normally authoritative nameservers are used for checking propagation.
-	DNS01RecursiveNameserversOnly bool
-
-	EnableCertificateOwnerRef bool
-
-	// The number of concurrent workers for each controller.
-	NumberOfConcurrentWorkers int
-	// MaxConcurrentChallenges determines the maximum number of challenges that can be
-	// scheduled as 'processing' at once.
-	MaxConcurrentChallenges int
-
-	// The host and port address, separated by a ':', that the Prometheus server
-	// should expose metrics on.
-	MetricsListenAddress string
-	// PprofAddress is the address on which Go profiler will run. Should be
-	// in form <host>:<port>.
-	PprofAddress string
-	// EnablePprof determines whether pprof should be enabled.
-	EnablePprof bool
-
-	// DNSO1CheckRetryPeriod is the period of time after which to check if
-	// challenge URL can be reached by cert-manager controller. This is used
-	// for both DNS-01 and HTTP-01 challenges.
-	DNS01CheckRetryPeriod time.Duration
-
-	// Annotations copied Certificate -> CertificateRequest,
-	// CertificateRequest -> Order. Slice of string literals that are
-	// treated as prefixes for annotation keys.
-	CopiedAnnotationPrefixes []string
+// ControllerFlags defines options that can only be configured via flags.
+type ControllerFlags struct {
+	// Path to a file containing a ControllerConfiguration resource
+	Config string
 }
 
-const (
-	defaultAPIServerHost              = ""
-	defaultKubeconfig                 = ""
-	defaultKubernetesAPIQPS   float32 = 20
-	defaultKubernetesAPIBurst         = 50
-
-	defaultClusterResourceNamespace = "kube-system"
-	defaultNamespace                = ""
-
-	defaultClusterIssuerAmbientCredentials = true
-	defaultIssuerAmbientCredentials        = false
-
-	defaultTLSACMEIssuerName         = ""
-	defaultTLSACMEIssuerKind         = "Issuer"
-	defaultTLSACMEIssuerGroup        = cm.GroupName
-	defaultEnableCertificateOwnerRef = false
-
-	defaultDNS01RecursiveNameserversOnly = false
-
-	defaultNumberOfConcurrentWorkers = 5
-	defaultMaxConcurrentChallenges   = 60
-
-	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
-
-	// default time period to wait between checking DNS01 and HTTP01 challenge propagation
-	defaultDNS01CheckRetryPeriod = 10 * time.Second
-)
-
 var (
-	defaultACMEHTTP01SolverImage                 = fmt.Sprintf("quay.io/jetstack/cert-manager-acmesolver:%s", util.AppVersion)
-	defaultACMEHTTP01SolverResourceRequestCPU    = "10m"
-	defaultACMEHTTP01SolverResourceRequestMemory = "64Mi"
-	defaultACMEHTTP01SolverResourceLimitsCPU     = "100m"
-	defaultACMEHTTP01SolverResourceLimitsMemory  = "64Mi"
-	defaultACMEHTTP01SolverRunAsNonRoot          = true
-
-	defaultAutoCertificateAnnotations = []string{"kubernetes.io/tls-acme"}
-
 	allControllers = []string{
 		issuerscontroller.ControllerName,
 		clusterissuerscontroller.ControllerName,
@@ -186,6 +90,12 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+
+		notificationscontroller.ControllerName,
+		expirymonitorcontroller.ControllerName,
+		crpolicyapprovercontroller.ControllerName,
+		csrpolicyapprovercontroller.ControllerName,
+		clustertrustbundlescontroller.ControllerName,
 	}
 
 	defaultEnabledControllers = []string{
@@ -208,6 +118,8 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+
+		notificationscontroller.ControllerName,
 	}
 
 	experimentalCertificateSigningRequestControllers = []string{
@@ -217,82 +129,85 @@ var (
 		csrvenaficontroller.CSRControllerName,
 		csrvaultcontroller.CSRControllerName,
 	}
-	// Annotations that will be copied from Certificate to CertificateRequest and to Order.
-	// By default, copy all annotations except for the ones applied by kubectl, fluxcd, argocd.
-	defaultCopiedAnnotationPrefixes = []string{
-		"*",
-		"-kubectl.kubernetes.io/",
-		"-fluxcd.io/",
-		"-argocd.argoproj.io/",
-	}
 )
 
-func NewControllerOptions() *ControllerOptions {
-	return &ControllerOptions{
-		APIServerHost:                     defaultAPIServerHost,
-		ClusterResourceNamespace:          defaultClusterResourceNamespace,
-		KubernetesAPIQPS:                  defaultKubernetesAPIQPS,
-		KubernetesAPIBurst:                defaultKubernetesAPIBurst,
-		Namespace:                         defaultNamespace,
-		LeaderElect:                       cmdutil.DefaultLeaderElect,
-		LeaderElectionNamespace:           cmdutil.DefaultLeaderElectionNamespace,
-		LeaderElectionLeaseDuration:       cmdutil.DefaultLeaderElectionLeaseDuration,
-		LeaderElectionRenewDeadline:       cmdutil.DefaultLeaderElectionRenewDeadline,
-		LeaderElectionRetryPeriod:         cmdutil.DefaultLeaderElectionRetryPeriod,
-		controllers:                       defaultEnabledControllers,
-		ClusterIssuerAmbientCredentials:   defaultClusterIssuerAmbientCredentials,
-		IssuerAmbientCredentials:          defaultIssuerAmbientCredentials,
-		DefaultIssuerName:                 defaultTLSACMEIssuerName,
-		DefaultIssuerKind:                 defaultTLSACMEIssuerKind,
-		DefaultIssuerGroup:                defaultTLSACMEIssuerGroup,
-		DefaultAutoCertificateAnnotations: defaultAutoCertificateAnnotations,
-		ACMEHTTP01SolverNameservers:       []string{},
-		DNS01RecursiveNameservers:         []string{},
-		DNS01RecursiveNameserversOnly:     defaultDNS01RecursiveNameserversOnly,
-		EnableCertificateOwnerRef:         defaultEnableCertificateOwnerRef,
-		MetricsListenAddress:              defaultPrometheusMetricsServerAddress,
-		NumberOfConcurrentWorkers:         defaultNumberOfConcurrentWorkers,
-		MaxConcurrentChallenges:           defaultMaxConcurrentChallenges,
-		DNS01CheckRetryPeriod:             defaultDNS01CheckRetryPeriod,
-		EnablePprof:                       cmdutil.DefaultEnableProfiling,
-		PprofAddress:                      cmdutil.DefaultProfilerAddr,
+func NewControllerFlags() *ControllerFlags {
+	return &ControllerFlags{}
+}
+
+func (f *ControllerFlags) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&f.Config, "config", "", "Path to a file containing a ControllerConfiguration object used to configure the controller")
+}
+
+func ValidateControllerFlags(f *ControllerFlags) error {
+	// No validation needed today
+	return nil
+}
+
+func NewControllerConfiguration() (*config.ControllerConfiguration, error) {
+	scheme, _, err := configscheme.NewSchemeAndCodecs()
+	if err != nil {
+		return nil, err
+	}
+	versioned := &configv1alpha1.ControllerConfiguration{}
+	scheme.Default(versioned)
+	config := &config.ControllerConfiguration{}
+	if err := scheme.Convert(versioned, config, nil); err != nil {
+		return nil, err
 	}
+	return config, nil
 }
 
-func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
-	fs.StringVar(&s.APIServerHost, "master", defaultAPIServerHost, ""+
+func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
+	fs.StringVar(&c.APIServerHost, "master", c.APIServerHost, ""+
 		"Optional apiserver host address to connect to. If not specified, autoconfiguration "+
 		"will be attempted.")
-	fs.StringVar(&s.Kubeconfig, "kubeconfig", defaultKubeconfig, ""+
+	fs.StringVar(&c.KubeConfig, "kubeconfig", c.KubeConfig, ""+
 		"Paths to a kubeconfig. Only required if out-of-cluster.")
-	fs.Float32Var(&s.KubernetesAPIQPS, "kube-api-qps", defaultKubernetesAPIQPS, "indicates the maximum queries-per-second requests to the Kubernetes apiserver")
-	fs.IntVar(&s.KubernetesAPIBurst, "kube-api-burst", defaultKubernetesAPIBurst, "the maximum burst queries-per-second of requests sent to the Kubernetes apiserver")
-	fs.StringVar(&s.ClusterResourceNamespace, "cluster-resource-namespace", defaultClusterResourceNamespace, ""+
+	fs.Float32Var(c.KubernetesAPIQPS, "kube-api-qps", *c.KubernetesAPIQPS, "indicates the maximum queries-per-second requests to the Kubernetes apiserver")
+	fs.IntVar(c.KubernetesAPIBurst, "kube-api-burst", *c.KubernetesAPIBurst, "the maximum burst queries-per-second of requests sent to the Kubernetes apiserver")
+	fs.StringVar(&c.ClusterResourceNamespace, "cluster-resource-namespace", c.ClusterResourceNamespace, ""+
 		"Namespace to store resources owned by cluster scoped resources such as ClusterIssuer in. "+
 		"This must be specified if ClusterIssuers are enabled.")
-	fs.StringVar(&s.Namespace, "namespace", defaultNamespace, ""+
+	fs.StringVar(&c.Namespace, "namespace", c.Namespace, ""+
 		"If set, this limits the scope of cert-manager to a single namespace and ClusterIssuers are disabled. "+
 		"If not specified, all namespaces will be watched")
-	fs.BoolVar(&s.LeaderElect, "leader-elect", cmdutil.DefaultLeaderElect, ""+
+	fs.BoolVar(c.LeaderElectionConfig.Enabled, "leader-elect", *c.LeaderElectionConfig.Enabled, ""+
 		"If true, cert-manager will perform leader election between instances to ensure no more "+
 		"than one instance of cert-manager operates at a time")
-	fs.StringVar(&s.LeaderElectionNamespace, "leader-election-namespace", cmdutil.DefaultLeaderElectionNamespace, ""+
+	fs.StringVar(&c.LeaderElectionConfig.Namespace, "leader-election-namespace", c.LeaderElectionConfig.Namespace, ""+
 		"Namespace used to perform leader election. Only used if leader election is enabled")
-	fs.DurationVar(&s.LeaderElectionLeaseDuration, "leader-election-lease-duration", cmdutil.DefaultLeaderElectionLeaseDuration, ""+
+	fs.DurationVar(&c.LeaderElectionConfig.LeaseDuration.Duration, "leader-election-lease-duration", c.LeaderElectionConfig.LeaseDuration.Duration, ""+
 		"The duration that non-leader candidates will wait after observing a leadership "+
 		"renewal until attempting to acquire leadership of a led but unrenewed leader "+
 		"slot. This is effectively the maximum duration that a leader can be stopped "+
 		"before it is replaced by another candidate. This is only applicable if leader "+
 		"election is enabled.")
-	fs.DurationVar(&s.LeaderElectionRenewDeadline, "leader-election-renew-deadline", cmdutil.DefaultLeaderElectionRenewDeadline, ""+
+	fs.DurationVar(&c.LeaderElectionConfig.RenewDeadline.Duration, "leader-election-renew-deadline", c.LeaderElectionConfig.RenewDeadline.Duration, ""+
 		"The interval between attempts by the acting master to renew a leadership slot "+
 		"before it stops leading. This must be less than or equal to the lease duration. "+
 		"This is only applicable if leader election is enabled.")
-	fs.DurationVar(&s.LeaderElectionRetryPeriod, "leader-election-retry-period", cmdutil.DefaultLeaderElectionRetryPeriod, ""+
+	fs.DurationVar(&c.LeaderElectionConfig.RetryPeriod.Duration, "leader-election-retry-period", c.LeaderElectionConfig.RetryPeriod.Duration, ""+
 		"The duration the clients should wait between attempting acquisition and renewal "+
 		"of a leadership. This is only applicable if leader election is enabled.")
-
-	fs.StringSliceVar(&s.controllers, "controllers", []string{"*"}, fmt.Sprintf(""+
+	fs.BoolVar(&c.ShardingConfig.Enabled, "enable-sharding", c.ShardingConfig.Enabled, ""+
+		"If true, all replicas of cert-manager run concurrently instead of performing leader "+
+		"election, and each replica only reconciles the resources assigned to its shard. "+
+		"Shard Leases are stored in the leader election namespace.")
+	fs.DurationVar(&c.ShardingConfig.LeaseDuration.Duration, "sharding-lease-duration", c.ShardingConfig.LeaseDuration.Duration, ""+
+		"The duration after which a replica that has not renewed its shard lease is considered "+
+		"gone and its resources are reassigned to the remaining replicas. This is only "+
+		"applicable if sharding is enabled.")
+
+	fs.StringVar(&c.TracingConfig.Endpoint, "tracing-otlp-endpoint", c.TracingConfig.Endpoint, ""+
+		"The host:port of an OTLP gRPC collector to export traces of Certificate issuances to. "+
+		"Tracing is disabled if empty.")
+	fs.BoolVar(&c.TracingConfig.Insecure, "tracing-otlp-insecure", c.TracingConfig.Insecure, ""+
+		"If true, TLS is not used when connecting to the OTLP collector.")
+	fs.Int32Var(c.TracingConfig.SamplingRatePerMillion, "tracing-sampling-rate-per-million", *c.TracingConfig.SamplingRatePerMillion, ""+
+		"The number of Certificate issuances out of every million that are traced.")
+
+	fs.StringSliceVar(&c.Controllers, "controllers", c.Controllers, fmt.Sprintf(""+
 		"A list of controllers to enable. '--controllers=*' enables all "+
 		"on-by-default controllers, '--controllers=foo' enables just the controller "+
 		"named 'foo', '--controllers=*,-foo' disables the controller named "+
@@ -304,111 +219,99 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	// configuration options
 	// https://github.com/cert-manager/cert-manager/blob/f1d7c432763100c3fb6eb6a1654d29060b479b3c/pkg/apis/acme/v1/types_issuer.go#L270
 	// These flags however will not be deprecated for backwards compatibility purposes.
-	fs.StringVar(&s.ACMEHTTP01SolverImage, "acme-http01-solver-image", defaultACMEHTTP01SolverImage, ""+
+	fs.StringVar(&c.ACMEHTTP01Config.SolverImage, "acme-http01-solver-image", c.ACMEHTTP01Config.SolverImage, ""+
 		"The docker image to use to solve ACME HTTP01 challenges. You most likely will not "+
 		"need to change this parameter unless you are testing a new feature or developing cert-manager.")
 
-	fs.StringVar(&s.ACMEHTTP01SolverResourceRequestCPU, "acme-http01-solver-resource-request-cpu", defaultACMEHTTP01SolverResourceRequestCPU, ""+
+	fs.StringVar(&c.ACMEHTTP01Config.SolverResourceRequestCPU, "acme-http01-solver-resource-request-cpu", c.ACMEHTTP01Config.SolverResourceRequestCPU, ""+
 		"Defines the resource request CPU size when spawning new ACME HTTP01 challenge solver pods.")
 
-	fs.StringVar(&s.ACMEHTTP01SolverResourceRequestMemory, "acme-http01-solver-resource-request-memory", defaultACMEHTTP01SolverResourceRequestMemory, ""+
+	fs.StringVar(&c.ACMEHTTP01Config.SolverResourceRequestMemory, "acme-http01-solver-resource-request-memory", c.ACMEHTTP01Config.SolverResourceRequestMemory, ""+
 		"Defines the resource request Memory size when spawning new ACME HTTP01 challenge solver pods.")
 
-	fs.StringVar(&s.ACMEHTTP01SolverResourceLimitsCPU, "acme-http01-solver-resource-limits-cpu", defaultACMEHTTP01SolverResourceLimitsCPU, ""+
+	fs.StringVar(&c.ACMEHTTP01Config.SolverResourceLimitsCPU, "acme-http01-solver-resource-limits-cpu", c.ACMEHTTP01Config.SolverResourceLimitsCPU, ""+
 		"Defines the resource limits CPU size when spawning new ACME HTTP01 challenge solver pods.")
 
-	fs.StringVar(&s.ACMEHTTP01SolverResourceLimitsMemory, "acme-http01-solver-resource-limits-memory", defaultACMEHTTP01SolverResourceLimitsMemory, ""+
+	fs.StringVar(&c.ACMEHTTP01Config.SolverResourceLimitsMemory, "acme-http01-solver-resource-limits-memory", c.ACMEHTTP01Config.SolverResourceLimitsMemory, ""+
 		"Defines the resource limits Memory size when spawning new ACME HTTP01 challenge solver pods.")
 
-	fs.BoolVar(&s.ACMEHTTP01SolverRunAsNonRoot, "acme-http01-solver-run-as-non-root", defaultACMEHTTP01SolverRunAsNonRoot, ""+
+	fs.BoolVar(c.ACMEHTTP01Config.SolverRunAsNonRoot, "acme-http01-solver-run-as-non-root", *c.ACMEHTTP01Config.SolverRunAsNonRoot, ""+
 		"Defines the ability to run the http01 solver as root for troubleshooting issues")
 
-	fs.StringSliceVar(&s.ACMEHTTP01SolverNameservers, "acme-http01-solver-nameservers",
-		[]string{}, "A list of comma separated dns server endpoints used for "+
+	fs.StringSliceVar(&c.ACMEHTTP01Config.SolverNameservers, "acme-http01-solver-nameservers",
+		c.ACMEHTTP01Config.SolverNameservers, "A list of comma separated dns server endpoints used for "+
 			"ACME HTTP01 check requests. This should be a list containing host and "+
 			"port, for example 8.8.8.8:53,8.8.4.4:53")
 
-	fs.BoolVar(&s.ClusterIssuerAmbientCredentials, "cluster-issuer-ambient-credentials", defaultClusterIssuerAmbientCredentials, ""+
+	fs.BoolVar(c.ClusterIssuerAmbientCredentials, "cluster-issuer-ambient-credentials", *c.ClusterIssuerAmbientCredentials, ""+
 		"Whether a cluster-issuer may make use of ambient credentials for issuers. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the ClusterIssuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
 		"AWS - All sources the Go SDK defaults to, notably including any EC2 IAM roles available via instance metadata.")
-	fs.BoolVar(&s.IssuerAmbientCredentials, "issuer-ambient-credentials", defaultIssuerAmbientCredentials, ""+
+	fs.BoolVar(&c.IssuerAmbientCredentials, "issuer-ambient-credentials", c.IssuerAmbientCredentials, ""+
 		"Whether an issuer may make use of ambient credentials. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the Issuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
 		"AWS - All sources the Go SDK defaults to, notably including any EC2 IAM roles available via instance metadata.")
-	fs.StringSliceVar(&s.DefaultAutoCertificateAnnotations, "auto-certificate-annotations", defaultAutoCertificateAnnotations, ""+
+	fs.StringSliceVar(&c.IngressShimConfig.DefaultAutoCertificateAnnotations, "auto-certificate-annotations", c.IngressShimConfig.DefaultAutoCertificateAnnotations, ""+
 		"The annotation consumed by the ingress-shim controller to indicate a ingress is requesting a certificate")
 
-	fs.StringVar(&s.DefaultIssuerName, "default-issuer-name", defaultTLSACMEIssuerName, ""+
+	fs.StringVar(&c.IngressShimConfig.DefaultIssuerName, "default-issuer-name", c.IngressShimConfig.DefaultIssuerName, ""+
 		"Name of the Issuer to use when the tls is requested but issuer name is not specified on the ingress resource.")
-	fs.StringVar(&s.DefaultIssuerKind, "default-issuer-kind", defaultTLSACMEIssuerKind, ""+
+	fs.StringVar(&c.IngressShimConfig.DefaultIssuerKind, "default-issuer-kind", c.IngressShimConfig.DefaultIssuerKind, ""+
 		"Kind of the Issuer to use when the tls is requested but issuer kind is not specified on the ingress resource.")
-	fs.StringVar(&s.DefaultIssuerGroup, "default-issuer-group", defaultTLSACMEIssuerGroup, ""+
+	fs.StringVar(&c.IngressShimConfig.DefaultIssuerGroup, "default-issuer-group", c.IngressShimConfig.DefaultIssuerGroup, ""+
 		"Group of the Issuer to use when the tls is requested but issuer group is not specified on the ingress resource.")
-	fs.StringSliceVar(&s.DNS01RecursiveNameservers, "dns01-recursive-nameservers",
-		[]string{}, "A list of comma separated dns server endpoints used for "+
+	fs.StringSliceVar(&c.ACMEDNS01Config.RecursiveNameservers, "dns01-recursive-nameservers",
+		c.ACMEDNS01Config.RecursiveNameservers, "A list of comma separated dns server endpoints used for "+
 			"DNS01 check requests. This should be a list containing host and "+
-			"port, for example 8.8.8.8:53,8.8.4.4:53")
-	fs.BoolVar(&s.DNS01RecursiveNameserversOnly, "dns01-recursive-nameservers-only",
-		defaultDNS01RecursiveNameserversOnly,
+			"port, for example 8.8.8.8:53,8.8.4.4:53. DNS-over-HTTPS and "+
+			"DNS-over-TLS resolvers may be specified using https:// URLs and "+
+			"tls://host[:port] addresses, for example "+
+			"https://1.1.1.1/dns-query,tls://1.1.1.1:853")
+	fs.BoolVar(&c.ACMEDNS01Config.RecursiveNameserversOnly, "dns01-recursive-nameservers-only",
+		c.ACMEDNS01Config.RecursiveNameserversOnly,
 		"When true, cert-manager will only ever query the configured DNS resolvers "+
 			"to perform the ACME DNS01 self check. This is useful in DNS constrained "+
 			"environments, where access to authoritative nameservers is restricted. "+
 			"Enabling this option could cause the DNS01 self check to take longer "+
 			"due to caching performed by the recursive nameservers.")
 
-	fs.BoolVar(&s.EnableCertificateOwnerRef, "enable-certificate-owner-ref", defaultEnableCertificateOwnerRef, ""+
+	fs.BoolVar(&c.EnableCertificateOwnerRef, "enable-certificate-owner-ref", c.EnableCertificateOwnerRef, ""+
 		"Whether to set the certificate resource as an owner of secret where the tls certificate is stored. "+
 		"When this flag is enabled, the secret will be automatically removed when the certificate resource is deleted.")
-	fs.StringSliceVar(&s.CopiedAnnotationPrefixes, "copied-annotation-prefixes", defaultCopiedAnnotationPrefixes, "Specify which annotations should/shouldn't be copied"+
+	fs.StringSliceVar(&c.CopiedAnnotationPrefixes, "copied-annotation-prefixes", c.CopiedAnnotationPrefixes, "Specify which annotations should/shouldn't be copied"+
 		"from Certificate to CertificateRequest and Order, as well as from CertificateSigningRequest to Order, by passing a list of annotation key prefixes."+
 		"A prefix starting with a dash(-) specifies an annotation that shouldn't be copied. Example: '*,-kubectl.kuberenetes.io/'- all annotations"+
 		"will be copied apart from the ones where the key is prefixed with 'kubectl.kubernetes.io/'.")
 
-	fs.IntVar(&s.NumberOfConcurrentWorkers, "concurrent-workers", defaultNumberOfConcurrentWorkers, ""+
-		"The number of concurrent workers for each controller.")
-	fs.IntVar(&s.MaxConcurrentChallenges, "max-concurrent-challenges", defaultMaxConcurrentChallenges, ""+
+	fs.IntVar(c.Workers.Default, "concurrent-workers", *c.Workers.Default, ""+
+		"The number of concurrent workers for each controller. Per-controller worker counts "+
+		"can be set using workers.controllers in the configuration file.")
+	fs.IntVar(c.MaxConcurrentChallenges, "max-concurrent-challenges", *c.MaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
-	fs.DurationVar(&s.DNS01CheckRetryPeriod, "dns01-check-retry-period", defaultDNS01CheckRetryPeriod, ""+
+	fs.DurationVar(&c.ACMEDNS01Config.CheckRetryPeriod.Duration, "dns01-check-retry-period", c.ACMEDNS01Config.CheckRetryPeriod.Duration, ""+
 		"The duration the controller should wait between a propagation check. Despite the name, this flag is used to configure the wait period for both DNS01 and HTTP01 challenge propagation checks. For DNS01 challenges the propagation check verifies that a TXT record with the challenge token has been created. For HTTP01 challenges the propagation check verifies that the challenge token is served at the challenge URL."+
 		"This should be a valid duration string, for example 180s or 1h")
 
-	fs.StringVar(&s.MetricsListenAddress, "metrics-listen-address", defaultPrometheusMetricsServerAddress, ""+
+	fs.StringVar(&c.MetricsListenAddress, "metrics-listen-address", c.MetricsListenAddress, ""+
 		"The host and port that the metrics endpoint should listen on.")
-	fs.BoolVar(&s.EnablePprof, "enable-profiling", cmdutil.DefaultEnableProfiling, ""+
+	fs.BoolVar(&c.EnablePprof, "enable-profiling", c.EnablePprof, ""+
 		"Enable profiling for controller.")
-	fs.StringVar(&s.PprofAddress, "profiler-address", cmdutil.DefaultProfilerAddr,
+	fs.StringVar(&c.PprofAddress, "profiler-address", c.PprofAddress,
 		"The host and port that Go profiler should listen on, i.e localhost:6060. Ensure that profiler is not exposed on a public address. Profiler will be served at /debug/pprof.")
+	fs.Var(cliflag.NewMapStringBool(&c.FeatureGates), "feature-gates", "A set of key=value pairs that describe feature gates for alpha/experimental features. "+
+		"Options are:\n"+strings.Join(utilfeature.DefaultFeatureGate.KnownFeatures(), "\n"))
 }
 
-func (o *ControllerOptions) Validate() error {
-	if len(o.DefaultIssuerKind) == 0 {
-		return errors.New("the --default-issuer-kind flag must not be empty")
-	}
-
-	if o.KubernetesAPIBurst <= 0 {
-		return fmt.Errorf("invalid value for kube-api-burst: %v must be higher than 0", o.KubernetesAPIBurst)
-	}
-
-	if o.KubernetesAPIQPS <= 0 {
-		return fmt.Errorf("invalid value for kube-api-qps: %v must be higher than 0", o.KubernetesAPIQPS)
-	}
-
-	if float32(o.KubernetesAPIBurst) < o.KubernetesAPIQPS {
-		return fmt.Errorf("invalid value for kube-api-burst: %v must be higher or equal to kube-api-qps: %v", o.KubernetesAPIQPS, o.KubernetesAPIQPS)
-	}
-
-	for _, server := range append(o.DNS01RecursiveNameservers, o.ACMEHTTP01SolverNameservers...) {
-		// ensure all servers have a port number
-		_, _, err := net.SplitHostPort(server)
-		if err != nil {
-			return fmt.Errorf("invalid DNS server (%v): %v", err, server)
-		}
+// ValidateControllerConfiguration validates the given configuration, including
+// that all controllers referenced by name are known to this binary.
+func ValidateControllerConfiguration(cfg *config.ControllerConfiguration) error {
+	if err := configvalidation.ValidateControllerConfiguration(cfg); err != nil {
+		return err
 	}
 
 	errs := []error{}
 	allControllersSet := sets.NewString(allControllers...)
-	for _, controller := range o.controllers {
+	for _, controller := range cfg.Controllers {
 		if controller == "*" {
 			continue
 		}
@@ -423,14 +326,27 @@ func (o *ControllerOptions) Validate() error {
 		return fmt.Errorf("validation failed for '--controllers': %v", errs)
 	}
 
+	allControllersSet.Insert(experimentalCertificateSigningRequestControllers...)
+	for controller := range cfg.Workers.Controllers {
+		if !allControllersSet.Has(controller) {
+			errs = append(errs, fmt.Errorf("%q is not in the list of known controllers", controller))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("validation failed for 'workers.controllers': %v", errs)
+	}
+
 	return nil
 }
 
-func (o *ControllerOptions) EnabledControllers() sets.String {
+// EnabledControllers returns the set of controllers enabled by the given
+// configuration and the current feature gates.
+func EnabledControllers(cfg *config.ControllerConfiguration) sets.String {
 	var disabled []string
 	enabled := sets.NewString()
 
-	for _, controller := range o.controllers {
+	for _, controller := range cfg.Controllers {
 		switch {
 		case controller == "*":
 			enabled = enabled.Insert(defaultEnabledControllers...)
@@ -455,3 +371,12 @@ func (o *ControllerOptions) EnabledControllers() sets.String {
 
 	return enabled
 }
+
+// ControllerWorkers returns the number of concurrent workers to run for the
+// named controller.
+func ControllerWorkers(cfg *config.ControllerConfiguration, controller string) int {
+	if workers, ok := cfg.Workers.Controllers[controller]; ok {
+		return workers
+	}
+	return *cfg.Workers.Default
+}
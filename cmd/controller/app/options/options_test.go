@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 )
 
 func TestEnabledControllers(t *testing.T) {
@@ -51,11 +53,11 @@ func TestEnabledControllers(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			o := ControllerOptions{
-				controllers: test.controllers,
+			cfg := &config.ControllerConfiguration{
+				Controllers: test.controllers,
 			}
 
-			got := o.EnabledControllers()
+			got := EnabledControllers(cfg)
 			if !got.Equal(test.expEnabled) {
 				t.Errorf("got unexpected enabled, exp=%s got=%s",
 					test.expEnabled, got)
@@ -63,3 +65,66 @@ func TestEnabledControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerWorkers(t *testing.T) {
+	cfg, err := NewControllerConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Workers.Controllers = map[string]int{"issuers": 1}
+
+	if got := ControllerWorkers(cfg, "issuers"); got != 1 {
+		t.Errorf("expected 1 worker for issuers controller, got %d", got)
+	}
+	if got := ControllerWorkers(cfg, "clusterissuers"); got != *cfg.Workers.Default {
+		t.Errorf("expected %d workers for clusterissuers controller, got %d", *cfg.Workers.Default, got)
+	}
+}
+
+func TestValidateControllerConfiguration(t *testing.T) {
+	tests := map[string]struct {
+		mutate func(*config.ControllerConfiguration)
+		expErr bool
+	}{
+		"defaults are valid": {
+			mutate: func(*config.ControllerConfiguration) {},
+		},
+		"unknown controller is invalid": {
+			mutate: func(cfg *config.ControllerConfiguration) { cfg.Controllers = []string{"*", "-foo"} },
+			expErr: true,
+		},
+		"worker count for unknown controller is invalid": {
+			mutate: func(cfg *config.ControllerConfiguration) { cfg.Workers.Controllers = map[string]int{"foo": 2} },
+			expErr: true,
+		},
+		"zero worker count is invalid": {
+			mutate: func(cfg *config.ControllerConfiguration) { cfg.Workers.Controllers = map[string]int{"issuers": 0} },
+			expErr: true,
+		},
+		"burst lower than qps is invalid": {
+			mutate: func(cfg *config.ControllerConfiguration) { *cfg.KubernetesAPIBurst = 1 },
+			expErr: true,
+		},
+		"invalid DNS01 nameserver is invalid": {
+			mutate: func(cfg *config.ControllerConfiguration) {
+				cfg.ACMEDNS01Config.RecursiveNameservers = []string{"1.1.1.1"}
+			},
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := NewControllerConfiguration()
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.mutate(cfg)
+
+			err = ValidateControllerConfiguration(cfg)
+			if test.expErr != (err != nil) {
+				t.Errorf("expected error=%t, got %v", test.expErr, err)
+			}
+		})
+	}
+}
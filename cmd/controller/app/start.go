@@ -17,19 +17,25 @@ limitations under the License.
 package app
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
-	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"github.com/spf13/pflag"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	cliflag "k8s.io/component-base/cli/flag"
 
 	"github.com/cert-manager/cert-manager/controller-binary/app/options"
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/acmechallenges"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/acmeorders"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/gateways"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/ingresses"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/certificates/trigger"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
+	"github.com/cert-manager/cert-manager/pkg/controller/configfile"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/issuers"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/acme"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/ca"
@@ -41,21 +47,21 @@ import (
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
-type CertManagerControllerOptions struct {
-	ControllerOptions *options.ControllerOptions
-}
-
-func NewCertManagerControllerOptions() *CertManagerControllerOptions {
-	o := &CertManagerControllerOptions{
-		ControllerOptions: options.NewControllerOptions(),
-	}
-
-	return o
-}
+const componentController = "controller"
 
 // NewCommandStartCertManagerController is a CLI handler for starting cert-manager
 func NewCommandStartCertManagerController(stopCh <-chan struct{}) *cobra.Command {
-	o := NewCertManagerControllerOptions()
+	log := logf.Log
+
+	cleanFlagSet := pflag.NewFlagSet(componentController, pflag.ContinueOnError)
+	// Replaces all instances of `_` in flag names with `-`
+	cleanFlagSet.SetNormalizeFunc(cliflag.WordSepNormalizeFunc)
+	controllerFlags := options.NewControllerFlags()
+	controllerConfig, err := options.NewControllerConfiguration()
+	if err != nil {
+		log.Error(err, "Failed to create new controller configuration")
+		os.Exit(1)
+	}
 
 	cmd := &cobra.Command{
 		Use:   "cert-manager-controller",
@@ -66,14 +72,58 @@ TLS certificates from various issuing sources.
 
 It will ensure certificates are valid and up to date periodically, and attempt
 to renew certificates at an appropriate time before expiry.`,
-
+		// The controller has special flag parsing requirements to handle precedence of providing
+		// configuration via versioned configuration files and flag values.
+		// Setting DisableFlagParsing=true prevents Cobra from interfering with flag parsing
+		// at all, and instead we handle it all in the RunE below.
+		DisableFlagParsing: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := o.Validate(args); err != nil {
+			// initial flag parse, since we disable cobra's flag parsing
+			if err := cleanFlagSet.Parse(args); err != nil {
+				cmd.Usage()
+				return fmt.Errorf("failed to parse controller flags: %w", err)
+			}
+
+			// check if there are non-flag arguments in the command line
+			if cmds := cleanFlagSet.Args(); len(cmds) > 0 {
+				cmd.Usage()
+				return fmt.Errorf("unknown command %q", cmds[0])
+			}
+
+			// short-circuit on help
+			help, err := cleanFlagSet.GetBool("help")
+			if err != nil {
+				return errors.New(`"help" flag is non-bool, programmer error, please correct`)
+			}
+			if help {
+				return cmd.Help()
+			}
+
+			if err := options.ValidateControllerFlags(controllerFlags); err != nil {
+				return fmt.Errorf("error validating flags: %w", err)
+			}
+
+			if configFile := controllerFlags.Config; len(configFile) > 0 {
+				controllerConfig, err = loadConfigFile(configFile)
+				if err != nil {
+					return err
+				}
+
+				if err := controllerConfigFlagPrecedence(controllerConfig, args); err != nil {
+					return fmt.Errorf("failed to merge flags with config file values: %w", err)
+				}
+			}
+
+			if err := utilfeature.DefaultMutableFeatureGate.SetFromMap(controllerConfig.FeatureGates); err != nil {
+				return fmt.Errorf("failed to set feature gates from config: %w", err)
+			}
+
+			if err := options.ValidateControllerConfiguration(controllerConfig); err != nil {
 				return fmt.Errorf("error validating options: %s", err)
 			}
 
 			logf.Log.V(logf.InfoLevel).Info("starting controller", "version", util.AppVersion, "git-commit", util.AppGitCommit)
-			if err := o.RunCertManagerController(stopCh); err != nil {
+			if err := Run(controllerConfig, stopCh); err != nil {
 				cmd.SilenceUsage = true // Don't display usage information when exiting because of an error
 				return err
 			}
@@ -83,19 +133,79 @@ to renew certificates at an appropriate time before expiry.`,
 		SilenceErrors: true, // Errors are already logged when calling cmd.Execute()
 	}
 
-	flags := cmd.Flags()
-	o.ControllerOptions.AddFlags(flags)
-	utilfeature.DefaultMutableFeatureGate.AddFlag(flags)
+	controllerFlags.AddFlags(cleanFlagSet)
+	options.AddConfigFlags(cleanFlagSet, controllerConfig)
+	options.AddGlobalFlags(cleanFlagSet)
+
+	cleanFlagSet.BoolP("help", "h", false, fmt.Sprintf("help for %s", cmd.Name()))
+
+	// ugly, but necessary, because Cobra's default UsageFunc and HelpFunc pollute the flagset with global flags
+	const usageFmt = "Usage:\n  %s\n\nFlags:\n%s"
+	cmd.SetUsageFunc(func(cmd *cobra.Command) error {
+		fmt.Fprintf(cmd.OutOrStderr(), usageFmt, cmd.UseLine(), cleanFlagSet.FlagUsagesWrapped(2))
+		return nil
+	})
+	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\n\n"+usageFmt, cmd.Long, cmd.UseLine(), cleanFlagSet.FlagUsagesWrapped(2))
+	})
 
 	return cmd
 }
 
-func (o CertManagerControllerOptions) Validate(args []string) error {
-	errors := []error{}
-	errors = append(errors, o.ControllerOptions.Validate())
-	return utilerrors.NewAggregate(errors)
+// newFlagSetWithGlobals constructs a new pflag.FlagSet with global flags registered
+// on it.
+func newFlagSetWithGlobals() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("", pflag.ExitOnError)
+	// set the normalize func, similar to k8s.io/component-base/cli//flags.go:InitFlags
+	fs.SetNormalizeFunc(cliflag.WordSepNormalizeFunc)
+	// explicitly add flags from libs that register global flags
+	options.AddGlobalFlags(fs)
+	return fs
 }
 
-func (o CertManagerControllerOptions) RunCertManagerController(stopCh <-chan struct{}) error {
-	return Run(o.ControllerOptions, stopCh)
+// newFakeFlagSet constructs a pflag.FlagSet with the same flags as fs, but where
+// all values have noop Set implementations
+func newFakeFlagSet(fs *pflag.FlagSet) *pflag.FlagSet {
+	ret := pflag.NewFlagSet("", pflag.ExitOnError)
+	ret.SetNormalizeFunc(fs.GetNormalizeFunc())
+	fs.VisitAll(func(f *pflag.Flag) {
+		ret.VarP(cliflag.NoOp{}, f.Name, f.Shorthand, f.Usage)
+	})
+	return ret
+}
+
+// controllerConfigFlagPrecedence re-parses flags over the ControllerConfiguration object.
+// We must enforce flag precedence by re-parsing the command line into the new object.
+// This is necessary to preserve backwards-compatibility across binary upgrades.
+func controllerConfigFlagPrecedence(cfg *config.ControllerConfiguration, args []string) error {
+	// We use a throwaway controllerFlags and a fake global flagset to avoid double-parses,
+	// as some Set implementations accumulate values from multiple flag invocations.
+	fs := newFakeFlagSet(newFlagSetWithGlobals())
+	// register throwaway ControllerFlags
+	options.NewControllerFlags().AddFlags(fs)
+	// register new ControllerConfiguration
+	options.AddConfigFlags(fs, cfg)
+	// re-parse flags
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func loadConfigFile(name string) (*config.ControllerConfiguration, error) {
+	const errFmt = "failed to load controller config file %s, error %v"
+	// compute absolute path based on current working dir
+	controllerConfigFile, err := filepath.Abs(name)
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, name, err)
+	}
+	loader, err := configfile.NewFSLoader(configfile.NewRealFS(), controllerConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, name, err)
+	}
+	cfg, err := loader.Load()
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, name, err)
+	}
+	return cfg, nil
 }
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"testing"
+
+	"github.com/cert-manager/cert-manager/controller-binary/app/options"
+)
+
+// Test to ensure flags take precedence over config options.
+func TestControllerConfigFlagPrecedence_FlagsTakePrecedence(t *testing.T) {
+	cfg, err := options.NewControllerConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.KubeConfig = "<invalid>"
+	if err := controllerConfigFlagPrecedence(cfg, []string{"--kubeconfig=valid"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.KubeConfig != "valid" {
+		t.Errorf("unexpected field value %q, expected %q", cfg.KubeConfig, "valid")
+	}
+}
+
+// Test to ensure that when flags are not provided, config provided values are preserved.
+func TestControllerConfigFlagPrecedence_ConfigPersistsWithoutFlags(t *testing.T) {
+	cfg, err := options.NewControllerConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.KubeConfig = "valid"
+	if err := controllerConfigFlagPrecedence(cfg, []string{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.KubeConfig != "valid" {
+		t.Errorf("unexpected field value %q, expected %q", cfg.KubeConfig, "valid")
+	}
+}
+
+// Test to ensure flags are applied to structured config fields.
+func TestControllerConfigFlagPrecedence_StructuredFields(t *testing.T) {
+	cfg, err := options.NewControllerConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	*cfg.Workers.Default = 1
+	cfg.ACMEDNS01Config.RecursiveNameservers = []string{"1.1.1.1:53"}
+	if err := controllerConfigFlagPrecedence(cfg, []string{"--concurrent-workers=10", "--default-issuer-name=test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if *cfg.Workers.Default != 10 {
+		t.Errorf("unexpected workers.default value %d, expected %d", *cfg.Workers.Default, 10)
+	}
+	if cfg.IngressShimConfig.DefaultIssuerName != "test" {
+		t.Errorf("unexpected ingressShimConfig.defaultIssuerName value %q, expected %q", cfg.IngressShimConfig.DefaultIssuerName, "test")
+	}
+	if len(cfg.ACMEDNS01Config.RecursiveNameservers) != 1 || cfg.ACMEDNS01Config.RecursiveNameservers[0] != "1.1.1.1:53" {
+		t.Errorf("unexpected acmeDNS01Config.recursiveNameservers value %v", cfg.ACMEDNS01Config.RecursiveNameservers)
+	}
+}
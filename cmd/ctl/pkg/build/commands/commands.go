@@ -33,6 +33,7 @@ import (
 	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/inspect"
 	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/renew"
 	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/status"
+	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/suspend"
 	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/upgrade"
 	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/version"
 )
@@ -52,6 +53,8 @@ func Commands() []RegisterCommandFunc {
 		convert.NewCmdConvert,
 		create.NewCmdCreate,
 		renew.NewCmdRenew,
+		suspend.NewCmdSuspend,
+		suspend.NewCmdResume,
 		status.NewCmdStatus,
 		inspect.NewCmdInspect,
 		approve.NewCmdApprove,
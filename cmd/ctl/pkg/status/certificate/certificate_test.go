@@ -116,6 +116,43 @@ func TestCRInfoString(t *testing.T) {
 	}
 }
 
+func TestHistoryToString(t *testing.T) {
+	notBefore := metav1.NewTime(time.Date(2023, 3, 15, 9, 0, 0, 0, time.UTC))
+	notAfter := metav1.NewTime(time.Date(2023, 6, 13, 9, 0, 0, 0, time.UTC))
+	history := []cmapi.CertificateHistoryEntry{
+		{
+			Revision: 2, SerialNumber: "0a1b", NotBefore: &notBefore, NotAfter: &notAfter,
+			IssuerRef:            cmmeta.ObjectReference{Name: "ca-issuer", Kind: cmapi.ClusterIssuerKind},
+			PublicKeyFingerprint: "ffee",
+			Reason:               "Renewing", Message: "Renewing certificate as renewal was scheduled",
+		},
+		{
+			Revision:  1,
+			IssuerRef: cmmeta.ObjectReference{Name: "self-signed"},
+			Reason:    "DoesNotExist", Message: "Issuing certificate as Secret does not exist",
+		},
+	}
+	expOutput := `History:
+  Revision 2:
+    Serial Number: 0a1b
+    Not Before: 2023-03-15T09:00:00Z
+    Not After: 2023-06-13T09:00:00Z
+    Issuer: ClusterIssuer/ca-issuer
+    Public Key Fingerprint: ffee
+    Reason: Renewing, Message: Renewing certificate as renewal was scheduled
+  Revision 1:
+    Serial Number: 
+    Not Before: <none>
+    Not After: <none>
+    Issuer: Issuer/self-signed
+    Public Key Fingerprint: 
+    Reason: DoesNotExist, Message: Issuing certificate as Secret does not exist
+`
+	if actualOutput := historyToString(history); actualOutput != expOutput {
+		t.Errorf("Unexpected output; expected: \n%s\nactual: \n%s", expOutput, actualOutput)
+	}
+}
+
 func TestKeyUsageToString(t *testing.T) {
 	tests := map[string]struct {
 		usage     x509.KeyUsage
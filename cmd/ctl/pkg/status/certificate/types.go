@@ -53,6 +53,8 @@ type CertificateStatus struct {
 	NotAfter *metav1.Time
 	// Renewal Time of Certificate resource
 	RenewalTime *metav1.Time
+	// Previously issued revisions of Certificate resource, newest first
+	History []cmapi.CertificateHistoryEntry
 
 	IssuerStatus *IssuerStatus
 
@@ -164,7 +166,8 @@ func newCertificateStatusFromCert(crt *cmapi.Certificate) *CertificateStatus {
 	return &CertificateStatus{
 		Name: crt.Name, Namespace: crt.Namespace, CreationTime: crt.CreationTimestamp,
 		Conditions: crt.Status.Conditions, DNSNames: crt.Spec.DNSNames,
-		NotBefore: crt.Status.NotBefore, NotAfter: crt.Status.NotAfter, RenewalTime: crt.Status.RenewalTime}
+		NotBefore: crt.Status.NotBefore, NotAfter: crt.Status.NotAfter, RenewalTime: crt.Status.RenewalTime,
+		History: crt.Status.History}
 }
 
 func (status *CertificateStatus) withEvents(events *v1.EventList) *CertificateStatus {
@@ -302,6 +305,11 @@ func (status *CertificateStatus) String() string {
 	output += fmt.Sprintf("Not After: %s\n", formatTimeString(status.NotAfter))
 	output += fmt.Sprintf("Renewal Time: %s\n", formatTimeString(status.RenewalTime))
 
+	// History is only set once the Certificate has been issued at least once
+	if len(status.History) > 0 {
+		output += historyToString(status.History)
+	}
+
 	output += status.CRStatus.String()
 
 	// OrderStatus is nil is not found or Issuer/ClusterIssuer is not ACME Issuer
@@ -316,6 +324,31 @@ func (status *CertificateStatus) String() string {
 	return output
 }
 
+// historyToString returns the previously issued revisions of a Certificate as
+// a string to be printed as output
+func historyToString(history []cmapi.CertificateHistoryEntry) string {
+	entryFormat := `  Revision %d:
+    Serial Number: %s
+    Not Before: %s
+    Not After: %s
+    Issuer: %s
+    Public Key Fingerprint: %s
+    Reason: %s, Message: %s
+`
+	output := "History:\n"
+	for _, entry := range history {
+		issuerKind := entry.IssuerRef.Kind
+		if issuerKind == "" {
+			issuerKind = cmapi.IssuerKind
+		}
+		output += fmt.Sprintf(entryFormat, entry.Revision, entry.SerialNumber,
+			formatTimeString(entry.NotBefore), formatTimeString(entry.NotAfter),
+			fmt.Sprintf("%s/%s", issuerKind, entry.IssuerRef.Name),
+			entry.PublicKeyFingerprint, entry.Reason, entry.Message)
+	}
+	return output
+}
+
 // String returns the information about the status of a Issuer/ClusterIssuer as a string to be printed as output
 func (issuerStatus *IssuerStatus) String() string {
 	if issuerStatus.Error != nil {
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suspend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/build"
+	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/factory"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var (
+	suspendLong = templates.LongDesc(i18n.T(`
+Suspend renewal and reissuance of cert-manager Certificate resources.
+
+While a Certificate is suspended, cert-manager will not create new
+CertificateRequests for it, and the certificate currently stored in its Secret
+is left untouched. Use the resume command to allow renewal again.`))
+
+	suspendExample = templates.Examples(i18n.T(build.WithTemplate(`
+# Suspend the Certificates named 'my-app' and 'vault' in the current context namespace.
+{{.BuildName}} suspend my-app vault
+
+# Suspend all Certificates in the 'kube-system' namespace.
+{{.BuildName}} suspend --namespace kube-system --all
+
+# Suspend all Certificates in all namespaces, provided those Certificates have the label 'app=my-service'
+{{.BuildName}} suspend --all-namespaces -l app=my-service`)))
+
+	resumeLong = templates.LongDesc(i18n.T(`
+Resume renewal and reissuance of suspended cert-manager Certificate resources.`))
+
+	resumeExample = templates.Examples(i18n.T(build.WithTemplate(`
+# Resume the Certificates named 'my-app' and 'vault' in the current context namespace.
+{{.BuildName}} resume my-app vault
+
+# Resume all Certificates in the 'kube-system' namespace.
+{{.BuildName}} resume --namespace kube-system --all
+
+# Resume all Certificates in all namespaces, provided those Certificates have the label 'app=my-service'
+{{.BuildName}} resume --all-namespaces -l app=my-service`)))
+)
+
+// Options is a struct to support the suspend and resume commands
+type Options struct {
+	LabelSelector string
+	All           bool
+	AllNamespaces bool
+
+	// Suspend is the value spec.suspend is set to on the selected
+	// Certificates.
+	Suspend bool
+
+	genericclioptions.IOStreams
+	*factory.Factory
+}
+
+// NewOptions returns initialized Options
+func NewOptions(ioStreams genericclioptions.IOStreams, suspend bool) *Options {
+	return &Options{
+		IOStreams: ioStreams,
+		Suspend:   suspend,
+	}
+}
+
+// NewCmdSuspend returns a cobra command for suspending Certificates
+func NewCmdSuspend(ctx context.Context, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	return newCmd(ctx, NewOptions(ioStreams, true), "suspend", "Suspend renewal of a Certificate", suspendLong, suspendExample)
+}
+
+// NewCmdResume returns a cobra command for resuming suspended Certificates
+func NewCmdResume(ctx context.Context, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	return newCmd(ctx, NewOptions(ioStreams, false), "resume", "Resume renewal of a suspended Certificate", resumeLong, resumeExample)
+}
+
+func newCmd(ctx context.Context, o *Options, use, short, long, example string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               use,
+		Short:             short,
+		Long:              long,
+		Example:           example,
+		ValidArgsFunction: factory.ValidArgsListCertificates(ctx, &o.Factory),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(ctx, args))
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.LabelSelector, "selector", "l", o.LabelSelector, "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", o.AllNamespaces, fmt.Sprintf("If present, %s Certificates across namespaces. Namespace in current context is ignored even if specified with --namespace.", use))
+	cmd.Flags().BoolVar(&o.All, "all", o.All, fmt.Sprintf("%s all Certificates in the given Namespace, or all namespaces with --all-namespaces enabled.", strings.ToUpper(use[:1])+use[1:]))
+
+	o.Factory = factory.New(ctx, cmd)
+
+	return cmd
+}
+
+// Validate validates the provided options
+func (o *Options) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.LabelSelector) > 0 && len(args) > 0 {
+		return errors.New("cannot specify Certificate names in conjunction with label selectors")
+	}
+
+	if len(o.LabelSelector) > 0 && o.All {
+		return errors.New("cannot specify label selectors in conjunction with --all flag")
+	}
+
+	if o.All && len(args) > 0 {
+		return errors.New("cannot specify Certificate names in conjunction with --all flag")
+	}
+
+	if o.All && cmd.PersistentFlags().Changed("namespace") {
+		return errors.New("cannot specify --namespace flag in conjunction with --all flag")
+	}
+
+	if !o.All && len(o.LabelSelector) == 0 && len(args) == 0 {
+		return errors.New("please supply one or more Certificate resource names, a label selector, or use the --all flag to select all Certificate resources")
+	}
+
+	return nil
+}
+
+// Run executes the suspend or resume command
+func (o *Options) Run(ctx context.Context, args []string) error {
+	nss := []corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: o.Namespace}}}
+
+	if o.AllNamespaces {
+		nsList, err := o.KubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		nss = nsList.Items
+	}
+
+	var crts []cmapi.Certificate
+	for _, ns := range nss {
+		switch {
+		case o.All, len(o.LabelSelector) > 0:
+			crtsList, err := o.CMClient.CertmanagerV1().Certificates(ns.Name).List(ctx, metav1.ListOptions{
+				LabelSelector: o.LabelSelector,
+			})
+			if err != nil {
+				return err
+			}
+
+			crts = append(crts, crtsList.Items...)
+
+		default:
+			for _, crtName := range args {
+				crt, err := o.CMClient.CertmanagerV1().Certificates(ns.Name).Get(ctx, crtName, metav1.GetOptions{})
+				if err != nil {
+					return err
+				}
+
+				crts = append(crts, *crt)
+			}
+		}
+	}
+
+	if len(crts) == 0 {
+		if o.AllNamespaces {
+			fmt.Fprintln(o.ErrOut, "No Certificates found")
+		} else {
+			fmt.Fprintf(o.ErrOut, "No Certificates found in %s namespace.\n", o.Namespace)
+		}
+
+		return nil
+	}
+
+	for _, crt := range crts {
+		if err := o.setSuspend(ctx, &crt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *Options) setSuspend(ctx context.Context, crt *cmapi.Certificate) error {
+	state := "suspended"
+	if !o.Suspend {
+		state = "resumed"
+	}
+
+	if crt.Spec.Suspend == o.Suspend {
+		fmt.Fprintf(o.Out, "Certificate %s/%s is already %s\n", crt.Namespace, crt.Name, state)
+		return nil
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"suspend":%t}}`, o.Suspend)
+	_, err := o.CMClient.CertmanagerV1().Certificates(crt.Namespace).Patch(ctx, crt.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update Certificate %s/%s: %v", crt.Namespace, crt.Name, err)
+	}
+	fmt.Fprintf(o.Out, "Certificate %s/%s %s\n", crt.Namespace, crt.Name, state)
+	return nil
+}
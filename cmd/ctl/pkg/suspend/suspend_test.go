@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suspend
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/cert-manager/cert-manager/cmctl-binary/pkg/factory"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+)
+
+type stringFlag struct {
+	name, value string
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		options        *Options
+		args           []string
+		setStringFlags []stringFlag
+		expErr         bool
+	}{
+		"If there are arguments, as well as label selector, error": {
+			options: &Options{
+				LabelSelector: "foo=bar",
+			},
+			args:   []string{"abc"},
+			expErr: true,
+		},
+		"If there are all certificates selected, as well as label selector, error": {
+			options: &Options{
+				LabelSelector: "foo=bar",
+				All:           true,
+			},
+			expErr: true,
+		},
+		"If there are all certificates selected, as well as arguments, error": {
+			options: &Options{
+				All: true,
+			},
+			args:   []string{"abc"},
+			expErr: true,
+		},
+		"If a label selector is given without arguments, don't error": {
+			options: &Options{
+				LabelSelector: "foo=bar",
+			},
+			expErr: false,
+		},
+		"If --namespace and --all namespace specified, error": {
+			options: &Options{
+				All: true,
+			},
+			setStringFlags: []stringFlag{
+				{name: "namespace", value: "foo"},
+			},
+			expErr: true,
+		},
+		"If --namespace specified without arguments, error": {
+			options: &Options{},
+			setStringFlags: []stringFlag{
+				{name: "namespace", value: "foo"},
+			},
+			expErr: true,
+		},
+		"If --namespace specified with multiple arguments, don't error": {
+			options: &Options{},
+			args:    []string{"bar", "abc"},
+			setStringFlags: []stringFlag{
+				{name: "namespace", value: "foo"},
+			},
+			expErr: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmd := NewCmdSuspend(context.TODO(), genericclioptions.IOStreams{})
+
+			// This is normally registered in the main func. We add here to test
+			// against flags normally inherited.
+			kubeConfigFlags := genericclioptions.NewConfigFlags(true)
+			kubeConfigFlags.AddFlags(cmd.PersistentFlags())
+
+			for _, s := range test.setStringFlags {
+				if err := cmd.PersistentFlags().Set(s.name, s.value); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := test.options.Validate(cmd, test.args)
+			if test.expErr != (err != nil) {
+				t.Errorf("expected error=%t got=%v",
+					test.expErr, err)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	crt := func(name string, suspend bool, labels map[string]string) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: labels},
+			Spec:       cmapi.CertificateSpec{Suspend: suspend},
+		}
+	}
+
+	tests := map[string]struct {
+		suspend       bool
+		labelSelector string
+		args          []string
+		expSuspended  map[string]bool
+		expOut        string
+	}{
+		"suspend named Certificates": {
+			suspend:      true,
+			args:         []string{"a", "b"},
+			expSuspended: map[string]bool{"a": true, "b": true, "c": false},
+			expOut:       "Certificate default/a suspended\nCertificate default/b is already suspended\n",
+		},
+		"suspend Certificates by label selector": {
+			suspend:       true,
+			labelSelector: "app=foo",
+			expSuspended:  map[string]bool{"a": false, "b": true, "c": true},
+			expOut:        "Certificate default/b is already suspended\nCertificate default/c suspended\n",
+		},
+		"resume named Certificates": {
+			suspend:      false,
+			args:         []string{"b"},
+			expSuspended: map[string]bool{"a": false, "b": false, "c": false},
+			expOut:       "Certificate default/b resumed\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmClient := cmfake.NewSimpleClientset(
+				crt("a", false, nil),
+				crt("b", true, map[string]string{"app": "foo"}),
+				crt("c", false, map[string]string{"app": "foo"}),
+			)
+			out := new(bytes.Buffer)
+			o := &Options{
+				LabelSelector: test.labelSelector,
+				Suspend:       test.suspend,
+				IOStreams:     genericclioptions.IOStreams{Out: out, ErrOut: out},
+				Factory:       &factory.Factory{Namespace: "default", CMClient: cmClient},
+			}
+
+			if err := o.Run(context.TODO(), test.args); err != nil {
+				t.Fatal(err)
+			}
+
+			if out.String() != test.expOut {
+				t.Errorf("unexpected output, exp=%q got=%q", test.expOut, out.String())
+			}
+
+			for name, exp := range test.expSuspended {
+				got, err := cmClient.CertmanagerV1().Certificates("default").Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Spec.Suspend != exp {
+					t.Errorf("expected Certificate %s to have suspend=%t, got %t", name, exp, got.Spec.Suspend)
+				}
+			}
+		})
+	}
+}
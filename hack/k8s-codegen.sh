@@ -59,6 +59,8 @@ deepcopy_inputs=(
   internal/apis/acme \
   pkg/apis/config/webhook/v1alpha1 \
   internal/apis/config/webhook \
+  pkg/apis/config/controller/v1alpha1 \
+  internal/apis/config/controller \
   pkg/apis/meta/v1 \
   internal/apis/meta \
   pkg/webhook/handlers/testdata/apis/testgroup/v2 \
@@ -86,6 +88,7 @@ defaulter_inputs=(
   internal/apis/acme/v1beta1 \
   internal/apis/acme/v1 \
   internal/apis/config/webhook/v1alpha1 \
+  internal/apis/config/controller/v1alpha1 \
   internal/apis/meta/v1 \
   pkg/webhook/handlers/testdata/apis/testgroup/v2 \
   pkg/webhook/handlers/testdata/apis/testgroup/v1 \
@@ -102,6 +105,7 @@ conversion_inputs=(
   internal/apis/acme/v1beta1 \
   internal/apis/acme/v1 \
   internal/apis/config/webhook/v1alpha1 \
+  internal/apis/config/controller/v1alpha1 \
   internal/apis/meta/v1 \
   pkg/webhook/handlers/testdata/apis/testgroup/v2 \
   pkg/webhook/handlers/testdata/apis/testgroup/v1 \
@@ -47,6 +47,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterIssuerList{},
 		&CertificateRequest{},
 		&CertificateRequestList{},
+		&NotificationPolicy{},
+		&NotificationPolicyList{},
+		&CertificateRequestPolicy{},
+		&CertificateRequestPolicyList{},
 	)
 	return nil
 }
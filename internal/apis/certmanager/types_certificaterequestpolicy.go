@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A CertificateRequestPolicy is evaluated by the opt-in policy approver to
+// decide whether CertificateRequests and CertificateSigningRequests should be
+// approved or denied. It is cluster-scoped and applies to every request whose
+// issuer and requester match its selector.
+//
+// A request which is matched by one or more policies is approved if at least
+// one of them allows it, and denied otherwise. Requests which are not matched
+// by any policy are left untouched.
+type CertificateRequestPolicy struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the CertificateRequestPolicy resource.
+	Spec CertificateRequestPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateRequestPolicyList is a list of CertificateRequestPolicies
+type CertificateRequestPolicyList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []CertificateRequestPolicy
+}
+
+// CertificateRequestPolicySpec defines which requests a policy applies to,
+// and which of those requests it allows.
+type CertificateRequestPolicySpec struct {
+	// Selector decides which requests this policy applies to.
+	Selector CertificateRequestPolicySelector
+
+	// Allowed lists the attributes a request may contain. Attributes which
+	// are not listed are not allowed, so a request containing a DNS name is
+	// only allowed if `dnsNames` is set and one of its patterns matches.
+	// Since every request has at least one usage, a policy without allowed
+	// attributes allows no requests.
+	Allowed *CertificateRequestPolicyAllowed
+
+	// Constraints restricts the duration and private key of allowed
+	// requests.
+	Constraints *CertificateRequestPolicyConstraints
+
+	// Validations are CEL expressions which must all evaluate to true for
+	// the policy to allow a request. Expressions are type checked and their
+	// cost is bounded when the policy is created. The following variables
+	// are available: `commonName`, `dnsNames`, `ipAddresses`, `uris`,
+	// `emailAddresses`, `subject` (a map of the other subject fields, such as
+	// `organizations`), `keyAlgorithm`, `keySize`, `duration`, `usages`,
+	// `isCA`, `username`, `groups`, `namespaceName`, `namespaceLabels`,
+	// `issuerName`, `issuerKind` and `issuerGroup`. For example:
+	// `dnsNames.all(n, n.endsWith("." + namespaceName + ".svc"))`.
+	Validations []CertificateRequestPolicyValidation
+}
+
+// CertificateRequestPolicyValidation is a CEL expression which an allowed
+// request must satisfy.
+type CertificateRequestPolicyValidation struct {
+	// Expression is a CEL expression which must evaluate to a bool.
+	Expression string
+
+	// Message describes the rule, and is used to name it when a request is
+	// denied because the expression evaluated to false. Defaults to the
+	// expression.
+	Message string
+}
+
+// CertificateRequestPolicySelector selects requests by the issuer they
+// reference and by the identity of the user who created them. A request must
+// match both the issuerRef and the requester selector.
+type CertificateRequestPolicySelector struct {
+	// IssuerRef matches the issuer referenced by the request. Each field may
+	// contain `*` wildcards; unset fields match every value. For
+	// CertificateSigningRequests the issuer is derived from the signer name.
+	// If unset, requests for every issuer match.
+	IssuerRef *CertificateRequestPolicyIssuerRefSelector
+
+	// Requester matches the identity of the user who created the request. If
+	// unset, requests from every user match.
+	Requester *CertificateRequestPolicyRequesterSelector
+}
+
+// CertificateRequestPolicyIssuerRefSelector matches the issuerRef of a
+// request.
+type CertificateRequestPolicyIssuerRefSelector struct {
+	// Name of the issuer. May contain `*` wildcards.
+	Name string
+
+	// Kind of the issuer. May contain `*` wildcards. A request with an empty
+	// kind refers to an Issuer.
+	Kind string
+
+	// Group of the issuer. May contain `*` wildcards. A request with an empty
+	// group refers to the `cert-manager.io` group.
+	Group string
+}
+
+// CertificateRequestPolicyRequesterSelector matches the identity of the user
+// who created a request, as recorded in its `username` and `groups` fields. A
+// request matches if its username matches one of `usernames` or one of its
+// groups matches one of `groups`.
+type CertificateRequestPolicyRequesterSelector struct {
+	// Usernames which match. May contain `*` wildcards, for example
+	// `system:serviceaccount:team-a:*`.
+	Usernames []string
+
+	// Groups which match. May contain `*` wildcards.
+	Groups []string
+}
+
+// CertificateRequestPolicyAllowed lists the attributes an allowed request may
+// contain. Patterns may contain `*` wildcards, which match any sequence of
+// characters.
+type CertificateRequestPolicyAllowed struct {
+	// CommonName is a pattern the common name of the request must match. If
+	// unset, requests must not have a common name.
+	CommonName *string
+
+	// DNSNames are patterns which every DNS name of the request must match
+	// at least one of.
+	DNSNames []string
+
+	// IPAddresses are patterns which every IP address of the request must
+	// match at least one of.
+	IPAddresses []string
+
+	// URIs are patterns which every URI of the request must match at least
+	// one of.
+	URIs []string
+
+	// EmailAddresses are patterns which every email address of the request
+	// must match at least one of.
+	EmailAddresses []string
+
+	// Usages which the request may contain. Requests which do not specify any
+	// usages are treated as requesting the default usages, `digital
+	// signature` and `key encipherment`.
+	Usages []KeyUsage
+
+	// IsCA allows requests for CA certificates. Defaults to false.
+	IsCA bool
+}
+
+// CertificateRequestPolicyConstraints restricts the duration and private key
+// of allowed requests.
+type CertificateRequestPolicyConstraints struct {
+	// MinDuration is the minimum duration a request may ask for.
+	MinDuration *metav1.Duration
+
+	// MaxDuration is the maximum duration a request may ask for. Requests
+	// which do not specify a duration are treated as asking for the default
+	// duration of 90 days.
+	MaxDuration *metav1.Duration
+
+	// PrivateKey restricts the private key of the request.
+	PrivateKey *CertificateRequestPolicyPrivateKeyConstraints
+}
+
+// CertificateRequestPolicyPrivateKeyConstraints restricts the public key
+// contained in the request.
+type CertificateRequestPolicyPrivateKeyConstraints struct {
+	// Algorithm the key must use.
+	Algorithm *PrivateKeyAlgorithm
+
+	// MinSize is the minimum size of the key in bits. Ignored for Ed25519
+	// keys.
+	MinSize *int
+
+	// MaxSize is the maximum size of the key in bits. Ignored for Ed25519
+	// keys.
+	MaxSize *int
+}
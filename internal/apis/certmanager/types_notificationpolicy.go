@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A NotificationPolicy configures which cert-manager events are sent as
+// CloudEvents to external HTTP sinks, such as a bridge to Slack or PagerDuty.
+// It is cluster-scoped and may select Certificates and Issuers in any
+// namespace.
+type NotificationPolicy struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the NotificationPolicy resource.
+	Spec NotificationPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationPolicyList is a list of NotificationPolicies
+type NotificationPolicyList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []NotificationPolicy
+}
+
+// NotificationPolicySpec defines which events are sent, for which resources
+// and to which sinks.
+type NotificationPolicySpec struct {
+	// Events is the list of event types to send.
+	Events []NotificationEventType
+
+	// NamespaceSelector restricts the policy to Certificates and Issuers in
+	// namespaces whose labels match the selector. ClusterIssuers are not
+	// namespaced and always match. If unset, resources in all namespaces
+	// match.
+	NamespaceSelector *metav1.LabelSelector
+
+	// IssuerRefs restricts the policy to Certificates issued by, and events
+	// about, the referenced issuers. An empty `kind` refers to an Issuer. If
+	// unset, all issuers match.
+	IssuerRefs []cmmeta.ObjectReference
+
+	// NearingExpiryThreshold is how long before a certificate expires a
+	// `NearingExpiry` event is sent. Defaults to 14 days (`336h`).
+	NearingExpiryThreshold *metav1.Duration
+
+	// Sinks to which every matching event is sent.
+	Sinks []NotificationSink
+
+	// Retry configures how failed deliveries to sinks are retried.
+	Retry *NotificationRetryPolicy
+}
+
+// NotificationEventType is a type of event that can be sent by a
+// NotificationPolicy.
+type NotificationEventType string
+
+const (
+	// NotificationEventIssued is sent when a new certificate has been issued
+	// for a Certificate.
+	NotificationEventIssued NotificationEventType = "Issued"
+
+	// NotificationEventFailed is sent when an issuance attempt for a
+	// Certificate has failed.
+	NotificationEventFailed NotificationEventType = "Failed"
+
+	// NotificationEventNearingExpiry is sent once per certificate when it is
+	// within the policy's nearingExpiryThreshold of its expiry.
+	NotificationEventNearingExpiry NotificationEventType = "NearingExpiry"
+
+	// NotificationEventIssuerNotReady is sent when an Issuer or ClusterIssuer
+	// stops being Ready.
+	NotificationEventIssuerNotReady NotificationEventType = "IssuerNotReady"
+)
+
+// NotificationSink is an HTTP endpoint that receives CloudEvents.
+type NotificationSink struct {
+	// Name of the sink, used to identify it in logs and metrics.
+	Name string
+
+	// URL to which events are sent as CloudEvents in structured content
+	// mode, using HTTP POST.
+	URL string
+
+	// CABundle is a PEM encoded bundle of CA certificates used to verify
+	// the sink's serving certificate. If unset, the system trust store is
+	// used.
+	CABundle []byte
+}
+
+// NotificationRetryPolicy configures how failed deliveries are retried.
+// Events that still cannot be delivered after all attempts are dropped and
+// counted by the `certmanager_notification_dead_letter_count` metric.
+type NotificationRetryPolicy struct {
+	// MaxAttempts is the maximum number of delivery attempts per event and
+	// sink, including the first one. Defaults to 5.
+	MaxAttempts *int32
+
+	// Backoff is the delay before the first retry. It doubles with every
+	// subsequent retry. Defaults to 1s.
+	Backoff *metav1.Duration
+}
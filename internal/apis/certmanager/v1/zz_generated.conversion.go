@@ -84,6 +84,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateHistoryEntry)(nil), (*certmanager.CertificateHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(a.(*v1.CertificateHistoryEntry), b.(*certmanager.CertificateHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateHistoryEntry)(nil), (*v1.CertificateHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateHistoryEntry_To_v1_CertificateHistoryEntry(a.(*certmanager.CertificateHistoryEntry), b.(*v1.CertificateHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*v1.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -144,6 +154,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicy)(nil), (*certmanager.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(a.(*v1.CertificateRequestPolicy), b.(*certmanager.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicy)(nil), (*v1.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(a.(*certmanager.CertificateRequestPolicy), b.(*v1.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyAllowed)(nil), (*certmanager.CertificateRequestPolicyAllowed)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(a.(*v1.CertificateRequestPolicyAllowed), b.(*certmanager.CertificateRequestPolicyAllowed), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyAllowed)(nil), (*v1.CertificateRequestPolicyAllowed)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(a.(*certmanager.CertificateRequestPolicyAllowed), b.(*v1.CertificateRequestPolicyAllowed), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyConstraints)(nil), (*certmanager.CertificateRequestPolicyConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyConstraints_To_certmanager_CertificateRequestPolicyConstraints(a.(*v1.CertificateRequestPolicyConstraints), b.(*certmanager.CertificateRequestPolicyConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyConstraints)(nil), (*v1.CertificateRequestPolicyConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyConstraints_To_v1_CertificateRequestPolicyConstraints(a.(*certmanager.CertificateRequestPolicyConstraints), b.(*v1.CertificateRequestPolicyConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyIssuerRefSelector)(nil), (*certmanager.CertificateRequestPolicyIssuerRefSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyIssuerRefSelector_To_certmanager_CertificateRequestPolicyIssuerRefSelector(a.(*v1.CertificateRequestPolicyIssuerRefSelector), b.(*certmanager.CertificateRequestPolicyIssuerRefSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyIssuerRefSelector)(nil), (*v1.CertificateRequestPolicyIssuerRefSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyIssuerRefSelector_To_v1_CertificateRequestPolicyIssuerRefSelector(a.(*certmanager.CertificateRequestPolicyIssuerRefSelector), b.(*v1.CertificateRequestPolicyIssuerRefSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyList)(nil), (*certmanager.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(a.(*v1.CertificateRequestPolicyList), b.(*certmanager.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyList)(nil), (*v1.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(a.(*certmanager.CertificateRequestPolicyList), b.(*v1.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyPrivateKeyConstraints)(nil), (*certmanager.CertificateRequestPolicyPrivateKeyConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyPrivateKeyConstraints_To_certmanager_CertificateRequestPolicyPrivateKeyConstraints(a.(*v1.CertificateRequestPolicyPrivateKeyConstraints), b.(*certmanager.CertificateRequestPolicyPrivateKeyConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyPrivateKeyConstraints)(nil), (*v1.CertificateRequestPolicyPrivateKeyConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyPrivateKeyConstraints_To_v1_CertificateRequestPolicyPrivateKeyConstraints(a.(*certmanager.CertificateRequestPolicyPrivateKeyConstraints), b.(*v1.CertificateRequestPolicyPrivateKeyConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyRequesterSelector)(nil), (*certmanager.CertificateRequestPolicyRequesterSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyRequesterSelector_To_certmanager_CertificateRequestPolicyRequesterSelector(a.(*v1.CertificateRequestPolicyRequesterSelector), b.(*certmanager.CertificateRequestPolicyRequesterSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyRequesterSelector)(nil), (*v1.CertificateRequestPolicyRequesterSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyRequesterSelector_To_v1_CertificateRequestPolicyRequesterSelector(a.(*certmanager.CertificateRequestPolicyRequesterSelector), b.(*v1.CertificateRequestPolicyRequesterSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicySelector)(nil), (*certmanager.CertificateRequestPolicySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(a.(*v1.CertificateRequestPolicySelector), b.(*certmanager.CertificateRequestPolicySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicySelector)(nil), (*v1.CertificateRequestPolicySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(a.(*certmanager.CertificateRequestPolicySelector), b.(*v1.CertificateRequestPolicySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicySpec)(nil), (*certmanager.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(a.(*v1.CertificateRequestPolicySpec), b.(*certmanager.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicySpec)(nil), (*v1.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(a.(*certmanager.CertificateRequestPolicySpec), b.(*v1.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyValidation)(nil), (*certmanager.CertificateRequestPolicyValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyValidation_To_certmanager_CertificateRequestPolicyValidation(a.(*v1.CertificateRequestPolicyValidation), b.(*certmanager.CertificateRequestPolicyValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyValidation)(nil), (*v1.CertificateRequestPolicyValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyValidation_To_v1_CertificateRequestPolicyValidation(a.(*certmanager.CertificateRequestPolicyValidation), b.(*v1.CertificateRequestPolicyValidation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestSpec)(nil), (*certmanager.CertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(a.(*v1.CertificateRequestSpec), b.(*certmanager.CertificateRequestSpec), scope)
 	}); err != nil {
@@ -244,6 +354,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerRateLimit)(nil), (*certmanager.IssuerRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerRateLimit_To_certmanager_IssuerRateLimit(a.(*v1.IssuerRateLimit), b.(*certmanager.IssuerRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerRateLimit)(nil), (*v1.IssuerRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerRateLimit_To_v1_IssuerRateLimit(a.(*certmanager.IssuerRateLimit), b.(*v1.IssuerRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(a.(*v1.IssuerSpec), b.(*certmanager.IssuerSpec), scope)
 	}); err != nil {
@@ -274,6 +394,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationPolicy)(nil), (*certmanager.NotificationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationPolicy_To_certmanager_NotificationPolicy(a.(*v1.NotificationPolicy), b.(*certmanager.NotificationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationPolicy)(nil), (*v1.NotificationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationPolicy_To_v1_NotificationPolicy(a.(*certmanager.NotificationPolicy), b.(*v1.NotificationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationPolicyList)(nil), (*certmanager.NotificationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationPolicyList_To_certmanager_NotificationPolicyList(a.(*v1.NotificationPolicyList), b.(*certmanager.NotificationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationPolicyList)(nil), (*v1.NotificationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationPolicyList_To_v1_NotificationPolicyList(a.(*certmanager.NotificationPolicyList), b.(*v1.NotificationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationPolicySpec)(nil), (*certmanager.NotificationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationPolicySpec_To_certmanager_NotificationPolicySpec(a.(*v1.NotificationPolicySpec), b.(*certmanager.NotificationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationPolicySpec)(nil), (*v1.NotificationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationPolicySpec_To_v1_NotificationPolicySpec(a.(*certmanager.NotificationPolicySpec), b.(*v1.NotificationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationRetryPolicy)(nil), (*certmanager.NotificationRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationRetryPolicy_To_certmanager_NotificationRetryPolicy(a.(*v1.NotificationRetryPolicy), b.(*certmanager.NotificationRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationRetryPolicy)(nil), (*v1.NotificationRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationRetryPolicy_To_v1_NotificationRetryPolicy(a.(*certmanager.NotificationRetryPolicy), b.(*v1.NotificationRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationSink)(nil), (*certmanager.NotificationSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationSink_To_certmanager_NotificationSink(a.(*v1.NotificationSink), b.(*certmanager.NotificationSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationSink)(nil), (*v1.NotificationSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationSink_To_v1_NotificationSink(a.(*certmanager.NotificationSink), b.(*v1.NotificationSink), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.PKCS12Keystore)(nil), (*certmanager.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(a.(*v1.PKCS12Keystore), b.(*certmanager.PKCS12Keystore), scope)
 	}); err != nil {
@@ -284,6 +454,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.RenewalWindow)(nil), (*certmanager.RenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RenewalWindow_To_certmanager_RenewalWindow(a.(*v1.RenewalWindow), b.(*certmanager.RenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RenewalWindow)(nil), (*v1.RenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RenewalWindow_To_v1_RenewalWindow(a.(*certmanager.RenewalWindow), b.(*v1.RenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.RenewalWindows)(nil), (*certmanager.RenewalWindows)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RenewalWindows_To_certmanager_RenewalWindows(a.(*v1.RenewalWindows), b.(*certmanager.RenewalWindows), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RenewalWindows)(nil), (*v1.RenewalWindows)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RenewalWindows_To_v1_RenewalWindows(a.(*certmanager.RenewalWindows), b.(*v1.RenewalWindows), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*v1.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -503,6 +693,44 @@ func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *cer
 	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
 }
 
+func autoConvert_v1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(in *v1.CertificateHistoryEntry, out *certmanager.CertificateHistoryEntry, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.SerialNumber = in.SerialNumber
+	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
+	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.PublicKeyFingerprint = in.PublicKeyFingerprint
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry is an autogenerated conversion function.
+func Convert_v1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(in *v1.CertificateHistoryEntry, out *certmanager.CertificateHistoryEntry, s conversion.Scope) error {
+	return autoConvert_v1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateHistoryEntry_To_v1_CertificateHistoryEntry(in *certmanager.CertificateHistoryEntry, out *v1.CertificateHistoryEntry, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.SerialNumber = in.SerialNumber
+	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
+	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.PublicKeyFingerprint = in.PublicKeyFingerprint
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateHistoryEntry_To_v1_CertificateHistoryEntry is an autogenerated conversion function.
+func Convert_certmanager_CertificateHistoryEntry_To_v1_CertificateHistoryEntry(in *certmanager.CertificateHistoryEntry, out *v1.CertificateHistoryEntry, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateHistoryEntry_To_v1_CertificateHistoryEntry(in, out, s)
+}
+
 func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
 	if in.JKS != nil {
 		in, out := &in.JKS, &out.JKS
@@ -727,6 +955,254 @@ func Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in
 	return autoConvert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in, out, s)
 }
 
+func autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *v1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *v1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *v1.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *v1.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(in *v1.CertificateRequestPolicyAllowed, out *certmanager.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	out.CommonName = (*string)(unsafe.Pointer(in.CommonName))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.IsCA = in.IsCA
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(in *v1.CertificateRequestPolicyAllowed, out *certmanager.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(in *certmanager.CertificateRequestPolicyAllowed, out *v1.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	out.CommonName = (*string)(unsafe.Pointer(in.CommonName))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.IsCA = in.IsCA
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(in *certmanager.CertificateRequestPolicyAllowed, out *v1.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyConstraints_To_certmanager_CertificateRequestPolicyConstraints(in *v1.CertificateRequestPolicyConstraints, out *certmanager.CertificateRequestPolicyConstraints, s conversion.Scope) error {
+	out.MinDuration = (*metav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.PrivateKey = (*certmanager.CertificateRequestPolicyPrivateKeyConstraints)(unsafe.Pointer(in.PrivateKey))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyConstraints_To_certmanager_CertificateRequestPolicyConstraints is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyConstraints_To_certmanager_CertificateRequestPolicyConstraints(in *v1.CertificateRequestPolicyConstraints, out *certmanager.CertificateRequestPolicyConstraints, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyConstraints_To_certmanager_CertificateRequestPolicyConstraints(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyConstraints_To_v1_CertificateRequestPolicyConstraints(in *certmanager.CertificateRequestPolicyConstraints, out *v1.CertificateRequestPolicyConstraints, s conversion.Scope) error {
+	out.MinDuration = (*metav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.PrivateKey = (*v1.CertificateRequestPolicyPrivateKeyConstraints)(unsafe.Pointer(in.PrivateKey))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyConstraints_To_v1_CertificateRequestPolicyConstraints is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyConstraints_To_v1_CertificateRequestPolicyConstraints(in *certmanager.CertificateRequestPolicyConstraints, out *v1.CertificateRequestPolicyConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyConstraints_To_v1_CertificateRequestPolicyConstraints(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyIssuerRefSelector_To_certmanager_CertificateRequestPolicyIssuerRefSelector(in *v1.CertificateRequestPolicyIssuerRefSelector, out *certmanager.CertificateRequestPolicyIssuerRefSelector, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyIssuerRefSelector_To_certmanager_CertificateRequestPolicyIssuerRefSelector is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyIssuerRefSelector_To_certmanager_CertificateRequestPolicyIssuerRefSelector(in *v1.CertificateRequestPolicyIssuerRefSelector, out *certmanager.CertificateRequestPolicyIssuerRefSelector, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyIssuerRefSelector_To_certmanager_CertificateRequestPolicyIssuerRefSelector(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyIssuerRefSelector_To_v1_CertificateRequestPolicyIssuerRefSelector(in *certmanager.CertificateRequestPolicyIssuerRefSelector, out *v1.CertificateRequestPolicyIssuerRefSelector, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyIssuerRefSelector_To_v1_CertificateRequestPolicyIssuerRefSelector is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyIssuerRefSelector_To_v1_CertificateRequestPolicyIssuerRefSelector(in *certmanager.CertificateRequestPolicyIssuerRefSelector, out *v1.CertificateRequestPolicyIssuerRefSelector, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyIssuerRefSelector_To_v1_CertificateRequestPolicyIssuerRefSelector(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *v1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *v1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *v1.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *v1.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyPrivateKeyConstraints_To_certmanager_CertificateRequestPolicyPrivateKeyConstraints(in *v1.CertificateRequestPolicyPrivateKeyConstraints, out *certmanager.CertificateRequestPolicyPrivateKeyConstraints, s conversion.Scope) error {
+	out.Algorithm = (*certmanager.PrivateKeyAlgorithm)(unsafe.Pointer(in.Algorithm))
+	out.MinSize = (*int)(unsafe.Pointer(in.MinSize))
+	out.MaxSize = (*int)(unsafe.Pointer(in.MaxSize))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyPrivateKeyConstraints_To_certmanager_CertificateRequestPolicyPrivateKeyConstraints is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyPrivateKeyConstraints_To_certmanager_CertificateRequestPolicyPrivateKeyConstraints(in *v1.CertificateRequestPolicyPrivateKeyConstraints, out *certmanager.CertificateRequestPolicyPrivateKeyConstraints, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyPrivateKeyConstraints_To_certmanager_CertificateRequestPolicyPrivateKeyConstraints(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyPrivateKeyConstraints_To_v1_CertificateRequestPolicyPrivateKeyConstraints(in *certmanager.CertificateRequestPolicyPrivateKeyConstraints, out *v1.CertificateRequestPolicyPrivateKeyConstraints, s conversion.Scope) error {
+	out.Algorithm = (*v1.PrivateKeyAlgorithm)(unsafe.Pointer(in.Algorithm))
+	out.MinSize = (*int)(unsafe.Pointer(in.MinSize))
+	out.MaxSize = (*int)(unsafe.Pointer(in.MaxSize))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyPrivateKeyConstraints_To_v1_CertificateRequestPolicyPrivateKeyConstraints is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyPrivateKeyConstraints_To_v1_CertificateRequestPolicyPrivateKeyConstraints(in *certmanager.CertificateRequestPolicyPrivateKeyConstraints, out *v1.CertificateRequestPolicyPrivateKeyConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyPrivateKeyConstraints_To_v1_CertificateRequestPolicyPrivateKeyConstraints(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyRequesterSelector_To_certmanager_CertificateRequestPolicyRequesterSelector(in *v1.CertificateRequestPolicyRequesterSelector, out *certmanager.CertificateRequestPolicyRequesterSelector, s conversion.Scope) error {
+	out.Usernames = *(*[]string)(unsafe.Pointer(&in.Usernames))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyRequesterSelector_To_certmanager_CertificateRequestPolicyRequesterSelector is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyRequesterSelector_To_certmanager_CertificateRequestPolicyRequesterSelector(in *v1.CertificateRequestPolicyRequesterSelector, out *certmanager.CertificateRequestPolicyRequesterSelector, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyRequesterSelector_To_certmanager_CertificateRequestPolicyRequesterSelector(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyRequesterSelector_To_v1_CertificateRequestPolicyRequesterSelector(in *certmanager.CertificateRequestPolicyRequesterSelector, out *v1.CertificateRequestPolicyRequesterSelector, s conversion.Scope) error {
+	out.Usernames = *(*[]string)(unsafe.Pointer(&in.Usernames))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyRequesterSelector_To_v1_CertificateRequestPolicyRequesterSelector is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyRequesterSelector_To_v1_CertificateRequestPolicyRequesterSelector(in *certmanager.CertificateRequestPolicyRequesterSelector, out *v1.CertificateRequestPolicyRequesterSelector, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyRequesterSelector_To_v1_CertificateRequestPolicyRequesterSelector(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(in *v1.CertificateRequestPolicySelector, out *certmanager.CertificateRequestPolicySelector, s conversion.Scope) error {
+	out.IssuerRef = (*certmanager.CertificateRequestPolicyIssuerRefSelector)(unsafe.Pointer(in.IssuerRef))
+	out.Requester = (*certmanager.CertificateRequestPolicyRequesterSelector)(unsafe.Pointer(in.Requester))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(in *v1.CertificateRequestPolicySelector, out *certmanager.CertificateRequestPolicySelector, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(in *certmanager.CertificateRequestPolicySelector, out *v1.CertificateRequestPolicySelector, s conversion.Scope) error {
+	out.IssuerRef = (*v1.CertificateRequestPolicyIssuerRefSelector)(unsafe.Pointer(in.IssuerRef))
+	out.Requester = (*v1.CertificateRequestPolicyRequesterSelector)(unsafe.Pointer(in.Requester))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(in *certmanager.CertificateRequestPolicySelector, out *v1.CertificateRequestPolicySelector, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *v1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	if err := Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	out.Allowed = (*certmanager.CertificateRequestPolicyAllowed)(unsafe.Pointer(in.Allowed))
+	out.Constraints = (*certmanager.CertificateRequestPolicyConstraints)(unsafe.Pointer(in.Constraints))
+	out.Validations = *(*[]certmanager.CertificateRequestPolicyValidation)(unsafe.Pointer(&in.Validations))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *v1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *v1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	if err := Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	out.Allowed = (*v1.CertificateRequestPolicyAllowed)(unsafe.Pointer(in.Allowed))
+	out.Constraints = (*v1.CertificateRequestPolicyConstraints)(unsafe.Pointer(in.Constraints))
+	out.Validations = *(*[]v1.CertificateRequestPolicyValidation)(unsafe.Pointer(&in.Validations))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *v1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyValidation_To_certmanager_CertificateRequestPolicyValidation(in *v1.CertificateRequestPolicyValidation, out *certmanager.CertificateRequestPolicyValidation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyValidation_To_certmanager_CertificateRequestPolicyValidation is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyValidation_To_certmanager_CertificateRequestPolicyValidation(in *v1.CertificateRequestPolicyValidation, out *certmanager.CertificateRequestPolicyValidation, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyValidation_To_certmanager_CertificateRequestPolicyValidation(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyValidation_To_v1_CertificateRequestPolicyValidation(in *certmanager.CertificateRequestPolicyValidation, out *v1.CertificateRequestPolicyValidation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyValidation_To_v1_CertificateRequestPolicyValidation is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyValidation_To_v1_CertificateRequestPolicyValidation(in *certmanager.CertificateRequestPolicyValidation, out *v1.CertificateRequestPolicyValidation, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyValidation_To_v1_CertificateRequestPolicyValidation(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
@@ -845,6 +1321,8 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.Suspend = in.Suspend
+	out.RenewalWindows = (*certmanager.RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
 	return nil
 }
 
@@ -878,6 +1356,8 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.Suspend = in.Suspend
+	out.RenewalWindows = (*v1.RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
 	return nil
 }
 
@@ -890,6 +1370,17 @@ func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.Ce
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]certmanager.CertificateHistoryEntry, len(*in))
+		for i := range *in {
+			if err := Convert_v1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.History = nil
+	}
 	return nil
 }
 
@@ -907,6 +1398,17 @@ func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certm
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]v1.CertificateHistoryEntry, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateHistoryEntry_To_v1_CertificateHistoryEntry(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.History = nil
+	}
 	return nil
 }
 
@@ -1169,10 +1671,39 @@ func Convert_certmanager_IssuerList_To_v1_IssuerList(in *certmanager.IssuerList,
 	return autoConvert_certmanager_IssuerList_To_v1_IssuerList(in, out, s)
 }
 
+func autoConvert_v1_IssuerRateLimit_To_certmanager_IssuerRateLimit(in *v1.IssuerRateLimit, out *certmanager.IssuerRateLimit, s conversion.Scope) error {
+	out.Requests = (*int32)(unsafe.Pointer(in.Requests))
+	out.Period = (*metav1.Duration)(unsafe.Pointer(in.Period))
+	out.Burst = (*int32)(unsafe.Pointer(in.Burst))
+	out.MaxInFlight = (*int32)(unsafe.Pointer(in.MaxInFlight))
+	return nil
+}
+
+// Convert_v1_IssuerRateLimit_To_certmanager_IssuerRateLimit is an autogenerated conversion function.
+func Convert_v1_IssuerRateLimit_To_certmanager_IssuerRateLimit(in *v1.IssuerRateLimit, out *certmanager.IssuerRateLimit, s conversion.Scope) error {
+	return autoConvert_v1_IssuerRateLimit_To_certmanager_IssuerRateLimit(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerRateLimit_To_v1_IssuerRateLimit(in *certmanager.IssuerRateLimit, out *v1.IssuerRateLimit, s conversion.Scope) error {
+	out.Requests = (*int32)(unsafe.Pointer(in.Requests))
+	out.Period = (*metav1.Duration)(unsafe.Pointer(in.Period))
+	out.Burst = (*int32)(unsafe.Pointer(in.Burst))
+	out.MaxInFlight = (*int32)(unsafe.Pointer(in.MaxInFlight))
+	return nil
+}
+
+// Convert_certmanager_IssuerRateLimit_To_v1_IssuerRateLimit is an autogenerated conversion function.
+func Convert_certmanager_IssuerRateLimit_To_v1_IssuerRateLimit(in *certmanager.IssuerRateLimit, out *v1.IssuerRateLimit, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerRateLimit_To_v1_IssuerRateLimit(in, out, s)
+}
+
 func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RateLimit = (*certmanager.IssuerRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.RenewalWindows = (*certmanager.RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -1185,6 +1716,9 @@ func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerS
 	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RateLimit = (*v1.IssuerRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.RenewalWindows = (*v1.RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -1241,6 +1775,170 @@ func Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeysto
 	return autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in, out, s)
 }
 
+func autoConvert_v1_NotificationPolicy_To_certmanager_NotificationPolicy(in *v1.NotificationPolicy, out *certmanager.NotificationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_NotificationPolicySpec_To_certmanager_NotificationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NotificationPolicy_To_certmanager_NotificationPolicy is an autogenerated conversion function.
+func Convert_v1_NotificationPolicy_To_certmanager_NotificationPolicy(in *v1.NotificationPolicy, out *certmanager.NotificationPolicy, s conversion.Scope) error {
+	return autoConvert_v1_NotificationPolicy_To_certmanager_NotificationPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationPolicy_To_v1_NotificationPolicy(in *certmanager.NotificationPolicy, out *v1.NotificationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_NotificationPolicySpec_To_v1_NotificationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_NotificationPolicy_To_v1_NotificationPolicy is an autogenerated conversion function.
+func Convert_certmanager_NotificationPolicy_To_v1_NotificationPolicy(in *certmanager.NotificationPolicy, out *v1.NotificationPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationPolicy_To_v1_NotificationPolicy(in, out, s)
+}
+
+func autoConvert_v1_NotificationPolicyList_To_certmanager_NotificationPolicyList(in *v1.NotificationPolicyList, out *certmanager.NotificationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.NotificationPolicy, len(*in))
+		for i := range *in {
+			if err := Convert_v1_NotificationPolicy_To_certmanager_NotificationPolicy(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_NotificationPolicyList_To_certmanager_NotificationPolicyList is an autogenerated conversion function.
+func Convert_v1_NotificationPolicyList_To_certmanager_NotificationPolicyList(in *v1.NotificationPolicyList, out *certmanager.NotificationPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_NotificationPolicyList_To_certmanager_NotificationPolicyList(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationPolicyList_To_v1_NotificationPolicyList(in *certmanager.NotificationPolicyList, out *v1.NotificationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.NotificationPolicy, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_NotificationPolicy_To_v1_NotificationPolicy(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_NotificationPolicyList_To_v1_NotificationPolicyList is an autogenerated conversion function.
+func Convert_certmanager_NotificationPolicyList_To_v1_NotificationPolicyList(in *certmanager.NotificationPolicyList, out *v1.NotificationPolicyList, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationPolicyList_To_v1_NotificationPolicyList(in, out, s)
+}
+
+func autoConvert_v1_NotificationPolicySpec_To_certmanager_NotificationPolicySpec(in *v1.NotificationPolicySpec, out *certmanager.NotificationPolicySpec, s conversion.Scope) error {
+	out.Events = *(*[]certmanager.NotificationEventType)(unsafe.Pointer(&in.Events))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	if in.IssuerRefs != nil {
+		in, out := &in.IssuerRefs, &out.IssuerRefs
+		*out = make([]meta.ObjectReference, len(*in))
+		for i := range *in {
+			if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IssuerRefs = nil
+	}
+	out.NearingExpiryThreshold = (*metav1.Duration)(unsafe.Pointer(in.NearingExpiryThreshold))
+	out.Sinks = *(*[]certmanager.NotificationSink)(unsafe.Pointer(&in.Sinks))
+	out.Retry = (*certmanager.NotificationRetryPolicy)(unsafe.Pointer(in.Retry))
+	return nil
+}
+
+// Convert_v1_NotificationPolicySpec_To_certmanager_NotificationPolicySpec is an autogenerated conversion function.
+func Convert_v1_NotificationPolicySpec_To_certmanager_NotificationPolicySpec(in *v1.NotificationPolicySpec, out *certmanager.NotificationPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_NotificationPolicySpec_To_certmanager_NotificationPolicySpec(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationPolicySpec_To_v1_NotificationPolicySpec(in *certmanager.NotificationPolicySpec, out *v1.NotificationPolicySpec, s conversion.Scope) error {
+	out.Events = *(*[]v1.NotificationEventType)(unsafe.Pointer(&in.Events))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	if in.IssuerRefs != nil {
+		in, out := &in.IssuerRefs, &out.IssuerRefs
+		*out = make([]apismetav1.ObjectReference, len(*in))
+		for i := range *in {
+			if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IssuerRefs = nil
+	}
+	out.NearingExpiryThreshold = (*metav1.Duration)(unsafe.Pointer(in.NearingExpiryThreshold))
+	out.Sinks = *(*[]v1.NotificationSink)(unsafe.Pointer(&in.Sinks))
+	out.Retry = (*v1.NotificationRetryPolicy)(unsafe.Pointer(in.Retry))
+	return nil
+}
+
+// Convert_certmanager_NotificationPolicySpec_To_v1_NotificationPolicySpec is an autogenerated conversion function.
+func Convert_certmanager_NotificationPolicySpec_To_v1_NotificationPolicySpec(in *certmanager.NotificationPolicySpec, out *v1.NotificationPolicySpec, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationPolicySpec_To_v1_NotificationPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_NotificationRetryPolicy_To_certmanager_NotificationRetryPolicy(in *v1.NotificationRetryPolicy, out *certmanager.NotificationRetryPolicy, s conversion.Scope) error {
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	out.Backoff = (*metav1.Duration)(unsafe.Pointer(in.Backoff))
+	return nil
+}
+
+// Convert_v1_NotificationRetryPolicy_To_certmanager_NotificationRetryPolicy is an autogenerated conversion function.
+func Convert_v1_NotificationRetryPolicy_To_certmanager_NotificationRetryPolicy(in *v1.NotificationRetryPolicy, out *certmanager.NotificationRetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRetryPolicy_To_certmanager_NotificationRetryPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationRetryPolicy_To_v1_NotificationRetryPolicy(in *certmanager.NotificationRetryPolicy, out *v1.NotificationRetryPolicy, s conversion.Scope) error {
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	out.Backoff = (*metav1.Duration)(unsafe.Pointer(in.Backoff))
+	return nil
+}
+
+// Convert_certmanager_NotificationRetryPolicy_To_v1_NotificationRetryPolicy is an autogenerated conversion function.
+func Convert_certmanager_NotificationRetryPolicy_To_v1_NotificationRetryPolicy(in *certmanager.NotificationRetryPolicy, out *v1.NotificationRetryPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationRetryPolicy_To_v1_NotificationRetryPolicy(in, out, s)
+}
+
+func autoConvert_v1_NotificationSink_To_certmanager_NotificationSink(in *v1.NotificationSink, out *certmanager.NotificationSink, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1_NotificationSink_To_certmanager_NotificationSink is an autogenerated conversion function.
+func Convert_v1_NotificationSink_To_certmanager_NotificationSink(in *v1.NotificationSink, out *certmanager.NotificationSink, s conversion.Scope) error {
+	return autoConvert_v1_NotificationSink_To_certmanager_NotificationSink(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationSink_To_v1_NotificationSink(in *certmanager.NotificationSink, out *v1.NotificationSink, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_certmanager_NotificationSink_To_v1_NotificationSink is an autogenerated conversion function.
+func Convert_certmanager_NotificationSink_To_v1_NotificationSink(in *certmanager.NotificationSink, out *v1.NotificationSink, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationSink_To_v1_NotificationSink(in, out, s)
+}
+
 func autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
 	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
@@ -1267,6 +1965,50 @@ func Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKC
 	return autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in, out, s)
 }
 
+func autoConvert_v1_RenewalWindow_To_certmanager_RenewalWindow(in *v1.RenewalWindow, out *certmanager.RenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1_RenewalWindow_To_certmanager_RenewalWindow is an autogenerated conversion function.
+func Convert_v1_RenewalWindow_To_certmanager_RenewalWindow(in *v1.RenewalWindow, out *certmanager.RenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1_RenewalWindow_To_certmanager_RenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_RenewalWindow_To_v1_RenewalWindow(in *certmanager.RenewalWindow, out *v1.RenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_certmanager_RenewalWindow_To_v1_RenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_RenewalWindow_To_v1_RenewalWindow(in *certmanager.RenewalWindow, out *v1.RenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_RenewalWindow_To_v1_RenewalWindow(in, out, s)
+}
+
+func autoConvert_v1_RenewalWindows_To_certmanager_RenewalWindows(in *v1.RenewalWindows, out *certmanager.RenewalWindows, s conversion.Scope) error {
+	out.Windows = *(*[]certmanager.RenewalWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1_RenewalWindows_To_certmanager_RenewalWindows is an autogenerated conversion function.
+func Convert_v1_RenewalWindows_To_certmanager_RenewalWindows(in *v1.RenewalWindows, out *certmanager.RenewalWindows, s conversion.Scope) error {
+	return autoConvert_v1_RenewalWindows_To_certmanager_RenewalWindows(in, out, s)
+}
+
+func autoConvert_certmanager_RenewalWindows_To_v1_RenewalWindows(in *certmanager.RenewalWindows, out *v1.RenewalWindows, s conversion.Scope) error {
+	out.Windows = *(*[]v1.RenewalWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_RenewalWindows_To_v1_RenewalWindows is an autogenerated conversion function.
+func Convert_certmanager_RenewalWindows_To_v1_RenewalWindows(in *certmanager.RenewalWindows, out *v1.RenewalWindows, s conversion.Scope) error {
+	return autoConvert_certmanager_RenewalWindows_To_v1_RenewalWindows(in, out, s)
+}
+
 func autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	return nil
@@ -87,6 +87,54 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// RateLimit configures the rate and concurrency at which CertificateRequests
+	// and CertificateSigningRequests referencing this issuer are signed.
+	// Requests exceeding the budget are left Pending until budget becomes
+	// available. Budgets are enforced by each cert-manager controller replica.
+	// +optional
+	RateLimit *IssuerRateLimit `json:"rateLimit,omitempty"`
+
+	// RenewalWindows are the default maintenance windows used to restrict
+	// when certificates issued by this issuer are renewed. Certificates may
+	// override them using `spec.renewalWindows`.
+	// +optional
+	RenewalWindows *RenewalWindows `json:"renewalWindows,omitempty"`
+
+	// NamespaceSelector restricts the namespaces whose CertificateRequests
+	// may use this issuer to those with matching labels. CertificateRequests
+	// in other namespaces are rejected by the webhook and marked as Failed by
+	// the controller. Only supported on ClusterIssuers. If unset,
+	// CertificateRequests in all namespaces may use the ClusterIssuer.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// IssuerRateLimit configures a token bucket rate limit and a maximum number of
+// concurrent signing requests for an issuer.
+type IssuerRateLimit struct {
+	// Requests is the number of signing requests permitted every period.
+	// If unset, the rate of signing requests is not limited.
+	// +optional
+	Requests *int32 `json:"requests,omitempty"`
+
+	// Period over which Requests signing requests are permitted.
+	// Defaults to 1m.
+	// +optional
+	Period *metav1.Duration `json:"period,omitempty"`
+
+	// Burst is the maximum number of signing requests that may be made at
+	// once after a period of inactivity.
+	// Defaults to Requests.
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+
+	// MaxInFlight is the maximum number of signing requests that may be in
+	// progress at the same time. A signing request is in progress until it
+	// has been issued, has failed or has been denied.
+	// If unset, the number of concurrent signing requests is not limited.
+	// +optional
+	MaxInFlight *int32 `json:"maxInFlight,omitempty"`
 }
 
 // The configuration for the issuer.
@@ -221,6 +221,22 @@ type CertificateSpec struct {
 	// the controller and webhook components.
 	// +optional
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
+
+	// Suspend, if set to true, stops cert-manager from renewing or reissuing
+	// this Certificate. No new CertificateRequests will be created for it
+	// until Suspend is set to false, and its `Suspended` condition will be
+	// set to true. The existing certificate in the target Secret is left
+	// untouched.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// RenewalWindows restricts renewal of the certificate to recurring
+	// maintenance windows. Renewal is scheduled at the start of the last
+	// window before the computed renewal time. If no window opens before the
+	// certificate expires, it is renewed at the computed renewal time anyway.
+	// If unset, the renewal windows of the referenced issuer are used.
+	// +optional
+	RenewalWindows *RenewalWindows `json:"renewalWindows,omitempty"`
 }
 
 // CertificatePrivateKey contains configuration options for private keys
@@ -398,6 +414,14 @@ type CertificateStatus struct {
 	// time.Hour * 2 ^ (failedIssuanceAttempts - 1).
 	// +optional
 	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
+
+	// History of the most recently issued revisions of the certificate,
+	// newest first. It is maintained by the issuing controller and bounded
+	// to a small number of entries, so it outlives the CertificateRequests
+	// removed because of `spec.revisionHistoryLimit`.
+	// +listType=atomic
+	// +optional
+	History []CertificateHistoryEntry `json:"history,omitempty"`
 }
 
 // CertificateCondition contains condition information for an Certificate.
@@ -461,6 +485,10 @@ const (
 	//
 	// It will be removed by the 'issuing' controller upon completing issuance.
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
+
+	// CertificateConditionSuspended indicates that renewal and reissuance of
+	// a Certificate has been suspended using `spec.suspend`.
+	CertificateConditionSuspended CertificateConditionType = "Suspended"
 )
 
 // CertificateSecretTemplate defines the default labels and annotations
@@ -508,3 +536,65 @@ type CertificateAdditionalOutputFormat struct {
 	// Certificate's target Secret.
 	Type CertificateOutputFormatType `json:"type"`
 }
+
+// RenewalWindows restricts when certificates may be renewed to a set of
+// recurring windows.
+type RenewalWindows struct {
+	// Windows during which certificates may be renewed.
+	// +kubebuilder:validation:MinItems=1
+	Windows []RenewalWindow `json:"windows"`
+
+	// TimeZone is the name of the IANA time zone, e.g. `Europe/London`, in
+	// which the window schedules are evaluated. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// RenewalWindow is a recurring period of time during which certificates may
+// be renewed.
+type RenewalWindow struct {
+	// Schedule is a cron expression with five fields (minute, hour, day of
+	// month, month and day of week) describing when the window opens, e.g.
+	// `0 9 * * 1-5` for 09:00 on every weekday.
+	Schedule string `json:"schedule"`
+
+	// Duration for which the window stays open each time it opens, e.g. `8h`.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// CertificateHistoryEntry records a certificate that was issued for a
+// revision of a Certificate.
+type CertificateHistoryEntry struct {
+	// Revision of the Certificate that the certificate was issued for.
+	Revision int `json:"revision"`
+
+	// SerialNumber of the issued certificate, hex encoded.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// NotBefore is the time from which the issued certificate is valid.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the expiration time of the issued certificate.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// IssuerRef is a reference to the issuer that signed the certificate.
+	IssuerRef cmmeta.ObjectReference `json:"issuerRef"`
+
+	// PublicKeyFingerprint is the hex encoded SHA-256 fingerprint of the
+	// certificate's public key.
+	// +optional
+	PublicKeyFingerprint string `json:"publicKeyFingerprint,omitempty"`
+
+	// Reason the issuance was triggered, as reported on the `Issuing`
+	// condition, e.g. `Renewing` or `DoesNotExist`.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable description of why the issuance was
+	// triggered.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -82,6 +82,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateHistoryEntry)(nil), (*certmanager.CertificateHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(a.(*CertificateHistoryEntry), b.(*certmanager.CertificateHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateHistoryEntry)(nil), (*CertificateHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateHistoryEntry_To_v1beta1_CertificateHistoryEntry(a.(*certmanager.CertificateHistoryEntry), b.(*CertificateHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -252,6 +262,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IssuerRateLimit)(nil), (*certmanager.IssuerRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuerRateLimit_To_certmanager_IssuerRateLimit(a.(*IssuerRateLimit), b.(*certmanager.IssuerRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerRateLimit)(nil), (*IssuerRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerRateLimit_To_v1beta1_IssuerRateLimit(a.(*certmanager.IssuerRateLimit), b.(*IssuerRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(a.(*IssuerSpec), b.(*certmanager.IssuerSpec), scope)
 	}); err != nil {
@@ -292,6 +312,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RenewalWindow)(nil), (*certmanager.RenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RenewalWindow_To_certmanager_RenewalWindow(a.(*RenewalWindow), b.(*certmanager.RenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RenewalWindow)(nil), (*RenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RenewalWindow_To_v1beta1_RenewalWindow(a.(*certmanager.RenewalWindow), b.(*RenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RenewalWindows)(nil), (*certmanager.RenewalWindows)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RenewalWindows_To_certmanager_RenewalWindows(a.(*RenewalWindows), b.(*certmanager.RenewalWindows), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RenewalWindows)(nil), (*RenewalWindows)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RenewalWindows_To_v1beta1_RenewalWindows(a.(*certmanager.RenewalWindows), b.(*RenewalWindows), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -501,6 +541,44 @@ func Convert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in
 	return autoConvert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(in *CertificateHistoryEntry, out *certmanager.CertificateHistoryEntry, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.SerialNumber = in.SerialNumber
+	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
+	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.PublicKeyFingerprint = in.PublicKeyFingerprint
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry is an autogenerated conversion function.
+func Convert_v1beta1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(in *CertificateHistoryEntry, out *certmanager.CertificateHistoryEntry, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateHistoryEntry_To_v1beta1_CertificateHistoryEntry(in *certmanager.CertificateHistoryEntry, out *CertificateHistoryEntry, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.SerialNumber = in.SerialNumber
+	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
+	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.PublicKeyFingerprint = in.PublicKeyFingerprint
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateHistoryEntry_To_v1beta1_CertificateHistoryEntry is an autogenerated conversion function.
+func Convert_certmanager_CertificateHistoryEntry_To_v1beta1_CertificateHistoryEntry(in *certmanager.CertificateHistoryEntry, out *CertificateHistoryEntry, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateHistoryEntry_To_v1beta1_CertificateHistoryEntry(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateKeystores_To_certmanager_CertificateKeystores(in *CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
 	if in.JKS != nil {
 		in, out := &in.JKS, &out.JKS
@@ -843,6 +921,8 @@ func autoConvert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(in *Cert
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.Suspend = in.Suspend
+	out.RenewalWindows = (*certmanager.RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
 	return nil
 }
 
@@ -881,6 +961,8 @@ func autoConvert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *cert
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.Suspend = in.Suspend
+	out.RenewalWindows = (*RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
 	return nil
 }
 
@@ -898,6 +980,17 @@ func autoConvert_v1beta1_CertificateStatus_To_certmanager_CertificateStatus(in *
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]certmanager.CertificateHistoryEntry, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_CertificateHistoryEntry_To_certmanager_CertificateHistoryEntry(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.History = nil
+	}
 	return nil
 }
 
@@ -915,6 +1008,17 @@ func autoConvert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(in *
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]CertificateHistoryEntry, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateHistoryEntry_To_v1beta1_CertificateHistoryEntry(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.History = nil
+	}
 	return nil
 }
 
@@ -1177,10 +1281,39 @@ func Convert_certmanager_IssuerList_To_v1beta1_IssuerList(in *certmanager.Issuer
 	return autoConvert_certmanager_IssuerList_To_v1beta1_IssuerList(in, out, s)
 }
 
+func autoConvert_v1beta1_IssuerRateLimit_To_certmanager_IssuerRateLimit(in *IssuerRateLimit, out *certmanager.IssuerRateLimit, s conversion.Scope) error {
+	out.Requests = (*int32)(unsafe.Pointer(in.Requests))
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	out.Burst = (*int32)(unsafe.Pointer(in.Burst))
+	out.MaxInFlight = (*int32)(unsafe.Pointer(in.MaxInFlight))
+	return nil
+}
+
+// Convert_v1beta1_IssuerRateLimit_To_certmanager_IssuerRateLimit is an autogenerated conversion function.
+func Convert_v1beta1_IssuerRateLimit_To_certmanager_IssuerRateLimit(in *IssuerRateLimit, out *certmanager.IssuerRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerRateLimit_To_certmanager_IssuerRateLimit(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerRateLimit_To_v1beta1_IssuerRateLimit(in *certmanager.IssuerRateLimit, out *IssuerRateLimit, s conversion.Scope) error {
+	out.Requests = (*int32)(unsafe.Pointer(in.Requests))
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	out.Burst = (*int32)(unsafe.Pointer(in.Burst))
+	out.MaxInFlight = (*int32)(unsafe.Pointer(in.MaxInFlight))
+	return nil
+}
+
+// Convert_certmanager_IssuerRateLimit_To_v1beta1_IssuerRateLimit is an autogenerated conversion function.
+func Convert_certmanager_IssuerRateLimit_To_v1beta1_IssuerRateLimit(in *certmanager.IssuerRateLimit, out *IssuerRateLimit, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerRateLimit_To_v1beta1_IssuerRateLimit(in, out, s)
+}
+
 func autoConvert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in *IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RateLimit = (*certmanager.IssuerRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.RenewalWindows = (*certmanager.RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -1193,6 +1326,9 @@ func autoConvert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in *certmanager.Is
 	if err := Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.RateLimit = (*IssuerRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.RenewalWindows = (*RenewalWindows)(unsafe.Pointer(in.RenewalWindows))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -1275,6 +1411,50 @@ func Convert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in *certmanage
 	return autoConvert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in, out, s)
 }
 
+func autoConvert_v1beta1_RenewalWindow_To_certmanager_RenewalWindow(in *RenewalWindow, out *certmanager.RenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1beta1_RenewalWindow_To_certmanager_RenewalWindow is an autogenerated conversion function.
+func Convert_v1beta1_RenewalWindow_To_certmanager_RenewalWindow(in *RenewalWindow, out *certmanager.RenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_RenewalWindow_To_certmanager_RenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_RenewalWindow_To_v1beta1_RenewalWindow(in *certmanager.RenewalWindow, out *RenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_certmanager_RenewalWindow_To_v1beta1_RenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_RenewalWindow_To_v1beta1_RenewalWindow(in *certmanager.RenewalWindow, out *RenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_RenewalWindow_To_v1beta1_RenewalWindow(in, out, s)
+}
+
+func autoConvert_v1beta1_RenewalWindows_To_certmanager_RenewalWindows(in *RenewalWindows, out *certmanager.RenewalWindows, s conversion.Scope) error {
+	out.Windows = *(*[]certmanager.RenewalWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1beta1_RenewalWindows_To_certmanager_RenewalWindows is an autogenerated conversion function.
+func Convert_v1beta1_RenewalWindows_To_certmanager_RenewalWindows(in *RenewalWindows, out *certmanager.RenewalWindows, s conversion.Scope) error {
+	return autoConvert_v1beta1_RenewalWindows_To_certmanager_RenewalWindows(in, out, s)
+}
+
+func autoConvert_certmanager_RenewalWindows_To_v1beta1_RenewalWindows(in *certmanager.RenewalWindows, out *RenewalWindows, s conversion.Scope) error {
+	out.Windows = *(*[]RenewalWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_RenewalWindows_To_v1beta1_RenewalWindows is an autogenerated conversion function.
+func Convert_certmanager_RenewalWindows_To_v1beta1_RenewalWindows(in *certmanager.RenewalWindows, out *RenewalWindows, s conversion.Scope) error {
+	return autoConvert_certmanager_RenewalWindows_To_v1beta1_RenewalWindows(in, out, s)
+}
+
 func autoConvert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	return nil
@@ -21,6 +21,7 @@ import (
 	"net"
 	"net/mail"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -34,6 +35,7 @@ import (
 	"github.com/cert-manager/cert-manager/internal/webhook/feature"
 	"github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/cron"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -150,6 +152,7 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	}
 
 	el = append(el, validateAdditionalOutputFormats(crt, fldPath)...)
+	el = append(el, ValidateRenewalWindows(crt.RenewalWindows, fldPath.Child("renewalWindows"))...)
 
 	return el
 }
@@ -181,6 +184,16 @@ func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) fi
 			el = append(el, field.Invalid(issuerRefPath.Child("kind"), issuerRef.Kind, "must be one of Issuer or ClusterIssuer"))
 		}
 	}
+	if issuerRef.Namespace != "" {
+		isIssuer := (issuerRef.Group == "" || issuerRef.Group == internalcmapi.SchemeGroupVersion.Group) &&
+			(issuerRef.Kind == "" || issuerRef.Kind == "Issuer")
+		if !isIssuer {
+			el = append(el, field.Forbidden(issuerRefPath.Child("namespace"), "may only be set when referencing an Issuer"))
+		}
+		for _, msg := range apivalidation.ValidateNamespaceName(issuerRef.Namespace, false) {
+			el = append(el, field.Invalid(issuerRefPath.Child("namespace"), issuerRef.Namespace, msg))
+		}
+	}
 	return el
 }
 
@@ -286,3 +299,29 @@ func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath
 
 	return el
 }
+
+// ValidateRenewalWindows validates the renewal windows of a Certificate or
+// the default renewal windows of an issuer.
+func ValidateRenewalWindows(rw *internalcmapi.RenewalWindows, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if rw == nil {
+		return el
+	}
+	if len(rw.Windows) == 0 {
+		el = append(el, field.Required(fldPath.Child("windows"), "at least one window must be specified"))
+	}
+	for i, w := range rw.Windows {
+		if _, err := cron.Parse(w.Schedule); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("windows").Index(i).Child("schedule"), w.Schedule, err.Error()))
+		}
+		if w.Duration.Duration <= 0 {
+			el = append(el, field.Invalid(fldPath.Child("windows").Index(i).Child("duration"), w.Duration.Duration.String(), "must be greater than zero"))
+		}
+	}
+	if rw.TimeZone != "" {
+		if _, err := time.LoadLocation(rw.TimeZone); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("timeZone"), rw.TimeZone, "must be a valid IANA time zone name"))
+		}
+	}
+	return el
+}
@@ -130,6 +130,53 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("issuerRef", "kind"), "invalid", "must be one of Issuer or ClusterIssuer"),
 			},
 		},
+		"valid with issuerRef namespace": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:      "valid",
+						Kind:      "Issuer",
+						Namespace: "issuers",
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"issuerRef namespace set for a ClusterIssuer": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:      "valid",
+						Kind:      "ClusterIssuer",
+						Namespace: "issuers",
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("issuerRef", "namespace"), "may only be set when referencing an Issuer"),
+			},
+		},
+		"invalid issuerRef namespace": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:      "valid",
+						Namespace: "Not_Valid",
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issuerRef", "namespace"), "Not_Valid", `a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`),
+			},
+		},
 		"certificate missing secretName": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -1044,3 +1091,61 @@ func Test_validateLiteralSubject(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRenewalWindows(t *testing.T) {
+	fldPath := field.NewPath("spec", "renewalWindows")
+	hour := metav1.Duration{Duration: time.Hour}
+	tests := map[string]struct {
+		windows *internalcmapi.RenewalWindows
+		expErr  field.ErrorList
+	}{
+		"no renewal windows": {
+			windows: nil,
+			expErr:  field.ErrorList{},
+		},
+		"valid renewal windows": {
+			windows: &internalcmapi.RenewalWindows{
+				TimeZone: "Europe/London",
+				Windows: []internalcmapi.RenewalWindow{
+					{Schedule: "0 9 * * 1-5", Duration: metav1.Duration{Duration: 8 * time.Hour}},
+					{Schedule: "*/30 2 1 * *", Duration: hour},
+				},
+			},
+			expErr: field.ErrorList{},
+		},
+		"no windows": {
+			windows: &internalcmapi.RenewalWindows{},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("windows"), "at least one window must be specified"),
+			},
+		},
+		"invalid schedule and duration": {
+			windows: &internalcmapi.RenewalWindows{
+				Windows: []internalcmapi.RenewalWindow{
+					{Schedule: "0 9 * *", Duration: metav1.Duration{}},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("windows").Index(0).Child("schedule"), "0 9 * *", `expected 5 fields but got 4 in "0 9 * *"`),
+				field.Invalid(fldPath.Child("windows").Index(0).Child("duration"), "0s", "must be greater than zero"),
+			},
+		},
+		"invalid time zone": {
+			windows: &internalcmapi.RenewalWindows{
+				TimeZone: "Mars/Olympus_Mons",
+				Windows: []internalcmapi.RenewalWindow{
+					{Schedule: "0 9 * * *", Duration: hour},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("timeZone"), "Mars/Olympus_Mons", "must be a valid IANA time zone name"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := ValidateRenewalWindows(test.windows, fldPath)
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
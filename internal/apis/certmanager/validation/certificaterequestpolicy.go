@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	"github.com/cert-manager/cert-manager/internal/celpolicy"
+	"github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Validation functions for cert-manager CertificateRequestPolicy types.
+
+var supportedPolicyKeyAlgorithms = []string{
+	string(certmanager.RSAKeyAlgorithm),
+	string(certmanager.ECDSAKeyAlgorithm),
+	string(certmanager.Ed25519KeyAlgorithm),
+}
+
+func ValidateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*certmanager.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*certmanager.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateCertificateRequestPolicySpec(spec *certmanager.CertificateRequestPolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if requester := spec.Selector.Requester; requester != nil && len(requester.Usernames) == 0 && len(requester.Groups) == 0 {
+		el = append(el, field.Required(fldPath.Child("selector", "requester"), "at least one of usernames or groups must be specified"))
+	}
+
+	if spec.Allowed != nil {
+		for i, u := range spec.Allowed.Usages {
+			_, kok := util.KeyUsageType(cmapi.KeyUsage(u))
+			_, ekok := util.ExtKeyUsageType(cmapi.KeyUsage(u))
+			if !kok && !ekok {
+				el = append(el, field.Invalid(fldPath.Child("allowed", "usages").Index(i), u, "unknown keyusage"))
+			}
+		}
+	}
+
+	if c := spec.Constraints; c != nil {
+		fldPath := fldPath.Child("constraints")
+		if c.MinDuration != nil && c.MinDuration.Duration <= 0 {
+			el = append(el, field.Invalid(fldPath.Child("minDuration"), c.MinDuration.Duration.String(), "must be greater than zero"))
+		}
+		if c.MaxDuration != nil && c.MaxDuration.Duration <= 0 {
+			el = append(el, field.Invalid(fldPath.Child("maxDuration"), c.MaxDuration.Duration.String(), "must be greater than zero"))
+		}
+		if c.MinDuration != nil && c.MaxDuration != nil && c.MinDuration.Duration > c.MaxDuration.Duration {
+			el = append(el, field.Invalid(fldPath.Child("maxDuration"), c.MaxDuration.Duration.String(), "must not be less than minDuration"))
+		}
+
+		if pk := c.PrivateKey; pk != nil {
+			fldPath := fldPath.Child("privateKey")
+			if pk.Algorithm != nil {
+				switch *pk.Algorithm {
+				case certmanager.RSAKeyAlgorithm, certmanager.ECDSAKeyAlgorithm, certmanager.Ed25519KeyAlgorithm:
+				default:
+					el = append(el, field.NotSupported(fldPath.Child("algorithm"), *pk.Algorithm, supportedPolicyKeyAlgorithms))
+				}
+			}
+			if pk.MinSize != nil && *pk.MinSize <= 0 {
+				el = append(el, field.Invalid(fldPath.Child("minSize"), *pk.MinSize, "must be greater than zero"))
+			}
+			if pk.MaxSize != nil && *pk.MaxSize <= 0 {
+				el = append(el, field.Invalid(fldPath.Child("maxSize"), *pk.MaxSize, "must be greater than zero"))
+			}
+			if pk.MinSize != nil && pk.MaxSize != nil && *pk.MinSize > *pk.MaxSize {
+				el = append(el, field.Invalid(fldPath.Child("maxSize"), *pk.MaxSize, "must not be less than minSize"))
+			}
+		}
+	}
+
+	for i, v := range spec.Validations {
+		fldPath := fldPath.Child("validations").Index(i).Child("expression")
+		if v.Expression == "" {
+			el = append(el, field.Required(fldPath, "must be specified"))
+			continue
+		}
+		if _, err := celpolicy.Compile(v.Expression); err != nil {
+			el = append(el, field.Invalid(fldPath, v.Expression, err.Error()))
+		}
+	}
+
+	return el
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+func TestValidateCertificateRequestPolicySpec(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	rsa := cmapi.RSAKeyAlgorithm
+	dsa := cmapi.PrivateKeyAlgorithm("DSA")
+	tests := map[string]struct {
+		spec   *cmapi.CertificateRequestPolicySpec
+		expErr field.ErrorList
+	}{
+		"valid policy": {
+			spec: &cmapi.CertificateRequestPolicySpec{
+				Selector: cmapi.CertificateRequestPolicySelector{
+					IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Name: "vault-*", Kind: "ClusterIssuer"},
+					Requester: &cmapi.CertificateRequestPolicyRequesterSelector{Groups: []string{"system:serviceaccounts:team-a"}},
+				},
+				Allowed: &cmapi.CertificateRequestPolicyAllowed{
+					DNSNames: []string{"*.team-a.example.com"},
+					Usages:   []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+				},
+				Constraints: &cmapi.CertificateRequestPolicyConstraints{
+					MinDuration: &metav1.Duration{Duration: time.Hour},
+					MaxDuration: &metav1.Duration{Duration: 24 * time.Hour},
+					PrivateKey:  &cmapi.CertificateRequestPolicyPrivateKeyConstraints{Algorithm: &rsa, MinSize: pointer.Int(2048), MaxSize: pointer.Int(4096)},
+				},
+			},
+			expErr: field.ErrorList{},
+		},
+		"empty requester selector and unknown usage": {
+			spec: &cmapi.CertificateRequestPolicySpec{
+				Selector: cmapi.CertificateRequestPolicySelector{
+					Requester: &cmapi.CertificateRequestPolicyRequesterSelector{},
+				},
+				Allowed: &cmapi.CertificateRequestPolicyAllowed{
+					Usages: []cmapi.KeyUsage{"teleport"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("selector", "requester"), "at least one of usernames or groups must be specified"),
+				field.Invalid(fldPath.Child("allowed", "usages").Index(0), cmapi.KeyUsage("teleport"), "unknown keyusage"),
+			},
+		},
+		"invalid constraints": {
+			spec: &cmapi.CertificateRequestPolicySpec{
+				Constraints: &cmapi.CertificateRequestPolicyConstraints{
+					MinDuration: &metav1.Duration{Duration: 48 * time.Hour},
+					MaxDuration: &metav1.Duration{Duration: 24 * time.Hour},
+					PrivateKey:  &cmapi.CertificateRequestPolicyPrivateKeyConstraints{Algorithm: &dsa, MinSize: pointer.Int(4096), MaxSize: pointer.Int(0)},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("constraints", "maxDuration"), "24h0m0s", "must not be less than minDuration"),
+				field.NotSupported(fldPath.Child("constraints", "privateKey", "algorithm"), dsa, supportedPolicyKeyAlgorithms),
+				field.Invalid(fldPath.Child("constraints", "privateKey", "maxSize"), 0, "must be greater than zero"),
+				field.Invalid(fldPath.Child("constraints", "privateKey", "maxSize"), 0, "must not be less than minSize"),
+			},
+		},
+		"invalid validations": {
+			spec: &cmapi.CertificateRequestPolicySpec{
+				Validations: []cmapi.CertificateRequestPolicyValidation{
+					{Expression: `dnsNames.all(n, n.endsWith("." + namespaceName + ".svc"))`},
+					{Message: "empty"},
+					{Expression: `keySize`},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("validations").Index(1).Child("expression"), "must be specified"),
+				field.Invalid(fldPath.Child("validations").Index(2).Child("expression"), "keySize", "expression must evaluate to a bool, not int"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := ValidateCertificateRequestPolicySpec(test.spec, fldPath)
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
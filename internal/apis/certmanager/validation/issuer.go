@@ -19,10 +19,13 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"net"
+	"net/url"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -38,18 +41,62 @@ import (
 func ValidateIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerNamespaceSelectorUnset(&iss.Spec, field.NewPath("spec"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerNamespaceSelectorUnset(&iss.Spec, field.NewPath("spec"))...)
 	// Admission request should never be nil
 	return allErrs, warnings
 }
 
 func ValidateIssuerSpec(iss *certmanager.IssuerSpec, fldPath *field.Path) (field.ErrorList, []string) {
-	return ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el, warnings := ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el = append(el, ValidateIssuerRateLimit(iss.RateLimit, fldPath.Child("rateLimit"))...)
+	el = append(el, ValidateRenewalWindows(iss.RenewalWindows, fldPath.Child("renewalWindows"))...)
+	if iss.NamespaceSelector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(iss.NamespaceSelector, metavalidation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+	return el, warnings
+}
+
+// validateIssuerNamespaceSelectorUnset forbids the namespaceSelector on
+// Issuers, which can only be used from their own namespace.
+func validateIssuerNamespaceSelectorUnset(iss *certmanager.IssuerSpec, fldPath *field.Path) field.ErrorList {
+	if iss.NamespaceSelector != nil {
+		return field.ErrorList{field.Forbidden(fldPath.Child("namespaceSelector"), "namespaceSelector is only supported on ClusterIssuers")}
+	}
+	return nil
+}
+
+// ValidateIssuerRateLimit validates the rate limit and concurrency budget of
+// an issuer.
+func ValidateIssuerRateLimit(rl *certmanager.IssuerRateLimit, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if rl == nil {
+		return el
+	}
+	if rl.Requests != nil && *rl.Requests <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("requests"), *rl.Requests, "must be greater than zero"))
+	}
+	if rl.Period != nil && rl.Period.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("period"), rl.Period.Duration.String(), "must be greater than zero"))
+	}
+	if rl.Burst != nil {
+		if *rl.Burst <= 0 {
+			el = append(el, field.Invalid(fldPath.Child("burst"), *rl.Burst, "must be greater than zero"))
+		}
+		if rl.Requests == nil {
+			el = append(el, field.Required(fldPath.Child("requests"), "must be specified when burst is set"))
+		}
+	}
+	if rl.MaxInFlight != nil && *rl.MaxInFlight <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("maxInFlight"), *rl.MaxInFlight, "must be greater than zero"))
+	}
+	return el
 }
 
 func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (field.ErrorList, []string) {
@@ -183,6 +230,15 @@ func ValidateACMEIssuerChallengeSolverHTTP01Config(http01 *cmacme.ACMEChallengeS
 		numDefined++
 		el = append(el, ValidateACMEIssuerChallengeSolverHTTP01GatewayConfig(http01.GatewayHTTPRoute, fldPath.Child("gateway"))...)
 	}
+	if http01.SharedSolver != nil {
+		el = append(el, ValidateACMEIssuerChallengeSolverHTTP01SharedSolverConfig(http01.SharedSolver, fldPath.Child("sharedSolver"))...)
+		if http01.SharedSolver.CatchAllRoute {
+			if numDefined > 0 {
+				el = append(el, field.Forbidden(fldPath, "ingress and gatewayHTTPRoute may not be specified when the shared solver uses a catch-all route"))
+			}
+			return el
+		}
+	}
 	if numDefined == 0 {
 		el = append(el, field.Required(fldPath, "no HTTP01 solver type configured"))
 	}
@@ -193,6 +249,26 @@ func ValidateACMEIssuerChallengeSolverHTTP01Config(http01 *cmacme.ACMEChallengeS
 	return el
 }
 
+func ValidateACMEIssuerChallengeSolverHTTP01SharedSolverConfig(shared *cmacme.ACMEChallengeSolverHTTP01SharedSolver, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if !shared.CatchAllRoute && len(shared.ServiceName) == 0 {
+		el = append(el, field.Required(fldPath.Child("serviceName"), "serviceName is required unless catchAllRoute is true"))
+	}
+	if len(shared.ServiceName) > 0 {
+		for _, msg := range validation.IsDNS1035Label(shared.ServiceName) {
+			el = append(el, field.Invalid(fldPath.Child("serviceName"), shared.ServiceName, msg))
+		}
+	}
+	if shared.ServicePort != 0 {
+		for _, msg := range validation.IsValidPortNum(int(shared.ServicePort)) {
+			el = append(el, field.Invalid(fldPath.Child("servicePort"), shared.ServicePort, msg))
+		}
+	}
+
+	return el
+}
+
 func ValidateACMEIssuerChallengeSolverHTTP01IngressConfig(ingress *cmacme.ACMEChallengeSolverHTTP01Ingress, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -519,6 +595,19 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, field.Required(fldPath.Child("acmeDNS", "host"), ""))
 		}
 	}
+	if p.PowerDNS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("powerDNS"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if len(p.PowerDNS.Host) == 0 {
+				el = append(el, field.Required(fldPath.Child("powerDNS", "host"), ""))
+			} else if u, err := url.Parse(p.PowerDNS.Host); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				el = append(el, field.Invalid(fldPath.Child("powerDNS", "host"), p.PowerDNS.Host, "host must be a valid http or https URL"))
+			}
+			el = append(el, ValidateSecretKeySelector(&p.PowerDNS.APIKey, fldPath.Child("powerDNS", "apiKeySecretRef"))...)
+		}
+	}
 
 	if p.DigitalOcean != nil {
 		if numProviders > 0 {
@@ -562,6 +651,45 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 				}
 
 			}
+
+			if gss := p.RFC2136.GSSTSIG; gss != nil {
+				gssPath := fldPath.Child("rfc2136", "gssTSIG")
+				if len(p.RFC2136.TSIGKeyName) > 0 || len(p.RFC2136.TSIGSecret.Name) > 0 || len(p.RFC2136.TSIGAlgorithm) > 0 {
+					el = append(el, field.Forbidden(gssPath, "may not be specified together with tsigKeyName, tsigSecretSecretRef or tsigAlgorithm"))
+				}
+				if len(gss.Realm) == 0 {
+					el = append(el, field.Required(gssPath.Child("realm"), ""))
+				}
+				if len(gss.Username) == 0 {
+					el = append(el, field.Required(gssPath.Child("username"), ""))
+				}
+				switch {
+				case gss.PasswordSecretRef != nil && gss.KeytabSecretRef != nil:
+					el = append(el, field.Forbidden(gssPath, "only one of passwordSecretRef or keytabSecretRef may be specified"))
+				case gss.PasswordSecretRef != nil:
+					el = append(el, ValidateSecretKeySelector(gss.PasswordSecretRef, gssPath.Child("passwordSecretRef"))...)
+				case gss.KeytabSecretRef != nil:
+					el = append(el, ValidateSecretKeySelector(gss.KeytabSecretRef, gssPath.Child("keytabSecretRef"))...)
+				default:
+					el = append(el, field.Required(gssPath, "one of passwordSecretRef or keytabSecretRef must be specified"))
+				}
+				for i, kdc := range gss.KDCs {
+					if _, _, err := net.SplitHostPort(kdc); err != nil {
+						el = append(el, field.Invalid(gssPath.Child("kdcs").Index(i), kdc, "must be in the form host:port"))
+					}
+				}
+			}
+		}
+	}
+	if p.ExternalDNS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("externalDNS"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if p.ExternalDNS.RecordTTL != nil && *p.ExternalDNS.RecordTTL <= 0 {
+				el = append(el, field.Invalid(fldPath.Child("externalDNS", "recordTTL"), *p.ExternalDNS.RecordTTL, "must be greater than zero"))
+			}
+			el = append(el, metavalidation.ValidateLabels(p.ExternalDNS.Labels, fldPath.Child("externalDNS", "labels"))...)
 		}
 	}
 	if p.Webhook != nil {
@@ -572,6 +700,11 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if len(p.Webhook.SolverName) == 0 {
 				el = append(el, field.Required(fldPath.Child("webhook", "solverName"), "solver name must be specified"))
 			}
+			if p.Webhook.GRPC != nil {
+				el = append(el, validateACMEIssuerDNS01ProviderWebhookGRPC(p.Webhook.GRPC, fldPath.Child("webhook", "grpc"))...)
+			} else if len(p.Webhook.GroupName) == 0 {
+				el = append(el, field.Required(fldPath.Child("webhook", "groupName"), "group name must be specified unless grpc is specified"))
+			}
 		}
 	}
 	if numProviders == 0 {
@@ -581,6 +714,24 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 	return el
 }
 
+func validateACMEIssuerDNS01ProviderWebhookGRPC(cfg *cmacme.ACMEIssuerDNS01ProviderWebhookGRPC, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(cfg.ServiceName) == 0 {
+		el = append(el, field.Required(fldPath.Child("serviceName"), "service name must be specified"))
+	} else {
+		for _, msg := range validation.IsDNS1035Label(cfg.ServiceName) {
+			el = append(el, field.Invalid(fldPath.Child("serviceName"), cfg.ServiceName, msg))
+		}
+	}
+	for _, msg := range validation.IsValidPortNum(int(cfg.Port)) {
+		el = append(el, field.Invalid(fldPath.Child("port"), cfg.Port, msg))
+	}
+	if len(cfg.TLSSecretRef.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("tlsSecretRef", "name"), "secret name is required"))
+	}
+	return el
+}
+
 func ValidateSecretKeySelector(sks *cmmeta.SecretKeySelector, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if sks.Name == "" {
@@ -19,12 +19,15 @@ package validation
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	cmacme "github.com/cert-manager/cert-manager/internal/apis/acme"
@@ -737,6 +740,51 @@ func TestValidateIssuerSpec(t *testing.T) {
 				field.Invalid(fldPath.Child("ca", "ocspServer").Index(0), "", `must be a valid URL, e.g., http://ocsp.int-x3.letsencrypt.org`),
 			},
 		},
+		"valid rate limit": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				RateLimit: &cmapi.IssuerRateLimit{
+					Requests:    pointer.Int32(10),
+					Period:      &metav1.Duration{Duration: time.Minute},
+					Burst:       pointer.Int32(20),
+					MaxInFlight: pointer.Int32(5),
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid rate limit": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				RateLimit: &cmapi.IssuerRateLimit{
+					Period:      &metav1.Duration{},
+					Burst:       pointer.Int32(0),
+					MaxInFlight: pointer.Int32(-1),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("rateLimit", "period"), "0s", "must be greater than zero"),
+				field.Invalid(fldPath.Child("rateLimit", "burst"), int32(0), "must be greater than zero"),
+				field.Required(fldPath.Child("rateLimit", "requests"), "must be specified when burst is set"),
+				field.Invalid(fldPath.Child("rateLimit", "maxInFlight"), int32(-1), "must be greater than zero"),
+			},
+		},
+		"invalid namespaceSelector": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "a b"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("namespaceSelector", "matchLabels"), "a b", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -833,6 +881,55 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 				field.Invalid(fldPath.Child("ingress", "serviceType"), corev1.ServiceType("InvalidServiceType"), `must be empty, "ClusterIP" or "NodePort"`),
 			},
 		},
+		"shared solver with ingress": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					IngressClassName: strPtr("abc"),
+				},
+				SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+					ServiceName: "acmesolver",
+				},
+			},
+		},
+		"shared solver with catch-all route": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+					CatchAllRoute: true,
+				},
+			},
+		},
+		"shared solver without route and without service name": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("sharedSolver", "serviceName"), "serviceName is required unless catchAllRoute is true"),
+				field.Required(fldPath, "no HTTP01 solver type configured"),
+			},
+		},
+		"shared solver with catch-all route and ingress": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+					CatchAllRoute: true,
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath, "ingress and gatewayHTTPRoute may not be specified when the shared solver uses a catch-all route"),
+			},
+		},
+		"shared solver with invalid port": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+					ServiceName: "acmesolver",
+					ServicePort: 70000,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("sharedSolver", "servicePort"), int32(70000), "must be between 1 and 65535, inclusive"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1411,6 +1508,174 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("rfc2136", "tsigKeyName"), ""),
 			},
 		},
+		"rfc2136 provider with valid GSS-TSIG config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "dc1.example.com",
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						Realm:           "EXAMPLE.COM",
+						Username:        "cert-manager",
+						KeytabSecretRef: &validSecretKeyRef,
+						KDCs:            []string{"dc1.example.com:88"},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rfc2136 provider with GSS-TSIG and TSIG key": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:  "dc1.example.com",
+					TSIGKeyName: "some-name",
+					TSIGSecret:  validSecretKeyRef,
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						Realm:             "EXAMPLE.COM",
+						Username:          "cert-manager",
+						PasswordSecretRef: &validSecretKeyRef,
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("rfc2136", "gssTSIG"), "may not be specified together with tsigKeyName, tsigSecretSecretRef or tsigAlgorithm"),
+			},
+		},
+		"rfc2136 provider with GSS-TSIG missing fields": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "dc1.example.com",
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						KDCs: []string{"dc1.example.com"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "realm"), ""),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "username"), ""),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG"), "one of passwordSecretRef or keytabSecretRef must be specified"),
+				field.Invalid(fldPath.Child("rfc2136", "gssTSIG", "kdcs").Index(0), "dc1.example.com", "must be in the form host:port"),
+			},
+		},
+		"rfc2136 provider with GSS-TSIG password and keytab": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "dc1.example.com",
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						Realm:             "EXAMPLE.COM",
+						Username:          "cert-manager",
+						PasswordSecretRef: &validSecretKeyRef,
+						KeytabSecretRef:   &validSecretKeyRef,
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("rfc2136", "gssTSIG"), "only one of passwordSecretRef or keytabSecretRef may be specified"),
+			},
+		},
+		"valid powerDNS config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+					Host:   "https://pdns.example.com:8081",
+					APIKey: validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"powerDNS provider missing host and api key": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("powerDNS", "host"), ""),
+				field.Required(fldPath.Child("powerDNS", "apiKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("powerDNS", "apiKeySecretRef", "key"), "secret key is required"),
+			},
+		},
+		"powerDNS provider with invalid host": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+					Host:   "pdns.example.com:8081",
+					APIKey: validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("powerDNS", "host"), "pdns.example.com:8081", "host must be a valid http or https URL"),
+			},
+		},
+		"valid externalDNS config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				ExternalDNS: &cmacme.ACMEIssuerDNS01ProviderExternalDNS{
+					Labels:    map[string]string{"external-dns": "public"},
+					RecordTTL: pointer.Int64(300),
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"externalDNS provider with invalid recordTTL": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				ExternalDNS: &cmacme.ACMEIssuerDNS01ProviderExternalDNS{
+					RecordTTL: pointer.Int64(0),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("externalDNS", "recordTTL"), int64(0), "must be greater than zero"),
+			},
+		},
+		"externalDNS and rfc2136 providers configured": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "127.0.0.1",
+				},
+				ExternalDNS: &cmacme.ACMEIssuerDNS01ProviderExternalDNS{},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("externalDNS"), "may not specify more than one provider type"),
+			},
+		},
+		"valid webhook config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					GroupName:  "acme.example.com",
+					SolverName: "example",
+				},
+			},
+		},
+		"webhook config missing groupName": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("webhook", "groupName"), "group name must be specified unless grpc is specified"),
+			},
+		},
+		"valid webhook grpc config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+					GRPC: &cmacme.ACMEIssuerDNS01ProviderWebhookGRPC{
+						ServiceName:  "example-webhook",
+						Port:         8443,
+						TLSSecretRef: cmmeta.LocalObjectReference{Name: "example-webhook-client"},
+					},
+				},
+			},
+		},
+		"invalid webhook grpc config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+					GRPC: &cmacme.ACMEIssuerDNS01ProviderWebhookGRPC{
+						ServiceName: "example.webhook",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("webhook", "grpc", "serviceName"), "example.webhook", "a DNS-1035 label must consist of lower case alphanumeric characters or '-', start with an alphabetic character, and end with an alphanumeric character (e.g. 'my-name',  or 'abc-123', regex used for validation is '[a-z]([-a-z0-9]*[a-z0-9])?')"),
+				field.Invalid(fldPath.Child("webhook", "grpc", "port"), int32(0), "must be between 1 and 65535, inclusive"),
+				field.Required(fldPath.Child("webhook", "grpc", "tlsSecretRef", "name"), "secret name is required"),
+			},
+		},
 		"multiple providers configured": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
@@ -1609,7 +1874,22 @@ func TestValidateIssuer(t *testing.T) {
 		a         *admissionv1.AdmissionRequest
 		expectedE []*field.Error
 		expectedW []string
-	}{}
+	}{
+		"namespaceSelector is forbidden": {
+			cfg: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						SelfSigned: &cmapi.SelfSignedIssuer{},
+					},
+					NamespaceSelector: &metav1.LabelSelector{},
+				},
+			},
+			a: someAdmissionRequest,
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "namespaceSelector"), "namespaceSelector is only supported on ClusterIssuers"),
+			},
+		},
+	}
 
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
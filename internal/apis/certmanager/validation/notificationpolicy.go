@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net/url"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager NotificationPolicy types.
+
+var supportedNotificationEventTypes = []string{
+	string(certmanager.NotificationEventIssued),
+	string(certmanager.NotificationEventFailed),
+	string(certmanager.NotificationEventNearingExpiry),
+	string(certmanager.NotificationEventIssuerNotReady),
+}
+
+func ValidateNotificationPolicy(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	np := obj.(*certmanager.NotificationPolicy)
+	return ValidateNotificationPolicySpec(&np.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateNotificationPolicy(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	np := obj.(*certmanager.NotificationPolicy)
+	return ValidateNotificationPolicySpec(&np.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateNotificationPolicySpec(spec *certmanager.NotificationPolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(spec.Events) == 0 {
+		el = append(el, field.Required(fldPath.Child("events"), "at least one event type must be specified"))
+	}
+	seenEvents := sets.NewString()
+	for i, e := range spec.Events {
+		fldPath := fldPath.Child("events").Index(i)
+		switch {
+		case !sets.NewString(supportedNotificationEventTypes...).Has(string(e)):
+			el = append(el, field.NotSupported(fldPath, e, supportedNotificationEventTypes))
+		case seenEvents.Has(string(e)):
+			el = append(el, field.Duplicate(fldPath, e))
+		}
+		seenEvents.Insert(string(e))
+	}
+
+	if spec.NamespaceSelector != nil {
+		el = append(el, metav1validation.ValidateLabelSelector(spec.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+
+	for i, ref := range spec.IssuerRefs {
+		fldPath := fldPath.Child("issuerRefs").Index(i)
+		if ref.Name == "" {
+			el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+		}
+	}
+
+	if spec.NearingExpiryThreshold != nil && spec.NearingExpiryThreshold.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("nearingExpiryThreshold"), spec.NearingExpiryThreshold.Duration.String(), "must be greater than zero"))
+	}
+
+	if len(spec.Sinks) == 0 {
+		el = append(el, field.Required(fldPath.Child("sinks"), "at least one sink must be specified"))
+	}
+	seenSinks := sets.NewString()
+	for i, sink := range spec.Sinks {
+		fldPath := fldPath.Child("sinks").Index(i)
+		switch {
+		case sink.Name == "":
+			el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+		case seenSinks.Has(sink.Name):
+			el = append(el, field.Duplicate(fldPath.Child("name"), sink.Name))
+		}
+		seenSinks.Insert(sink.Name)
+
+		if u, err := url.Parse(sink.URL); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("url"), sink.URL, err.Error()))
+		} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			el = append(el, field.Invalid(fldPath.Child("url"), sink.URL, "must be an absolute http or https URL"))
+		}
+
+		if len(sink.CABundle) > 0 {
+			if err := validateCABundleNotEmpty(sink.CABundle); err != nil {
+				el = append(el, field.Invalid(fldPath.Child("caBundle"), "<snip>", err.Error()))
+			}
+		}
+	}
+
+	if spec.Retry != nil {
+		fldPath := fldPath.Child("retry")
+		if spec.Retry.MaxAttempts != nil && *spec.Retry.MaxAttempts <= 0 {
+			el = append(el, field.Invalid(fldPath.Child("maxAttempts"), *spec.Retry.MaxAttempts, "must be greater than zero"))
+		}
+		if spec.Retry.Backoff != nil && spec.Retry.Backoff.Duration <= 0 {
+			el = append(el, field.Invalid(fldPath.Child("backoff"), spec.Retry.Backoff.Duration.String(), "must be greater than zero"))
+		}
+	}
+
+	return el
+}
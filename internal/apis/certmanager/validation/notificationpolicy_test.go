@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+func TestValidateNotificationPolicySpec(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	validSink := cmapi.NotificationSink{Name: "slack", URL: "https://events.example.com/slack"}
+	tests := map[string]struct {
+		spec   *cmapi.NotificationPolicySpec
+		expErr field.ErrorList
+	}{
+		"valid policy": {
+			spec: &cmapi.NotificationPolicySpec{
+				Events:                 []cmapi.NotificationEventType{cmapi.NotificationEventFailed, cmapi.NotificationEventNearingExpiry},
+				NamespaceSelector:      &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				IssuerRefs:             []cmmeta.ObjectReference{{Name: "letsencrypt", Kind: "ClusterIssuer"}},
+				NearingExpiryThreshold: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				Sinks: []cmapi.NotificationSink{
+					validSink,
+					{Name: "pagerduty", URL: "http://bridge.monitoring.svc:8080/"},
+				},
+				Retry: &cmapi.NotificationRetryPolicy{MaxAttempts: pointer.Int32(3), Backoff: &metav1.Duration{Duration: time.Second}},
+			},
+			expErr: field.ErrorList{},
+		},
+		"no events or sinks": {
+			spec: &cmapi.NotificationPolicySpec{},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("events"), "at least one event type must be specified"),
+				field.Required(fldPath.Child("sinks"), "at least one sink must be specified"),
+			},
+		},
+		"unsupported and duplicate events": {
+			spec: &cmapi.NotificationPolicySpec{
+				Events: []cmapi.NotificationEventType{"Revoked", cmapi.NotificationEventIssued, cmapi.NotificationEventIssued},
+				Sinks:  []cmapi.NotificationSink{validSink},
+			},
+			expErr: field.ErrorList{
+				field.NotSupported(fldPath.Child("events").Index(0), cmapi.NotificationEventType("Revoked"), supportedNotificationEventTypes),
+				field.Duplicate(fldPath.Child("events").Index(2), cmapi.NotificationEventIssued),
+			},
+		},
+		"invalid sinks": {
+			spec: &cmapi.NotificationPolicySpec{
+				Events: []cmapi.NotificationEventType{cmapi.NotificationEventIssued},
+				Sinks: []cmapi.NotificationSink{
+					validSink,
+					{Name: "slack", URL: "events.example.com"},
+					{URL: "ftp://events.example.com", CABundle: []byte("not a certificate")},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Duplicate(fldPath.Child("sinks").Index(1).Child("name"), "slack"),
+				field.Invalid(fldPath.Child("sinks").Index(1).Child("url"), "events.example.com", "must be an absolute http or https URL"),
+				field.Required(fldPath.Child("sinks").Index(2).Child("name"), "must be specified"),
+				field.Invalid(fldPath.Child("sinks").Index(2).Child("url"), "ftp://events.example.com", "must be an absolute http or https URL"),
+				field.Invalid(fldPath.Child("sinks").Index(2).Child("caBundle"), "<snip>", "cert bundle didn't contain any valid certificates"),
+			},
+		},
+		"invalid issuerRefs, threshold and retry": {
+			spec: &cmapi.NotificationPolicySpec{
+				Events:                 []cmapi.NotificationEventType{cmapi.NotificationEventNearingExpiry},
+				IssuerRefs:             []cmmeta.ObjectReference{{Kind: "Issuer"}},
+				NearingExpiryThreshold: &metav1.Duration{},
+				Sinks:                  []cmapi.NotificationSink{validSink},
+				Retry:                  &cmapi.NotificationRetryPolicy{MaxAttempts: pointer.Int32(0), Backoff: &metav1.Duration{Duration: -time.Second}},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("issuerRefs").Index(0).Child("name"), "must be specified"),
+				field.Invalid(fldPath.Child("nearingExpiryThreshold"), "0s", "must be greater than zero"),
+				field.Invalid(fldPath.Child("retry", "maxAttempts"), int32(0), "must be greater than zero"),
+				field.Invalid(fldPath.Child("retry", "backoff"), "-1s", "must be greater than zero"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := ValidateNotificationPolicySpec(test.spec, fldPath)
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
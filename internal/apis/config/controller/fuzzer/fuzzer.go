@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuzzer
+
+import (
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/pointer"
+
+	"github.com/cert-manager/cert-manager/internal/apis/config/controller"
+)
+
+// Funcs returns the fuzzer functions for the controller config api group.
+var Funcs = func(codecs runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(s *controller.ControllerConfiguration, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.KubernetesAPIQPS == nil {
+				s.KubernetesAPIQPS = pointer.Float32(10)
+			}
+			if s.KubernetesAPIBurst == nil {
+				s.KubernetesAPIBurst = pointer.Int(12)
+			}
+			if s.ClusterResourceNamespace == "" {
+				s.ClusterResourceNamespace = "test-namespace"
+			}
+			if len(s.Controllers) == 0 {
+				s.Controllers = []string{"*"}
+			}
+			if s.ClusterIssuerAmbientCredentials == nil {
+				s.ClusterIssuerAmbientCredentials = pointer.Bool(true)
+			}
+			if len(s.CopiedAnnotationPrefixes) == 0 {
+				s.CopiedAnnotationPrefixes = []string{"*"}
+			}
+			if s.MaxConcurrentChallenges == nil {
+				s.MaxConcurrentChallenges = pointer.Int(42)
+			}
+			if s.MetricsListenAddress == "" {
+				s.MetricsListenAddress = "something:1234"
+			}
+			if s.PprofAddress == "" {
+				s.PprofAddress = "something:1234"
+			}
+		},
+		func(s *controller.LeaderElectionConfig, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.Enabled == nil {
+				s.Enabled = pointer.Bool(true)
+			}
+			if s.Namespace == "" {
+				s.Namespace = "test-namespace"
+			}
+			if s.LeaseDuration.Duration == 0 {
+				s.LeaseDuration = metav1.Duration{Duration: time.Second * 8875}
+			}
+			if s.RenewDeadline.Duration == 0 {
+				s.RenewDeadline = metav1.Duration{Duration: time.Second * 8875}
+			}
+			if s.RetryPeriod.Duration == 0 {
+				s.RetryPeriod = metav1.Duration{Duration: time.Second * 8875}
+			}
+		},
+		func(s *controller.ShardingConfig, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.LeaseDuration.Duration == 0 {
+				s.LeaseDuration = metav1.Duration{Duration: time.Second * 8875}
+			}
+		},
+		func(s *controller.TracingConfig, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.SamplingRatePerMillion == nil {
+				s.SamplingRatePerMillion = pointer.Int32(3)
+			}
+		},
+		func(s *controller.WorkersConfig, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.Default == nil {
+				s.Default = pointer.Int(3)
+			}
+		},
+		func(s *controller.IngressShimConfig, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.DefaultIssuerKind == "" {
+				s.DefaultIssuerKind = "Issuer"
+			}
+			if s.DefaultIssuerGroup == "" {
+				s.DefaultIssuerGroup = "test-group"
+			}
+			if len(s.DefaultAutoCertificateAnnotations) == 0 {
+				s.DefaultAutoCertificateAnnotations = []string{"test-annotation"}
+			}
+		},
+		func(s *controller.ACMEHTTP01Config, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.SolverImage == "" {
+				s.SolverImage = "test-image"
+			}
+			if s.SolverResourceRequestCPU == "" {
+				s.SolverResourceRequestCPU = "10m"
+			}
+			if s.SolverResourceRequestMemory == "" {
+				s.SolverResourceRequestMemory = "64Mi"
+			}
+			if s.SolverResourceLimitsCPU == "" {
+				s.SolverResourceLimitsCPU = "100m"
+			}
+			if s.SolverResourceLimitsMemory == "" {
+				s.SolverResourceLimitsMemory = "64Mi"
+			}
+			if s.SolverRunAsNonRoot == nil {
+				s.SolverRunAsNonRoot = pointer.Bool(true)
+			}
+		},
+		func(s *controller.ACMEDNS01Config, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			if s.CheckRetryPeriod.Duration == 0 {
+				s.CheckRetryPeriod = metav1.Duration{Duration: time.Second * 8875}
+			}
+		},
+	}
+}
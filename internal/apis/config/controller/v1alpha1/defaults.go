@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	cmdutil "github.com/cert-manager/cert-manager/internal/cmd/util"
+	cm "github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	"github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
+	"github.com/cert-manager/cert-manager/pkg/util"
+)
+
+var (
+	defaultKubernetesAPIQPS   float32 = 20
+	defaultKubernetesAPIBurst         = 50
+
+	defaultClusterResourceNamespace = "kube-system"
+
+	defaultClusterIssuerAmbientCredentials = true
+
+	defaultShardingLeaseDuration = 30 * time.Second
+
+	defaultTracingSamplingRatePerMillion int32 = 1000000
+
+	defaultNumberOfConcurrentWorkers = 5
+	defaultMaxConcurrentChallenges   = 60
+
+	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
+
+	defaultEnabledControllers = []string{"*"}
+
+	defaultTLSACMEIssuerKind          = "Issuer"
+	defaultTLSACMEIssuerGroup         = cm.GroupName
+	defaultAutoCertificateAnnotations = []string{"kubernetes.io/tls-acme"}
+
+	defaultACMEHTTP01SolverImage                 = fmt.Sprintf("quay.io/jetstack/cert-manager-acmesolver:%s", util.AppVersion)
+	defaultACMEHTTP01SolverResourceRequestCPU    = "10m"
+	defaultACMEHTTP01SolverResourceRequestMemory = "64Mi"
+	defaultACMEHTTP01SolverResourceLimitsCPU     = "100m"
+	defaultACMEHTTP01SolverResourceLimitsMemory  = "64Mi"
+	defaultACMEHTTP01SolverRunAsNonRoot          = true
+
+	// default time period to wait between checking DNS01 and HTTP01 challenge propagation
+	defaultDNS01CheckRetryPeriod = 10 * time.Second
+
+	// Annotations that will be copied from Certificate to CertificateRequest and to Order.
+	// By default, copy all annotations except for the ones applied by kubectl, fluxcd, argocd.
+	defaultCopiedAnnotationPrefixes = []string{
+		"*",
+		"-kubectl.kubernetes.io/",
+		"-fluxcd.io/",
+		"-argocd.argoproj.io/",
+	}
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration) {
+	if obj.KubernetesAPIQPS == nil {
+		obj.KubernetesAPIQPS = pointer.Float32(defaultKubernetesAPIQPS)
+	}
+	if obj.KubernetesAPIBurst == nil {
+		obj.KubernetesAPIBurst = pointer.Int(defaultKubernetesAPIBurst)
+	}
+	if obj.ClusterResourceNamespace == "" {
+		obj.ClusterResourceNamespace = defaultClusterResourceNamespace
+	}
+	if obj.Controllers == nil {
+		obj.Controllers = defaultEnabledControllers
+	}
+	if obj.ClusterIssuerAmbientCredentials == nil {
+		obj.ClusterIssuerAmbientCredentials = pointer.Bool(defaultClusterIssuerAmbientCredentials)
+	}
+	if obj.CopiedAnnotationPrefixes == nil {
+		obj.CopiedAnnotationPrefixes = defaultCopiedAnnotationPrefixes
+	}
+	if obj.MaxConcurrentChallenges == nil {
+		obj.MaxConcurrentChallenges = pointer.Int(defaultMaxConcurrentChallenges)
+	}
+	if obj.MetricsListenAddress == "" {
+		obj.MetricsListenAddress = defaultPrometheusMetricsServerAddress
+	}
+	if obj.PprofAddress == "" {
+		obj.PprofAddress = cmdutil.DefaultProfilerAddr
+	}
+}
+
+func SetDefaults_LeaderElectionConfig(obj *v1alpha1.LeaderElectionConfig) {
+	if obj.Enabled == nil {
+		obj.Enabled = pointer.Bool(cmdutil.DefaultLeaderElect)
+	}
+	if obj.Namespace == "" {
+		obj.Namespace = cmdutil.DefaultLeaderElectionNamespace
+	}
+	if obj.LeaseDuration.Duration == 0 {
+		obj.LeaseDuration.Duration = cmdutil.DefaultLeaderElectionLeaseDuration
+	}
+	if obj.RenewDeadline.Duration == 0 {
+		obj.RenewDeadline.Duration = cmdutil.DefaultLeaderElectionRenewDeadline
+	}
+	if obj.RetryPeriod.Duration == 0 {
+		obj.RetryPeriod.Duration = cmdutil.DefaultLeaderElectionRetryPeriod
+	}
+}
+
+func SetDefaults_ShardingConfig(obj *v1alpha1.ShardingConfig) {
+	if obj.LeaseDuration.Duration == 0 {
+		obj.LeaseDuration.Duration = defaultShardingLeaseDuration
+	}
+}
+
+func SetDefaults_TracingConfig(obj *v1alpha1.TracingConfig) {
+	if obj.SamplingRatePerMillion == nil {
+		obj.SamplingRatePerMillion = pointer.Int32(defaultTracingSamplingRatePerMillion)
+	}
+}
+
+func SetDefaults_WorkersConfig(obj *v1alpha1.WorkersConfig) {
+	if obj.Default == nil {
+		obj.Default = pointer.Int(defaultNumberOfConcurrentWorkers)
+	}
+}
+
+func SetDefaults_IngressShimConfig(obj *v1alpha1.IngressShimConfig) {
+	if obj.DefaultIssuerKind == "" {
+		obj.DefaultIssuerKind = defaultTLSACMEIssuerKind
+	}
+	if obj.DefaultIssuerGroup == "" {
+		obj.DefaultIssuerGroup = defaultTLSACMEIssuerGroup
+	}
+	if obj.DefaultAutoCertificateAnnotations == nil {
+		obj.DefaultAutoCertificateAnnotations = defaultAutoCertificateAnnotations
+	}
+}
+
+func SetDefaults_ACMEHTTP01Config(obj *v1alpha1.ACMEHTTP01Config) {
+	if obj.SolverImage == "" {
+		obj.SolverImage = defaultACMEHTTP01SolverImage
+	}
+	if obj.SolverResourceRequestCPU == "" {
+		obj.SolverResourceRequestCPU = defaultACMEHTTP01SolverResourceRequestCPU
+	}
+	if obj.SolverResourceRequestMemory == "" {
+		obj.SolverResourceRequestMemory = defaultACMEHTTP01SolverResourceRequestMemory
+	}
+	if obj.SolverResourceLimitsCPU == "" {
+		obj.SolverResourceLimitsCPU = defaultACMEHTTP01SolverResourceLimitsCPU
+	}
+	if obj.SolverResourceLimitsMemory == "" {
+		obj.SolverResourceLimitsMemory = defaultACMEHTTP01SolverResourceLimitsMemory
+	}
+	if obj.SolverRunAsNonRoot == nil {
+		obj.SolverRunAsNonRoot = pointer.Bool(defaultACMEHTTP01SolverRunAsNonRoot)
+	}
+}
+
+func SetDefaults_ACMEDNS01Config(obj *v1alpha1.ACMEDNS01Config) {
+	if obj.CheckRetryPeriod.Duration == 0 {
+		obj.CheckRetryPeriod.Duration = defaultDNS01CheckRetryPeriod
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+func ValidateControllerConfiguration(cfg *config.ControllerConfiguration) error {
+	var allErrors []error
+	if len(cfg.IngressShimConfig.DefaultIssuerKind) == 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: ingressShimConfig.defaultIssuerKind (--default-issuer-kind) must not be empty"))
+	}
+
+	if cfg.KubernetesAPIBurst == nil || *cfg.KubernetesAPIBurst <= 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: kubernetesAPIBurst (--kube-api-burst) must be higher than 0"))
+	}
+	if cfg.KubernetesAPIQPS == nil || *cfg.KubernetesAPIQPS <= 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: kubernetesAPIQPS (--kube-api-qps) must be higher than 0"))
+	}
+	if cfg.KubernetesAPIBurst != nil && cfg.KubernetesAPIQPS != nil && float32(*cfg.KubernetesAPIBurst) < *cfg.KubernetesAPIQPS {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: kubernetesAPIBurst (--kube-api-burst) %v must be higher or equal to kubernetesAPIQPS (--kube-api-qps) %v", *cfg.KubernetesAPIBurst, *cfg.KubernetesAPIQPS))
+	}
+
+	if cfg.ShardingConfig.Enabled && cfg.ShardingConfig.LeaseDuration.Duration < 3*time.Second {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: shardingConfig.leaseDuration (--sharding-lease-duration) must be at least 3s"))
+	}
+
+	if rate := cfg.TracingConfig.SamplingRatePerMillion; rate == nil || *rate < 0 || *rate > 1000000 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: tracingConfig.samplingRatePerMillion (--tracing-sampling-rate-per-million) must be between 0 and 1000000"))
+	}
+
+	if cfg.Workers.Default == nil || *cfg.Workers.Default <= 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: workers.default (--concurrent-workers) must be higher than 0"))
+	}
+	for name, workers := range cfg.Workers.Controllers {
+		if workers <= 0 {
+			allErrors = append(allErrors, fmt.Errorf("invalid configuration: workers.controllers[%s] must be higher than 0", name))
+		}
+	}
+	if cfg.MaxConcurrentChallenges == nil || *cfg.MaxConcurrentChallenges <= 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: maxConcurrentChallenges (--max-concurrent-challenges) must be higher than 0"))
+	}
+
+	for _, server := range cfg.ACMEDNS01Config.RecursiveNameservers {
+		if err := dnsutil.ValidateNameserver(server); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("invalid configuration: acmeDNS01Config.recursiveNameservers (--dns01-recursive-nameservers) contains an invalid DNS server (%v): %v", err, server))
+		}
+	}
+	for _, server := range cfg.ACMEHTTP01Config.SolverNameservers {
+		// ensure all servers have a port number
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("invalid configuration: acmeHTTP01Config.solverNameservers (--acme-http01-solver-nameservers) contains an invalid DNS server (%v): %v", err, server))
+		}
+	}
+	return utilerrors.NewAggregate(allErrors)
+}
@@ -47,7 +47,8 @@ type LocalObjectReference struct {
 	Name string
 }
 
-// ObjectReference is a reference to an object with a given name, kind and group.
+// ObjectReference is a reference to an object with a given name, kind, group
+// and optionally namespace.
 type ObjectReference struct {
 	// Name of the resource being referred to.
 	Name string
@@ -55,6 +56,13 @@ type ObjectReference struct {
 	Kind string
 	// Group of the resource being referred to.
 	Group string
+	// Namespace of the resource being referred to.
+	// May only be set when referencing an Issuer in another namespace, in
+	// which case a Gateway API ReferenceGrant in that namespace must permit
+	// CertificateRequests in the namespace of the referring resource to
+	// reference the Issuer. Certificates require the same grant, as they are
+	// issued through CertificateRequests.
+	Namespace string
 }
 
 // A reference to a specific 'key' within a Secret resource.
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package celpolicy compiles and evaluates CEL expressions over a typed view
+// of a certificate request. It is used by the policy approver to evaluate the
+// validations of CertificateRequestPolicies, and by the webhook to reject
+// policies whose validations do not compile.
+package celpolicy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	"github.com/google/cel-go/common/types"
+)
+
+// CostBudget is the maximum cost of evaluating a single expression. An
+// expression whose estimated worst case cost exceeds the budget is rejected
+// when it is compiled, and evaluation is aborted if it is exceeded at
+// runtime.
+const CostBudget = 1000000
+
+const (
+	// maxListSize and maxStringSize bound the size of variables when
+	// estimating the cost of an expression.
+	maxListSize   = 128
+	maxStringSize = 1024
+)
+
+// Input is the typed view of a certificate request which expressions are
+// evaluated over. Each field is available to expressions as a variable with
+// the name given in its comment.
+type Input struct {
+	// commonName, dnsNames, ipAddresses, uris and emailAddresses are decoded
+	// from the x509 certificate request.
+	CommonName     string
+	DNSNames       []string
+	IPAddresses    []string
+	URIs           []string
+	EmailAddresses []string
+
+	// subject is a map of the other subject fields of the x509 certificate
+	// request, keyed by organizations, organizationalUnits, countries,
+	// provinces, localities, streetAddresses and postalCodes.
+	Subject map[string][]string
+
+	// keyAlgorithm is one of RSA, ECDSA and Ed25519, and keySize is the size
+	// of the public key in bits, or 0 for Ed25519 keys.
+	KeyAlgorithm string
+	KeySize      int
+
+	// duration is the requested duration of the certificate.
+	Duration time.Duration
+
+	// usages are the requested key usages, and isCA is true if a CA
+	// certificate is requested.
+	Usages []string
+	IsCA   bool
+
+	// username and groups identify the user who created the request.
+	Username string
+	Groups   []string
+
+	// namespaceName and namespaceLabels are the name and labels of the
+	// namespace of the request. For CertificateSigningRequests this is the
+	// namespace of the referenced Issuer, and empty for ClusterIssuers.
+	NamespaceName   string
+	NamespaceLabels map[string]string
+
+	// issuerName, issuerKind and issuerGroup identify the referenced issuer.
+	IssuerName  string
+	IssuerKind  string
+	IssuerGroup string
+}
+
+var env = func() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("commonName", cel.StringType),
+		cel.Variable("dnsNames", cel.ListType(cel.StringType)),
+		cel.Variable("ipAddresses", cel.ListType(cel.StringType)),
+		cel.Variable("uris", cel.ListType(cel.StringType)),
+		cel.Variable("emailAddresses", cel.ListType(cel.StringType)),
+		cel.Variable("subject", cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
+		cel.Variable("keyAlgorithm", cel.StringType),
+		cel.Variable("keySize", cel.IntType),
+		cel.Variable("duration", cel.DurationType),
+		cel.Variable("usages", cel.ListType(cel.StringType)),
+		cel.Variable("isCA", cel.BoolType),
+		cel.Variable("username", cel.StringType),
+		cel.Variable("groups", cel.ListType(cel.StringType)),
+		cel.Variable("namespaceName", cel.StringType),
+		cel.Variable("namespaceLabels", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("issuerName", cel.StringType),
+		cel.Variable("issuerKind", cel.StringType),
+		cel.Variable("issuerGroup", cel.StringType),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create CEL environment: %v", err))
+	}
+	return env
+}()
+
+// Program is a compiled expression.
+type Program struct {
+	program cel.Program
+}
+
+// Compile type checks the expression, which must evaluate to a bool, and
+// checks that its estimated worst case cost is within CostBudget.
+func Compile(expression string) (*Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", ast.OutputType())
+	}
+
+	cost, err := env.EstimateCost(ast, sizeEstimator{})
+	if err != nil {
+		return nil, err
+	}
+	if cost.Max > CostBudget {
+		return nil, fmt.Errorf("estimated cost %d exceeds the budget of %d", cost.Max, CostBudget)
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(CostBudget))
+	if err != nil {
+		return nil, err
+	}
+	return &Program{program: program}, nil
+}
+
+// Eval evaluates the program over the input.
+func (p *Program) Eval(in *Input) (bool, error) {
+	out, _, err := p.program.Eval(map[string]interface{}{
+		"commonName":      in.CommonName,
+		"dnsNames":        in.DNSNames,
+		"ipAddresses":     in.IPAddresses,
+		"uris":            in.URIs,
+		"emailAddresses":  in.EmailAddresses,
+		"subject":         in.Subject,
+		"keyAlgorithm":    in.KeyAlgorithm,
+		"keySize":         in.KeySize,
+		"duration":        in.Duration,
+		"usages":          in.Usages,
+		"isCA":            in.IsCA,
+		"username":        in.Username,
+		"groups":          in.Groups,
+		"namespaceName":   in.NamespaceName,
+		"namespaceLabels": in.NamespaceLabels,
+		"issuerName":      in.IssuerName,
+		"issuerKind":      in.IssuerKind,
+		"issuerGroup":     in.IssuerGroup,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %s, not bool", out.Type())
+	}
+	return bool(result), nil
+}
+
+// sizeEstimator bounds the size of lists, maps and strings when estimating
+// the cost of an expression, since the size of the variables is otherwise
+// unknown.
+type sizeEstimator struct{}
+
+func (sizeEstimator) EstimateSize(element checker.AstNode) *checker.SizeEstimate {
+	switch {
+	case element.Type().GetListType() != nil, element.Type().GetMapType() != nil:
+		return &checker.SizeEstimate{Min: 0, Max: maxListSize}
+	default:
+		return &checker.SizeEstimate{Min: 0, Max: maxStringSize}
+	}
+}
+
+func (sizeEstimator) EstimateCallCost(function, overloadID string, target *checker.AstNode, args []checker.AstNode) *checker.CallEstimate {
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	tests := map[string]struct {
+		expression string
+		expErr     string
+	}{
+		"valid expression": {
+			expression: `dnsNames.all(n, n.endsWith("." + namespaceName + ".svc"))`,
+		},
+		"duration and labels": {
+			expression: `duration <= duration("24h") && namespaceLabels["team"] == "a"`,
+		},
+		"syntax error": {
+			expression: `dnsNames.all(n,`,
+			expErr:     "Syntax error",
+		},
+		"undeclared variable": {
+			expression: `request.dnsNames == []`,
+			expErr:     "undeclared reference to 'request'",
+		},
+		"not a bool": {
+			expression: `keySize + 1`,
+			expErr:     "expression must evaluate to a bool, not int",
+		},
+		"too expensive": {
+			expression: `dnsNames.all(a, dnsNames.all(b, dnsNames.all(c, a + b + c != "")))`,
+			expErr:     "exceeds the budget of 1000000",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Compile(test.expression)
+			if test.expErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expErr)
+			}
+		})
+	}
+}
+
+func TestEval(t *testing.T) {
+	in := &Input{
+		CommonName:      "app",
+		DNSNames:        []string{"app.team-a.svc", "app.team-a.svc.cluster.local"},
+		Subject:         map[string][]string{"organizations": {"Example"}},
+		KeyAlgorithm:    "ECDSA",
+		KeySize:         256,
+		Duration:        time.Hour,
+		Usages:          []string{"digital signature"},
+		Username:        "system:serviceaccount:team-a:app",
+		Groups:          []string{"system:serviceaccounts"},
+		NamespaceName:   "team-a",
+		NamespaceLabels: map[string]string{"team": "a"},
+		IssuerName:      "ca",
+		IssuerKind:      "ClusterIssuer",
+		IssuerGroup:     "cert-manager.io",
+	}
+	tests := map[string]struct {
+		expression string
+		exp        bool
+		expErr     string
+	}{
+		"SANs in namespace": {
+			expression: `dnsNames.all(n, n.startsWith(commonName + "." + namespaceName + ".svc"))`,
+			exp:        true,
+		},
+		"subject, key and duration": {
+			expression: `subject["organizations"] == ["Example"] && keyAlgorithm == "ECDSA" && keySize >= 256 && duration < duration("2h")`,
+			exp:        true,
+		},
+		"requester and issuer": {
+			expression: `username.startsWith("system:serviceaccount:" + namespaceName + ":") && issuerKind == "ClusterIssuer" && !isCA`,
+			exp:        true,
+		},
+		"false result": {
+			expression: `namespaceLabels["team"] == "b"`,
+			exp:        false,
+		},
+		"runtime error": {
+			expression: `namespaceLabels["missing"] == "b"`,
+			expErr:     "no such key: missing",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			program, err := Compile(test.expression)
+			require.NoError(t, err)
+			got, err := program.Eval(in)
+			if test.expErr != "" {
+				assert.ErrorContains(t, err, test.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.exp, got)
+		})
+	}
+}
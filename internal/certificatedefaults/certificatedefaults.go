@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certificatedefaults reads the defaults for Certificate fields that
+// can be configured per namespace using annotations on the Namespace
+// resource. The defaults are applied by the webhook when a Certificate is
+// created and by the ingress-shim when it builds Certificates.
+package certificatedefaults
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Defaults holds the defaults configured for a namespace. Empty fields have no
+// default.
+type Defaults struct {
+	IssuerName  string
+	IssuerKind  string
+	IssuerGroup string
+
+	Duration    *metav1.Duration
+	RenewBefore *metav1.Duration
+
+	PrivateKeyAlgorithm      cmapi.PrivateKeyAlgorithm
+	PrivateKeySize           int
+	PrivateKeyRotationPolicy cmapi.PrivateKeyRotationPolicy
+}
+
+// FromAnnotations parses the Certificate defaults from the annotations of a
+// Namespace. A nil Defaults is returned if no default annotations are set.
+func FromAnnotations(annotations map[string]string) (*Defaults, error) {
+	d := &Defaults{
+		IssuerName:  annotations[cmapi.DefaultIssuerNameAnnotationKey],
+		IssuerKind:  annotations[cmapi.DefaultIssuerKindAnnotationKey],
+		IssuerGroup: annotations[cmapi.DefaultIssuerGroupAnnotationKey],
+	}
+
+	if len(d.IssuerName) == 0 && (len(d.IssuerKind) > 0 || len(d.IssuerGroup) > 0) {
+		return nil, fmt.Errorf("%q must be set when %q or %q is set",
+			cmapi.DefaultIssuerNameAnnotationKey, cmapi.DefaultIssuerKindAnnotationKey, cmapi.DefaultIssuerGroupAnnotationKey)
+	}
+
+	if duration, found := annotations[cmapi.DefaultDurationAnnotationKey]; found {
+		duration, err := time.ParseDuration(duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotation %q: %v", cmapi.DefaultDurationAnnotationKey, err)
+		}
+		d.Duration = &metav1.Duration{Duration: duration}
+	}
+
+	if renewBefore, found := annotations[cmapi.DefaultRenewBeforeAnnotationKey]; found {
+		renewBefore, err := time.ParseDuration(renewBefore)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotation %q: %v", cmapi.DefaultRenewBeforeAnnotationKey, err)
+		}
+		d.RenewBefore = &metav1.Duration{Duration: renewBefore}
+	}
+
+	if algorithm, found := annotations[cmapi.DefaultPrivateKeyAlgorithmAnnotationKey]; found {
+		d.PrivateKeyAlgorithm = cmapi.PrivateKeyAlgorithm(algorithm)
+		switch d.PrivateKeyAlgorithm {
+		case cmapi.RSAKeyAlgorithm, cmapi.ECDSAKeyAlgorithm, cmapi.Ed25519KeyAlgorithm:
+		default:
+			return nil, fmt.Errorf("invalid annotation %q: unsupported private key algorithm %q", cmapi.DefaultPrivateKeyAlgorithmAnnotationKey, algorithm)
+		}
+	}
+
+	if size, found := annotations[cmapi.DefaultPrivateKeySizeAnnotationKey]; found {
+		size, err := strconv.Atoi(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotation %q: %v", cmapi.DefaultPrivateKeySizeAnnotationKey, err)
+		}
+		if size < 1 {
+			return nil, fmt.Errorf("invalid annotation %q: private key size must be a positive number", cmapi.DefaultPrivateKeySizeAnnotationKey)
+		}
+		d.PrivateKeySize = size
+	}
+
+	if rotationPolicy, found := annotations[cmapi.DefaultPrivateKeyRotationPolicyAnnotationKey]; found {
+		d.PrivateKeyRotationPolicy = cmapi.PrivateKeyRotationPolicy(rotationPolicy)
+		switch d.PrivateKeyRotationPolicy {
+		case cmapi.RotationPolicyNever, cmapi.RotationPolicyAlways:
+		default:
+			return nil, fmt.Errorf("invalid annotation %q: unsupported private key rotation policy %q", cmapi.DefaultPrivateKeyRotationPolicyAnnotationKey, rotationPolicy)
+		}
+	}
+
+	if *d == (Defaults{}) {
+		return nil, nil
+	}
+
+	return d, nil
+}
+
+// Apply sets the defaults on the fields of the Certificate that are empty.
+// The issuerRef is only defaulted if no issuer name is set. The private key
+// size is only defaulted if the Certificate does not set a different private
+// key algorithm than the default.
+func (d *Defaults) Apply(crt *cmapi.Certificate) {
+	if d == nil {
+		return
+	}
+
+	if len(crt.Spec.IssuerRef.Name) == 0 && len(d.IssuerName) > 0 {
+		crt.Spec.IssuerRef.Name = d.IssuerName
+		crt.Spec.IssuerRef.Kind = d.IssuerKind
+		crt.Spec.IssuerRef.Group = d.IssuerGroup
+	}
+
+	if crt.Spec.Duration == nil && d.Duration != nil {
+		crt.Spec.Duration = d.Duration.DeepCopy()
+	}
+
+	if crt.Spec.RenewBefore == nil && d.RenewBefore != nil {
+		crt.Spec.RenewBefore = d.RenewBefore.DeepCopy()
+	}
+
+	if len(d.PrivateKeyAlgorithm) == 0 && d.PrivateKeySize == 0 && len(d.PrivateKeyRotationPolicy) == 0 {
+		return
+	}
+
+	if crt.Spec.PrivateKey == nil {
+		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
+	}
+	pk := crt.Spec.PrivateKey
+
+	sizeApplies := len(pk.Algorithm) == 0 || pk.Algorithm == d.PrivateKeyAlgorithm
+	if len(pk.Algorithm) == 0 {
+		pk.Algorithm = d.PrivateKeyAlgorithm
+	}
+	if pk.Size == 0 && sizeApplies {
+		pk.Size = d.PrivateKeySize
+	}
+	if len(pk.RotationPolicy) == 0 {
+		pk.RotationPolicy = d.PrivateKeyRotationPolicy
+	}
+}
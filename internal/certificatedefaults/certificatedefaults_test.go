@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatedefaults
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestFromAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		annotations map[string]string
+		expected    *Defaults
+		expectErr   string
+	}{
+		"no annotations": {
+			annotations: nil,
+			expected:    nil,
+		},
+		"unrelated annotations": {
+			annotations: map[string]string{"cert-manager.io/issuer": "ca"},
+			expected:    nil,
+		},
+		"all defaults": {
+			annotations: map[string]string{
+				"cert-manager.io/default-issuer-name":                 "ca",
+				"cert-manager.io/default-issuer-kind":                 "ClusterIssuer",
+				"cert-manager.io/default-issuer-group":                "cert-manager.io",
+				"cert-manager.io/default-duration":                    "720h",
+				"cert-manager.io/default-renew-before":                "240h",
+				"cert-manager.io/default-private-key-algorithm":       "ECDSA",
+				"cert-manager.io/default-private-key-size":            "384",
+				"cert-manager.io/default-private-key-rotation-policy": "Always",
+			},
+			expected: &Defaults{
+				IssuerName:               "ca",
+				IssuerKind:               "ClusterIssuer",
+				IssuerGroup:              "cert-manager.io",
+				Duration:                 &metav1.Duration{Duration: 720 * time.Hour},
+				RenewBefore:              &metav1.Duration{Duration: 240 * time.Hour},
+				PrivateKeyAlgorithm:      cmapi.ECDSAKeyAlgorithm,
+				PrivateKeySize:           384,
+				PrivateKeyRotationPolicy: cmapi.RotationPolicyAlways,
+			},
+		},
+		"issuer kind without issuer name": {
+			annotations: map[string]string{"cert-manager.io/default-issuer-kind": "ClusterIssuer"},
+			expectErr:   `"cert-manager.io/default-issuer-name" must be set when "cert-manager.io/default-issuer-kind" or "cert-manager.io/default-issuer-group" is set`,
+		},
+		"invalid renew before": {
+			annotations: map[string]string{"cert-manager.io/default-renew-before": "soon"},
+			expectErr:   `invalid annotation "cert-manager.io/default-renew-before": time: invalid duration "soon"`,
+		},
+		"invalid private key algorithm": {
+			annotations: map[string]string{"cert-manager.io/default-private-key-algorithm": "DSA"},
+			expectErr:   `invalid annotation "cert-manager.io/default-private-key-algorithm": unsupported private key algorithm "DSA"`,
+		},
+		"invalid private key size": {
+			annotations: map[string]string{"cert-manager.io/default-private-key-size": "-1"},
+			expectErr:   `invalid annotation "cert-manager.io/default-private-key-size": private key size must be a positive number`,
+		},
+		"invalid private key rotation policy": {
+			annotations: map[string]string{"cert-manager.io/default-private-key-rotation-policy": "Sometimes"},
+			expectErr:   `invalid annotation "cert-manager.io/default-private-key-rotation-policy": unsupported private key rotation policy "Sometimes"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := FromAnnotations(test.annotations)
+			if test.expectErr != "" {
+				assert.EqualError(t, err, test.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, d)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	defaults := &Defaults{
+		IssuerName:               "ca",
+		IssuerKind:               "ClusterIssuer",
+		Duration:                 &metav1.Duration{Duration: 720 * time.Hour},
+		PrivateKeyAlgorithm:      cmapi.RSAKeyAlgorithm,
+		PrivateKeySize:           4096,
+		PrivateKeyRotationPolicy: cmapi.RotationPolicyAlways,
+	}
+
+	tests := map[string]struct {
+		defaults *Defaults
+		spec     cmapi.CertificateSpec
+		expected cmapi.CertificateSpec
+	}{
+		"nil defaults": {
+			defaults: nil,
+			spec:     cmapi.CertificateSpec{},
+			expected: cmapi.CertificateSpec{},
+		},
+		"empty fields are defaulted": {
+			defaults: defaults,
+			spec:     cmapi.CertificateSpec{},
+			expected: cmapi.CertificateSpec{
+				IssuerRef: cmmeta.ObjectReference{Name: "ca", Kind: "ClusterIssuer"},
+				Duration:  &metav1.Duration{Duration: 720 * time.Hour},
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					Algorithm:      cmapi.RSAKeyAlgorithm,
+					Size:           4096,
+					RotationPolicy: cmapi.RotationPolicyAlways,
+				},
+			},
+		},
+		"issuerRef is not merged with the default": {
+			defaults: defaults,
+			spec: cmapi.CertificateSpec{
+				IssuerRef: cmmeta.ObjectReference{Name: "my-issuer"},
+			},
+			expected: cmapi.CertificateSpec{
+				IssuerRef: cmmeta.ObjectReference{Name: "my-issuer"},
+				Duration:  &metav1.Duration{Duration: 720 * time.Hour},
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					Algorithm:      cmapi.RSAKeyAlgorithm,
+					Size:           4096,
+					RotationPolicy: cmapi.RotationPolicyAlways,
+				},
+			},
+		},
+		"private key size is not defaulted for a different algorithm": {
+			defaults: defaults,
+			spec: cmapi.CertificateSpec{
+				PrivateKey: &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+			},
+			expected: cmapi.CertificateSpec{
+				IssuerRef: cmmeta.ObjectReference{Name: "ca", Kind: "ClusterIssuer"},
+				Duration:  &metav1.Duration{Duration: 720 * time.Hour},
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					Algorithm:      cmapi.ECDSAKeyAlgorithm,
+					RotationPolicy: cmapi.RotationPolicyAlways,
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: test.spec}
+			test.defaults.Apply(crt)
+			assert.Equal(t, test.expected, crt.Spec)
+		})
+	}
+}
@@ -0,0 +1,378 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approverpolicy evaluates CertificateRequestPolicies against
+// CertificateRequests and CertificateSigningRequests. It is shared by the
+// policy approver controllers of both APIs.
+package approverpolicy
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/lru"
+
+	"github.com/cert-manager/cert-manager/internal/celpolicy"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Reason is the reason set on the Approved and Denied conditions by the
+// policy approver.
+const Reason = "policy.cert-manager.io"
+
+// programCacheSize is the maximum number of compiled validation expressions
+// which are kept in memory.
+const programCacheSize = 1024
+
+// programs caches the result of compiling each validation expression, so that
+// an expression is not compiled again every time a request is evaluated.
+// Compiling is deterministic, so the result is keyed by the expression alone
+// and shared between policies.
+var programs = lru.New(programCacheSize)
+
+// compiled is the result of compiling an expression.
+type compiled struct {
+	program *celpolicy.Program
+	err     error
+}
+
+// compile returns the compiled expression from the cache, compiling and
+// caching it if it isn't there yet.
+func compile(expression string) (*celpolicy.Program, error) {
+	if c, ok := programs.Get(expression); ok {
+		return c.(compiled).program, c.(compiled).err
+	}
+	program, err := celpolicy.Compile(expression)
+	programs.Add(expression, compiled{program: program, err: err})
+	return program, err
+}
+
+// Decision is the result of evaluating a request against all policies.
+type Decision struct {
+	// Matched is false if no policy selects the request, in which case the
+	// request should be left for another approver.
+	Matched bool
+
+	// Approved is true if at least one matching policy allows the request.
+	Approved bool
+
+	// Message names the policy which approved the request, or every rule
+	// the matching policies found violated.
+	Message string
+}
+
+// Evaluate evaluates the request against every policy which selects it. The
+// request is approved if any of them allows it.
+func Evaluate(policies []*cmapi.CertificateRequestPolicy, req *Request) Decision {
+	policies = append([]*cmapi.CertificateRequestPolicy(nil), policies...)
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+
+	var denials []string
+	for _, policy := range policies {
+		if !Matches(policy, req) {
+			continue
+		}
+
+		violations := Violations(policy, req)
+		if len(violations) == 0 {
+			return Decision{
+				Matched:  true,
+				Approved: true,
+				Message:  fmt.Sprintf("Approved by CertificateRequestPolicy %q", policy.Name),
+			}
+		}
+		denials = append(denials, fmt.Sprintf("CertificateRequestPolicy %q: %s", policy.Name, strings.Join(violations, ", ")))
+	}
+
+	if len(denials) == 0 {
+		return Decision{}
+	}
+
+	return Decision{
+		Matched: true,
+		Message: fmt.Sprintf("Denied by all matching policies: [%s]", strings.Join(denials, "; ")),
+	}
+}
+
+// Matches returns true if the policy's selector selects the request.
+func Matches(policy *cmapi.CertificateRequestPolicy, req *Request) bool {
+	if sel := policy.Spec.Selector.IssuerRef; sel != nil {
+		if !wildcardMatchOrEmpty(sel.Name, req.IssuerRef.Name) ||
+			!wildcardMatchOrEmpty(sel.Kind, req.IssuerRef.Kind) ||
+			!wildcardMatchOrEmpty(sel.Group, req.IssuerRef.Group) {
+			return false
+		}
+	}
+
+	if sel := policy.Spec.Selector.Requester; sel != nil {
+		if !matchesAny(sel.Usernames, req.Username) && !matchesAnyOf(sel.Groups, req.Groups) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Violations returns a description of every rule of the policy which the
+// request violates, prefixed with the path of the rule.
+func Violations(policy *cmapi.CertificateRequestPolicy, req *Request) []string {
+	var violations []string
+	violate := func(path *field.Path, format string, args ...interface{}) {
+		violations = append(violations, path.String()+": "+fmt.Sprintf(format, args...))
+	}
+
+	allowedPath := field.NewPath("spec", "allowed")
+	allowed := policy.Spec.Allowed
+	if allowed == nil {
+		allowed = &cmapi.CertificateRequestPolicyAllowed{}
+	}
+
+	if cn := req.CSR.Subject.CommonName; cn != "" {
+		if allowed.CommonName == nil || !wildcardMatch(*allowed.CommonName, cn) {
+			violate(allowedPath.Child("commonName"), "%q is not allowed", cn)
+		}
+	}
+
+	checkAll := func(path *field.Path, patterns, values []string) {
+		for _, v := range values {
+			if !matchesAny(patterns, v) {
+				violate(path, "%q is not allowed", v)
+			}
+		}
+	}
+	checkAll(allowedPath.Child("dnsNames"), allowed.DNSNames, req.CSR.DNSNames)
+	var ips, uris []string
+	for _, ip := range req.CSR.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+	for _, uri := range req.CSR.URIs {
+		uris = append(uris, uri.String())
+	}
+	checkAll(allowedPath.Child("ipAddresses"), allowed.IPAddresses, ips)
+	checkAll(allowedPath.Child("uris"), allowed.URIs, uris)
+	checkAll(allowedPath.Child("emailAddresses"), allowed.EmailAddresses, req.CSR.EmailAddresses)
+
+	for _, usage := range req.Usages {
+		if !hasUsage(allowed.Usages, usage) {
+			violate(allowedPath.Child("usages"), "%q is not allowed", usage)
+		}
+	}
+
+	if req.IsCA && !allowed.IsCA {
+		violate(allowedPath.Child("isCA"), "CA certificates are not allowed")
+	}
+
+	if constraints := policy.Spec.Constraints; constraints != nil {
+		violations = append(violations, constraintViolations(constraints, req)...)
+	}
+
+	var in *celpolicy.Input
+	for i, v := range policy.Spec.Validations {
+		path := field.NewPath("spec", "validations").Index(i)
+		message := v.Message
+		if message == "" {
+			message = v.Expression
+		}
+
+		program, err := compile(v.Expression)
+		if err != nil {
+			violate(path, "failed to compile %q: %s", v.Expression, err)
+			continue
+		}
+		if in == nil {
+			in = celInput(req)
+		}
+		ok, err := program.Eval(in)
+		switch {
+		case err != nil:
+			violate(path, "failed to evaluate %q: %s", v.Expression, err)
+		case !ok:
+			violate(path, "%s", message)
+		}
+	}
+
+	return violations
+}
+
+// constraintViolations returns a description of every constraint which the
+// request violates, prefixed with the path of the constraint.
+func constraintViolations(constraints *cmapi.CertificateRequestPolicyConstraints, req *Request) []string {
+	var violations []string
+	violate := func(path *field.Path, format string, args ...interface{}) {
+		violations = append(violations, path.String()+": "+fmt.Sprintf(format, args...))
+	}
+
+	constraintsPath := field.NewPath("spec", "constraints")
+
+	if constraints.MinDuration != nil && req.Duration < constraints.MinDuration.Duration {
+		violate(constraintsPath.Child("minDuration"), "duration %s is less than %s", req.Duration, constraints.MinDuration.Duration)
+	}
+	if constraints.MaxDuration != nil && req.Duration > constraints.MaxDuration.Duration {
+		violate(constraintsPath.Child("maxDuration"), "duration %s is greater than %s", req.Duration, constraints.MaxDuration.Duration)
+	}
+
+	if pk := constraints.PrivateKey; pk != nil {
+		pkPath := constraintsPath.Child("privateKey")
+		algorithm, size := publicKeyAlgorithmAndSize(req.CSR.PublicKey)
+		if pk.Algorithm != nil && *pk.Algorithm != algorithm {
+			violate(pkPath.Child("algorithm"), "key algorithm %q is not allowed", algorithm)
+		}
+		if size > 0 {
+			if pk.MinSize != nil && size < *pk.MinSize {
+				violate(pkPath.Child("minSize"), "key size %d is less than %d", size, *pk.MinSize)
+			}
+			if pk.MaxSize != nil && size > *pk.MaxSize {
+				violate(pkPath.Child("maxSize"), "key size %d is greater than %d", size, *pk.MaxSize)
+			}
+		}
+	}
+
+	return violations
+}
+
+// NeedNamespaceLabels returns true if any of the policies has validations,
+// which need the labels of the namespace of the request.
+func NeedNamespaceLabels(policies []*cmapi.CertificateRequestPolicy) bool {
+	for _, policy := range policies {
+		if len(policy.Spec.Validations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// celInput returns the view of the request which validations are evaluated
+// over.
+func celInput(req *Request) *celpolicy.Input {
+	in := &celpolicy.Input{
+		CommonName:     req.CSR.Subject.CommonName,
+		DNSNames:       req.CSR.DNSNames,
+		EmailAddresses: req.CSR.EmailAddresses,
+		Subject: map[string][]string{
+			"organizations":       req.CSR.Subject.Organization,
+			"organizationalUnits": req.CSR.Subject.OrganizationalUnit,
+			"countries":           req.CSR.Subject.Country,
+			"provinces":           req.CSR.Subject.Province,
+			"localities":          req.CSR.Subject.Locality,
+			"streetAddresses":     req.CSR.Subject.StreetAddress,
+			"postalCodes":         req.CSR.Subject.PostalCode,
+		},
+		Duration:        req.Duration,
+		IsCA:            req.IsCA,
+		Username:        req.Username,
+		Groups:          req.Groups,
+		NamespaceName:   req.Namespace,
+		NamespaceLabels: req.NamespaceLabels,
+		IssuerName:      req.IssuerRef.Name,
+		IssuerKind:      req.IssuerRef.Kind,
+		IssuerGroup:     req.IssuerRef.Group,
+	}
+
+	algorithm, size := publicKeyAlgorithmAndSize(req.CSR.PublicKey)
+	in.KeyAlgorithm, in.KeySize = string(algorithm), size
+
+	for _, ip := range req.CSR.IPAddresses {
+		in.IPAddresses = append(in.IPAddresses, ip.String())
+	}
+	for _, uri := range req.CSR.URIs {
+		in.URIs = append(in.URIs, uri.String())
+	}
+	for _, usage := range req.Usages {
+		in.Usages = append(in.Usages, string(usage))
+	}
+
+	return in
+}
+
+// publicKeyAlgorithmAndSize returns the algorithm and size in bits of the
+// public key. The size is 0 for Ed25519 and unknown key types.
+func publicKeyAlgorithmAndSize(pub interface{}) (cmapi.PrivateKeyAlgorithm, int) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return cmapi.RSAKeyAlgorithm, pub.N.BitLen()
+	case *ecdsa.PublicKey:
+		return cmapi.ECDSAKeyAlgorithm, pub.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return cmapi.Ed25519KeyAlgorithm, 0
+	default:
+		return "", 0
+	}
+}
+
+func hasUsage(usages []cmapi.KeyUsage, usage cmapi.KeyUsage) bool {
+	for _, u := range usages {
+		if u == usage {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyOf returns true if any of the values matches any of the
+// patterns.
+func matchesAnyOf(patterns, values []string) bool {
+	for _, v := range values {
+		if matchesAny(patterns, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny returns true if the value matches any of the patterns.
+func matchesAny(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if wildcardMatch(p, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcardMatchOrEmpty returns true if the pattern is empty or matches the
+// value.
+func wildcardMatchOrEmpty(pattern, value string) bool {
+	return pattern == "" || wildcardMatch(pattern, value)
+}
+
+// wildcardMatch returns true if the value matches the pattern, where each
+// `*` in the pattern matches any sequence of characters.
+func wildcardMatch(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
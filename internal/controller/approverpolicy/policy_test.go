@@ -0,0 +1,358 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approverpolicy
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func mustRequest(t *testing.T, keyAlgorithm x509.PublicKeyAlgorithm, mods ...gen.CSRModifier) *Request {
+	csrPEM, _, err := gen.CSR(keyAlgorithm, mods...)
+	require.NoError(t, err)
+	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+	require.NoError(t, err)
+	return &Request{
+		IssuerRef: cmmeta.ObjectReference{Name: "ca", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"},
+		Username:  "system:serviceaccount:team-a:app",
+		Groups:    []string{"system:serviceaccounts", "system:serviceaccounts:team-a"},
+		CSR:       csr,
+		Duration:  cmapi.DefaultCertificateDuration,
+		Usages:    cmapi.DefaultKeyUsages(),
+	}
+}
+
+func policy(name string, spec cmapi.CertificateRequestPolicySpec) *cmapi.CertificateRequestPolicy {
+	return &cmapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+}
+
+func TestEvaluate(t *testing.T) {
+	allowTeamA := policy("team-a", cmapi.CertificateRequestPolicySpec{
+		Selector: cmapi.CertificateRequestPolicySelector{
+			Requester: &cmapi.CertificateRequestPolicyRequesterSelector{Groups: []string{"system:serviceaccounts:team-a"}},
+		},
+		Allowed: &cmapi.CertificateRequestPolicyAllowed{
+			DNSNames: []string{"*.team-a.example.com"},
+			Usages:   cmapi.DefaultKeyUsages(),
+		},
+	})
+	otherIssuer := policy("other-issuer", cmapi.CertificateRequestPolicySpec{
+		Selector: cmapi.CertificateRequestPolicySelector{
+			IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Name: "vault-*"},
+		},
+	})
+
+	tests := map[string]struct {
+		policies []*cmapi.CertificateRequestPolicy
+		req      *Request
+		exp      Decision
+	}{
+		"no policies leaves the request alone": {
+			req: mustRequest(t, x509.RSA, gen.SetCSRDNSNames("app.team-a.example.com")),
+			exp: Decision{},
+		},
+		"no matching policy leaves the request alone": {
+			policies: []*cmapi.CertificateRequestPolicy{otherIssuer},
+			req:      mustRequest(t, x509.RSA, gen.SetCSRDNSNames("app.team-a.example.com")),
+			exp:      Decision{},
+		},
+		"matching policy allowing the request approves it": {
+			policies: []*cmapi.CertificateRequestPolicy{otherIssuer, allowTeamA},
+			req:      mustRequest(t, x509.RSA, gen.SetCSRDNSNames("app.team-a.example.com")),
+			exp:      Decision{Matched: true, Approved: true, Message: `Approved by CertificateRequestPolicy "team-a"`},
+		},
+		"matching policy violated denies the request naming the rule": {
+			policies: []*cmapi.CertificateRequestPolicy{allowTeamA},
+			req:      mustRequest(t, x509.RSA, gen.SetCSRDNSNames("app.team-b.example.com")),
+			exp: Decision{
+				Matched: true,
+				Message: `Denied by all matching policies: [CertificateRequestPolicy "team-a": spec.allowed.dnsNames: "app.team-b.example.com" is not allowed]`,
+			},
+		},
+		"any matching policy allowing the request approves it": {
+			policies: []*cmapi.CertificateRequestPolicy{
+				policy("strict", cmapi.CertificateRequestPolicySpec{}),
+				allowTeamA,
+			},
+			req: mustRequest(t, x509.RSA, gen.SetCSRDNSNames("app.team-a.example.com")),
+			exp: Decision{Matched: true, Approved: true, Message: `Approved by CertificateRequestPolicy "team-a"`},
+		},
+		"all violations of all matching policies are reported": {
+			policies: []*cmapi.CertificateRequestPolicy{
+				allowTeamA,
+				policy("strict", cmapi.CertificateRequestPolicySpec{}),
+			},
+			req: mustRequest(t, x509.RSA, gen.SetCSRCommonName("app"), gen.SetCSRDNSNames("app.team-b.example.com")),
+			exp: Decision{
+				Matched: true,
+				Message: `Denied by all matching policies: [` +
+					`CertificateRequestPolicy "strict": spec.allowed.commonName: "app" is not allowed, spec.allowed.dnsNames: "app.team-b.example.com" is not allowed, spec.allowed.usages: "digital signature" is not allowed, spec.allowed.usages: "key encipherment" is not allowed; ` +
+					`CertificateRequestPolicy "team-a": spec.allowed.commonName: "app" is not allowed, spec.allowed.dnsNames: "app.team-b.example.com" is not allowed]`,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, Evaluate(test.policies, test.req))
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	req := mustRequest(t, x509.RSA)
+	tests := map[string]struct {
+		selector cmapi.CertificateRequestPolicySelector
+		exp      bool
+	}{
+		"empty selector matches everything": {
+			exp: true,
+		},
+		"issuerRef wildcard name and kind": {
+			selector: cmapi.CertificateRequestPolicySelector{
+				IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Name: "c*", Kind: "Cluster*"},
+			},
+			exp: true,
+		},
+		"issuerRef different group": {
+			selector: cmapi.CertificateRequestPolicySelector{
+				IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Group: "example.com"},
+			},
+			exp: false,
+		},
+		"requester username wildcard": {
+			selector: cmapi.CertificateRequestPolicySelector{
+				Requester: &cmapi.CertificateRequestPolicyRequesterSelector{Usernames: []string{"system:serviceaccount:team-a:*"}},
+			},
+			exp: true,
+		},
+		"requester group": {
+			selector: cmapi.CertificateRequestPolicySelector{
+				Requester: &cmapi.CertificateRequestPolicyRequesterSelector{Groups: []string{"system:serviceaccounts:team-a"}},
+			},
+			exp: true,
+		},
+		"requester not matching": {
+			selector: cmapi.CertificateRequestPolicySelector{
+				Requester: &cmapi.CertificateRequestPolicyRequesterSelector{
+					Usernames: []string{"system:serviceaccount:team-b:*"},
+					Groups:    []string{"system:masters"},
+				},
+			},
+			exp: false,
+		},
+		"issuerRef matches but requester does not": {
+			selector: cmapi.CertificateRequestPolicySelector{
+				IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Name: "ca"},
+				Requester: &cmapi.CertificateRequestPolicyRequesterSelector{Usernames: []string{"admin"}},
+			},
+			exp: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := policy("test", cmapi.CertificateRequestPolicySpec{Selector: test.selector})
+			assert.Equal(t, test.exp, Matches(p, req))
+		})
+	}
+}
+
+func TestViolations(t *testing.T) {
+	allowAll := &cmapi.CertificateRequestPolicyAllowed{
+		CommonName:     pointer.String("*"),
+		DNSNames:       []string{"*"},
+		IPAddresses:    []string{"10.0.0.*"},
+		URIs:           []string{"spiffe://cluster.local/*"},
+		EmailAddresses: []string{"*@example.com"},
+		Usages:         cmapi.DefaultKeyUsages(),
+	}
+	rsa := cmapi.RSAKeyAlgorithm
+
+	tests := map[string]struct {
+		spec   cmapi.CertificateRequestPolicySpec
+		req    *Request
+		modify func(*Request)
+		exp    []string
+	}{
+		"request within policy": {
+			spec: cmapi.CertificateRequestPolicySpec{Allowed: allowAll},
+			req: mustRequest(t, x509.RSA,
+				gen.SetCSRCommonName("app"),
+				gen.SetCSRDNSNames("app.example.com"),
+				gen.SetCSRIPAddressesFromStrings("10.0.0.1"),
+				gen.SetCSRURIsFromStrings("spiffe://cluster.local/ns/a/sa/b"),
+				gen.SetCSREmails([]string{"app@example.com"}),
+			),
+		},
+		"disallowed SANs": {
+			spec: cmapi.CertificateRequestPolicySpec{Allowed: allowAll},
+			req: mustRequest(t, x509.RSA,
+				gen.SetCSRIPAddressesFromStrings("192.168.0.1"),
+				gen.SetCSRURIsFromStrings("spiffe://other.local/a"),
+				gen.SetCSREmails([]string{"app@example.org"}),
+			),
+			exp: []string{
+				`spec.allowed.ipAddresses: "192.168.0.1" is not allowed`,
+				`spec.allowed.uris: "spiffe://other.local/a" is not allowed`,
+				`spec.allowed.emailAddresses: "app@example.org" is not allowed`,
+			},
+		},
+		"disallowed usage and CA": {
+			spec: cmapi.CertificateRequestPolicySpec{Allowed: allowAll},
+			req:  mustRequest(t, x509.RSA),
+			modify: func(req *Request) {
+				req.Usages = []cmapi.KeyUsage{cmapi.UsageCertSign}
+				req.IsCA = true
+			},
+			exp: []string{
+				`spec.allowed.usages: "cert sign" is not allowed`,
+				`spec.allowed.isCA: CA certificates are not allowed`,
+			},
+		},
+		"duration out of range": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Allowed: allowAll,
+				Constraints: &cmapi.CertificateRequestPolicyConstraints{
+					MinDuration: &metav1.Duration{Duration: time.Hour},
+					MaxDuration: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+			req: mustRequest(t, x509.RSA),
+			exp: []string{`spec.constraints.maxDuration: duration 2160h0m0s is greater than 24h0m0s`},
+		},
+		"key algorithm and size": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Allowed: allowAll,
+				Constraints: &cmapi.CertificateRequestPolicyConstraints{
+					PrivateKey: &cmapi.CertificateRequestPolicyPrivateKeyConstraints{
+						Algorithm: &rsa,
+						MinSize:   pointer.Int(384),
+					},
+				},
+			},
+			req: mustRequest(t, x509.ECDSA),
+			exp: []string{
+				`spec.constraints.privateKey.algorithm: key algorithm "ECDSA" is not allowed`,
+				`spec.constraints.privateKey.minSize: key size 256 is less than 384`,
+			},
+		},
+		"validations": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Allowed: allowAll,
+				Validations: []cmapi.CertificateRequestPolicyValidation{
+					{Expression: `dnsNames.all(n, n.endsWith("." + namespaceName + ".svc"))`},
+					{Expression: `namespaceLabels["team"] == "a"`, Message: "namespace must belong to team a"},
+					{Expression: `keyAlgorithm == "ECDSA" && keySize == 256 && !isCA`},
+					{Expression: `namespaceLabels["missing"] == "a"`},
+					{Expression: `dnsNames`},
+				},
+			},
+			req: mustRequest(t, x509.ECDSA, gen.SetCSRDNSNames("app.team-a.svc", "app.team-b.svc")),
+			modify: func(req *Request) {
+				req.Namespace = "team-a"
+				req.NamespaceLabels = map[string]string{"team": "b"}
+			},
+			exp: []string{
+				`spec.validations[0]: dnsNames.all(n, n.endsWith("." + namespaceName + ".svc"))`,
+				`spec.validations[1]: namespace must belong to team a`,
+				`spec.validations[3]: failed to evaluate "namespaceLabels[\"missing\"] == \"a\"": no such key: missing`,
+				`spec.validations[4]: failed to compile "dnsNames": expression must evaluate to a bool, not list(string)`,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.modify != nil {
+				test.modify(test.req)
+			}
+			assert.Equal(t, test.exp, Violations(policy("test", test.spec), test.req))
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	program, err := compile(`isCA`)
+	require.NoError(t, err)
+	cached, err := compile(`isCA`)
+	require.NoError(t, err)
+	assert.Same(t, program, cached, "expected the compiled program to be reused")
+
+	_, err = compile(`dnsNames`)
+	assert.EqualError(t, err, "expression must evaluate to a bool, not list(string)")
+	_, err = compile(`dnsNames`)
+	assert.EqualError(t, err, "expression must evaluate to a bool, not list(string)")
+}
+
+func TestRequestFromCertificateSigningRequest(t *testing.T) {
+	csrPEM, _, err := gen.CSR(x509.ECDSA, gen.SetCSRDNSNames("example.com"))
+	require.NoError(t, err)
+
+	req, err := RequestFromCertificateSigningRequest(gen.CertificateSigningRequest("test",
+		gen.SetCertificateSigningRequestSignerName("issuers.cert-manager.io/team-a.ca"),
+		gen.SetCertificateSigningRequestRequest(csrPEM),
+		gen.SetCertificateSigningRequestDuration("1h"),
+		gen.SetCertificateSigningRequestIsCA(true),
+		gen.SetCertificateSigningRequestUsername("alice"),
+		gen.SetCertificateSigningRequestUsages([]certificatesv1.KeyUsage{certificatesv1.UsageServerAuth}),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, cmmeta.ObjectReference{Name: "ca", Kind: cmapi.IssuerKind, Group: "cert-manager.io"}, req.IssuerRef)
+	assert.Equal(t, "alice", req.Username)
+	assert.Equal(t, []string{"example.com"}, req.CSR.DNSNames)
+	assert.Equal(t, time.Hour, req.Duration)
+	assert.Equal(t, []cmapi.KeyUsage{cmapi.UsageServerAuth}, req.Usages)
+	assert.True(t, req.IsCA)
+
+	_, err = RequestFromCertificateSigningRequest(gen.CertificateSigningRequest("test",
+		gen.SetCertificateSigningRequestSignerName("kubernetes.io/kube-apiserver-client"),
+		gen.SetCertificateSigningRequestRequest(csrPEM),
+	))
+	assert.Error(t, err)
+}
+
+func TestWildcardMatch(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		exp            bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "www.example.com", false},
+		{"*", "", true},
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "a.b.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxcyyb", false},
+		{"ab*ba", "aba", false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.exp, wildcardMatch(test.pattern, test.value), "pattern=%q value=%q", test.pattern, test.value)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approverpolicy
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// Request is the view of a CertificateRequest or CertificateSigningRequest
+// which CertificateRequestPolicies are evaluated against.
+type Request struct {
+	// IssuerRef is the issuer the request is for, with an empty kind and
+	// group defaulted to Issuer and cert-manager.io.
+	IssuerRef cmmeta.ObjectReference
+
+	// Username and Groups identify the user who created the request.
+	Username string
+	Groups   []string
+
+	// Namespace is the namespace of the request. For
+	// CertificateSigningRequests this is the namespace of the referenced
+	// Issuer, and empty for ClusterIssuers.
+	Namespace string
+
+	// NamespaceLabels are the labels of Namespace. They are only needed to
+	// evaluate policies with validations, see NeedNamespaceLabels.
+	NamespaceLabels map[string]string
+
+	// CSR is the decoded x509 certificate request.
+	CSR *x509.CertificateRequest
+
+	// Duration is the requested duration of the certificate.
+	Duration time.Duration
+
+	// Usages are the requested key usages.
+	Usages []cmapi.KeyUsage
+
+	// IsCA is true if a CA certificate is requested.
+	IsCA bool
+}
+
+// RequestFromCertificateRequest builds the policy view of a
+// CertificateRequest.
+func RequestFromCertificateRequest(cr *cmapi.CertificateRequest) (*Request, error) {
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := cmapi.DefaultCertificateDuration
+	if cr.Spec.Duration != nil {
+		duration = cr.Spec.Duration.Duration
+	}
+
+	usages := cr.Spec.Usages
+	if len(usages) == 0 {
+		usages = cmapi.DefaultKeyUsages()
+	}
+
+	return &Request{
+		IssuerRef: defaultIssuerRef(cr.Spec.IssuerRef),
+		Username:  cr.Spec.Username,
+		Groups:    cr.Spec.Groups,
+		Namespace: cr.Namespace,
+		CSR:       csr,
+		Duration:  duration,
+		Usages:    usages,
+		IsCA:      cr.Spec.IsCA,
+	}, nil
+}
+
+// RequestFromCertificateSigningRequest builds the policy view of a
+// CertificateSigningRequest. The issuer is derived from the signer name, so
+// an error is returned for signer names which do not refer to an issuers or
+// clusterissuers resource.
+func RequestFromCertificateSigningRequest(csr *certificatesv1.CertificateSigningRequest) (*Request, error) {
+	signer, ok := util.SignerIssuerRefFromSignerName(csr.Spec.SignerName)
+	if !ok {
+		return nil, fmt.Errorf("signer name %q does not refer to an issuer", csr.Spec.SignerName)
+	}
+	kind, ok := util.IssuerKindFromType(signer.Type)
+	if !ok {
+		return nil, fmt.Errorf("signer name %q does not refer to an issuer", csr.Spec.SignerName)
+	}
+
+	x509CSR, err := pki.DecodeX509CertificateRequestBytes(csr.Spec.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := pki.DurationFromCertificateSigningRequest(csr)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []cmapi.KeyUsage
+	for _, u := range csr.Spec.Usages {
+		usages = append(usages, cmapi.KeyUsage(u))
+	}
+	if len(usages) == 0 {
+		usages = cmapi.DefaultKeyUsages()
+	}
+
+	return &Request{
+		IssuerRef: cmmeta.ObjectReference{Name: signer.Name, Kind: kind, Group: signer.Group},
+		Username:  csr.Spec.Username,
+		Groups:    csr.Spec.Groups,
+		Namespace: signer.Namespace,
+		CSR:       x509CSR,
+		Duration:  duration,
+		Usages:    usages,
+		IsCA:      csr.Annotations[experimentalapi.CertificateSigningRequestIsCAAnnotationKey] == "true",
+	}, nil
+}
+
+func defaultIssuerRef(ref cmmeta.ObjectReference) cmmeta.ObjectReference {
+	if ref.Kind == "" {
+		ref.Kind = cmapi.IssuerKind
+	}
+	if ref.Group == "" {
+		ref.Group = cmapi.SchemeGroupVersion.Group
+	}
+	return ref
+}
@@ -229,6 +229,13 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 		crt := input.Certificate
 		renewalTime := pki.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore)
 
+		// Move the renewal time into the configured renewal windows. Invalid
+		// windows are rejected by the webhook, so errors are only possible if
+		// validation was bypassed, in which case the windows are ignored.
+		if adjusted, err := pki.RenewalTimeInWindows(renewalTime.Time, notAfter.Time, c.Now(), input.RenewalWindows); err == nil {
+			renewalTime = &metav1.Time{Time: adjusted}
+		}
+
 		renewIn := renewalTime.Time.Sub(c.Now())
 		if renewIn > 0 {
 			//renewal time is in future, no need to renew
@@ -2164,3 +2164,59 @@ func Test_SecretOwnerReferenceValueMismatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_CurrentCertificateNearingExpiryWithRenewalWindows(t *testing.T) {
+	// 2023-03-15 00:00 UTC, a Wednesday.
+	clock := fakeclock.NewFakeClock(time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+	staticFixedPrivateKey := testcrypto.MustCreatePEMPrivateKey(t)
+	crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}}
+	// The certificate is valid for 300 minutes, so the renewal time is 02:50.
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+			corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey, crt,
+				clock.Now().Add(time.Minute*-30),
+				clock.Now().Add(time.Minute*270),
+			),
+		},
+	}
+	windowAt := func(schedule string) *cmapi.RenewalWindows {
+		return &cmapi.RenewalWindows{
+			Windows: []cmapi.RenewalWindow{
+				{Schedule: schedule, Duration: metav1.Duration{Duration: time.Hour}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		windows *cmapi.RenewalWindows
+		renew   bool
+	}{
+		"does not renew before the renewal time without windows": {
+			windows: nil,
+			renew:   false,
+		},
+		"renews before the renewal time if a window is open and no later window opens before it": {
+			windows: windowAt("0 0 * * *"),
+			renew:   true,
+		},
+		"does not renew if a later window opens before the renewal time": {
+			windows: windowAt("0 2 * * *"),
+			renew:   false,
+		},
+		"does not renew if no window is open but one opens before expiry": {
+			windows: windowAt("0 4 * * *"),
+			renew:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, renew := CurrentCertificateNearingExpiry(clock)(Input{
+				Certificate:    crt,
+				Secret:         secret,
+				RenewalWindows: test.windows,
+			})
+			assert.Equal(t, test.renew, renew)
+		})
+	}
+}
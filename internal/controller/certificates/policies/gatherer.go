@@ -215,6 +215,8 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
@@ -227,6 +229,12 @@ import (
 type Gatherer struct {
 	CertificateRequestLister cmlisters.CertificateRequestLister
 	SecretLister             internalinformers.SecretLister
+
+	// IssuerLister and ClusterIssuerLister are used to look up the default
+	// renewal windows of the issuer referenced by a Certificate. If they are
+	// not set, only the Certificate's own renewal windows are used.
+	IssuerLister        cmlisters.IssuerLister
+	ClusterIssuerLister cmlisters.ClusterIssuerLister
 }
 
 // DataForCertificate returns the secret as well as the "current" and "next"
@@ -312,10 +320,63 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 		log.V(logf.DebugLevel).Info("Found no CertificateRequest resources owned by this Certificate for the next revision", "revision", nextCRRevision)
 	}
 
+	renewalWindows, err := g.renewalWindowsForCertificate(crt)
+	if err != nil {
+		return Input{}, err
+	}
+
 	return Input{
 		Certificate:            crt,
 		Secret:                 secret,
 		CurrentRevisionRequest: curCR,
 		NextRevisionRequest:    nextCR,
+		RenewalWindows:         renewalWindows,
 	}, nil
 }
+
+// renewalWindowsForCertificate returns the renewal windows that apply to the
+// given certificate: its own spec.renewalWindows if set, otherwise those of
+// the cert-manager.io issuer it references. A missing issuer is not an
+// error; the certificate is then not restricted to any windows.
+func (g *Gatherer) renewalWindowsForCertificate(crt *cmapi.Certificate) (*cmapi.RenewalWindows, error) {
+	if crt.Spec.RenewalWindows != nil {
+		return crt.Spec.RenewalWindows, nil
+	}
+
+	ref := crt.Spec.IssuerRef
+	if ref.Group != "" && ref.Group != certmanager.GroupName {
+		return nil, nil
+	}
+
+	var spec *cmapi.IssuerSpec
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		if g.IssuerLister == nil {
+			return nil, nil
+		}
+		issuer, err := g.IssuerLister.Issuers(apiutil.IssuerNamespace(ref, crt.Namespace)).Get(ref.Name)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		spec = &issuer.Spec
+	case cmapi.ClusterIssuerKind:
+		if g.ClusterIssuerLister == nil {
+			return nil, nil
+		}
+		issuer, err := g.ClusterIssuerLister.Get(ref.Name)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		spec = &issuer.Spec
+	default:
+		return nil, nil
+	}
+
+	return spec.RenewalWindows, nil
+}
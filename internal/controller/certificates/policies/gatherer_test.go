@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
@@ -34,12 +35,15 @@ import (
 
 	cmscheme "github.com/cert-manager/cert-manager/pkg/api"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func TestDataForCertificate(t *testing.T) {
+	issuerWindows := &cmapi.RenewalWindows{Windows: []cmapi.RenewalWindow{{Schedule: "0 9 * * 1-5", Duration: metav1.Duration{Duration: 8 * time.Hour}}}}
+	certWindows := &cmapi.RenewalWindows{Windows: []cmapi.RenewalWindow{{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}}}}
 	tests := map[string]struct {
 		builder    *testpkg.Builder
 		givenCert  *cmapi.Certificate
@@ -47,7 +51,55 @@ func TestDataForCertificate(t *testing.T) {
 		wantNextCR *cmapi.CertificateRequest
 		wantSecret *corev1.Secret
 		wantErr    string
+
+		wantRenewalWindows *cmapi.RenewalWindows
 	}{
+		"when the cert has no renewal windows, those of the referenced issuer are returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1"}),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.Issuer("issuer-1", gen.SetIssuerNamespace("ns-1"), gen.SetIssuerRenewalWindows(issuerWindows)),
+			}},
+			wantRenewalWindows: issuerWindows,
+		},
+		"when the cert has no renewal windows, those of the referenced cluster issuer are returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1", Kind: cmapi.ClusterIssuerKind}),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.ClusterIssuer("issuer-1", gen.SetIssuerRenewalWindows(issuerWindows)),
+			}},
+			wantRenewalWindows: issuerWindows,
+		},
+		"when the cert references an issuer in another namespace, its renewal windows are returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1", Namespace: "ns-2"}),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.Issuer("issuer-1", gen.SetIssuerNamespace("ns-2"), gen.SetIssuerRenewalWindows(issuerWindows)),
+			}},
+			wantRenewalWindows: issuerWindows,
+		},
+		"when the cert has renewal windows, they override those of the issuer": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1"}),
+				gen.SetCertificateRenewalWindows(certWindows),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.Issuer("issuer-1", gen.SetIssuerNamespace("ns-1"), gen.SetIssuerRenewalWindows(issuerWindows)),
+			}},
+			wantRenewalWindows: certWindows,
+		},
+		"when the referenced issuer does not exist, no renewal windows are returned": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("ns-1"),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer-1"}),
+			),
+			builder: &testpkg.Builder{CertManagerObjects: []runtime.Object{
+				gen.Issuer("issuer-1", gen.SetIssuerNamespace("ns-2"), gen.SetIssuerRenewalWindows(issuerWindows)),
+			}},
+			wantRenewalWindows: nil,
+		},
 		"when no secret is found, the returned secret is nil": {
 			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("default-unit-test-ns"),
 				gen.SetCertificateSecretName("secret-1"),
@@ -169,6 +221,8 @@ func TestDataForCertificate(t *testing.T) {
 			noop := cache.ResourceEventHandlerFuncs{AddFunc: func(obj interface{}) {}}
 			test.builder.SharedInformerFactory.Certmanager().V1().CertificateRequests().Informer().AddEventHandler(noop)
 			test.builder.KubeSharedInformerFactory.Secrets().Informer().AddEventHandler(noop)
+			test.builder.SharedInformerFactory.Certmanager().V1().Issuers().Informer().AddEventHandler(noop)
+			test.builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Informer().AddEventHandler(noop)
 
 			// Even though we are only relying on listers in this unit test
 			// and do not use the informer event handlers, we still need to
@@ -213,6 +267,8 @@ func TestDataForCertificate(t *testing.T) {
 			g := &Gatherer{
 				CertificateRequestLister: test.builder.SharedInformerFactory.Certmanager().V1().CertificateRequests().Lister(),
 				SecretLister:             test.builder.KubeSharedInformerFactory.Secrets().Lister(),
+				IssuerLister:             test.builder.SharedInformerFactory.Certmanager().V1().Issuers().Lister(),
+				ClusterIssuerLister:      test.builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Lister(),
 			}
 
 			ctx := logf.NewContext(context.Background(), logf.WithResource(log, test.givenCert))
@@ -227,6 +283,7 @@ func TestDataForCertificate(t *testing.T) {
 				assert.Equal(t, test.wantCurCR, got.CurrentRevisionRequest)
 				assert.Equal(t, test.wantNextCR, got.NextRevisionRequest)
 				assert.Equal(t, test.wantSecret, got.Secret)
+				assert.Equal(t, test.wantRenewalWindows, got.RenewalWindows)
 			}
 		})
 	}
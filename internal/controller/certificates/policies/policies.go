@@ -40,6 +40,11 @@ type Input struct {
 	// Take a look at the gatherer package's documentation to see more about why
 	// we care about the "next" certificate request.
 	NextRevisionRequest *cmapi.CertificateRequest
+
+	// RenewalWindows restricts when the certificate may be renewed. It is
+	// either the certificate's own spec.renewalWindows or the default set on
+	// the referenced issuer.
+	RenewalWindows *cmapi.RenewalWindows
 }
 
 // A Func evaluates the given input data and decides whether a check has passed
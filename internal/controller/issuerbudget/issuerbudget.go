@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package issuerbudget enforces the rate limits and concurrency budgets that
+// may be configured on Issuers and ClusterIssuers, so that a mass renewal
+// does not overwhelm the signing backend of an issuer.
+package issuerbudget
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+const (
+	// defaultPeriod is the period used when an issuer's rate limit sets
+	// requests but not period.
+	defaultPeriod = time.Minute
+
+	// inFlightRetryPeriod is how long a request waiting on an issuer's
+	// maxInFlight budget waits before trying again.
+	inFlightRetryPeriod = 5 * time.Second
+
+	// waitingGracePeriod is how long after its expected retry time a request
+	// continues to be counted as waiting. Requests that are deleted whilst
+	// waiting stop being counted after this period.
+	waitingGracePeriod = time.Minute
+)
+
+// ExhaustedError is returned by Acquire when an issuer's budget does not
+// currently permit another signing request.
+type ExhaustedError struct {
+	// RetryAfter is the time after which the request should be retried.
+	RetryAfter time.Duration
+
+	message string
+}
+
+func (e *ExhaustedError) Error() string {
+	return e.message
+}
+
+// Budgets tracks the rate limit and concurrency budget of every issuer that
+// has one configured. A single Budgets should be shared by all controllers
+// that call an issuer's Sign function.
+type Budgets struct {
+	clock   clock.Clock
+	metrics *metrics.Metrics
+
+	lock    sync.Mutex
+	budgets map[issuerKey]*budget
+	// held maps the keys of requests that have been permitted by a budget to
+	// the budget they hold a slot in.
+	held map[string]*budget
+}
+
+type issuerKey struct {
+	kind, namespace, name string
+}
+
+type budget struct {
+	key     issuerKey
+	spec    cmapi.IssuerRateLimit
+	limiter *rate.Limiter
+
+	// inFlight is the number of requests holding a slot in the budget.
+	inFlight int
+	// waiting maps the keys of requests that have been refused by the budget
+	// to the time after which they are no longer counted as waiting.
+	waiting map[string]time.Time
+}
+
+// New returns Budgets that use the given clock. Queue depth and in-flight
+// requests per issuer are exposed using m, which may be nil.
+func New(c clock.Clock, m *metrics.Metrics) *Budgets {
+	return &Budgets{
+		clock:   c,
+		metrics: m,
+		budgets: make(map[issuerKey]*budget),
+		held:    make(map[string]*budget),
+	}
+}
+
+// Acquire attempts to take a slot from the budget of the given issuer for the
+// request identified by requestKey, which must be unique to the request, e.g.
+// its UID. If the budget does not permit the request an *ExhaustedError is
+// returned describing why and for how long the request must wait.
+// A request is only charged once: after it has been permitted it holds its
+// slot, and further calls to Acquire for the same request succeed without
+// charging the budget again, until Release is called once the request has
+// reached a terminal state or has been deleted.
+// Issuers without a rate limit, and nil Budgets, always permit requests.
+func (b *Budgets) Acquire(issuer cmapi.GenericIssuer, requestKey string) error {
+	if b == nil {
+		return nil
+	}
+
+	key := keyForIssuer(issuer)
+	spec := issuer.GetSpec().RateLimit
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.held[requestKey]; ok {
+		return nil
+	}
+
+	if spec == nil || (spec.Requests == nil && spec.MaxInFlight == nil) {
+		if _, ok := b.budgets[key]; ok {
+			delete(b.budgets, key)
+			if b.metrics != nil {
+				b.metrics.RemoveIssuerBudget(key.kind, key.namespace, key.name)
+			}
+		}
+		return nil
+	}
+
+	bgt := b.budgetFor(key, spec)
+	now := b.clock.Now()
+	bgt.pruneWaiting(now)
+
+	if spec.MaxInFlight != nil && bgt.inFlight >= int(*spec.MaxInFlight) {
+		err := &ExhaustedError{
+			RetryAfter: inFlightRetryPeriod,
+			message: fmt.Sprintf("Waiting for one of the %d signing requests in progress against the %s %q to complete",
+				bgt.inFlight, key.kind, key.name),
+		}
+		b.wait(key, bgt, requestKey, now, err.RetryAfter)
+		return err
+	}
+
+	if bgt.limiter != nil {
+		r := bgt.limiter.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); !r.OK() || delay > 0 {
+			r.CancelAt(now)
+			if delay <= 0 {
+				delay = inFlightRetryPeriod
+			}
+			err := &ExhaustedError{
+				RetryAfter: delay,
+				message: fmt.Sprintf("The %s %q is limited to %d signing requests every %s, retrying in %s",
+					key.kind, key.name, *spec.Requests, periodFor(spec), delay.Round(time.Second)),
+			}
+			b.wait(key, bgt, requestKey, now, err.RetryAfter)
+			return err
+		}
+	}
+
+	delete(bgt.waiting, requestKey)
+	bgt.inFlight++
+	b.held[requestKey] = bgt
+	b.updateMetrics(key, bgt)
+	return nil
+}
+
+// Release frees the slot held by the request identified by requestKey, if
+// any. It is safe to call Release for requests which do not hold a slot.
+func (b *Budgets) Release(requestKey string) {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	bgt, ok := b.held[requestKey]
+	if !ok {
+		return
+	}
+	delete(b.held, requestKey)
+	bgt.inFlight--
+	// Only report metrics if the budget was not removed in the meantime.
+	if b.budgets[bgt.key] == bgt {
+		b.updateMetrics(bgt.key, bgt)
+	}
+}
+
+// budgetFor returns the budget for the given issuer, creating it or updating
+// its limiter if the issuer's rate limit has changed.
+// The caller must hold the lock.
+func (b *Budgets) budgetFor(key issuerKey, spec *cmapi.IssuerRateLimit) *budget {
+	bgt, ok := b.budgets[key]
+	if !ok {
+		bgt = &budget{key: key, waiting: make(map[string]time.Time)}
+		b.budgets[key] = bgt
+	} else if apiequality.Semantic.DeepEqual(bgt.spec, *spec) {
+		return bgt
+	}
+
+	bgt.spec = *spec.DeepCopy()
+	bgt.limiter = nil
+	if spec.Requests != nil {
+		burst := int(*spec.Requests)
+		if spec.Burst != nil {
+			burst = int(*spec.Burst)
+		}
+		limit := rate.Limit(float64(*spec.Requests) / periodFor(spec).Seconds())
+		bgt.limiter = rate.NewLimiter(limit, burst)
+	}
+	return bgt
+}
+
+// wait records the request as waiting on the budget.
+// The caller must hold the lock.
+func (b *Budgets) wait(key issuerKey, bgt *budget, requestKey string, now time.Time, retryAfter time.Duration) {
+	bgt.waiting[requestKey] = now.Add(retryAfter + waitingGracePeriod)
+	b.updateMetrics(key, bgt)
+}
+
+// updateMetrics must be called whilst holding the lock.
+func (b *Budgets) updateMetrics(key issuerKey, bgt *budget) {
+	if b.metrics == nil {
+		return
+	}
+	b.metrics.SetIssuerBudgetWaitingRequests(key.kind, key.namespace, key.name, len(bgt.waiting))
+	b.metrics.SetIssuerBudgetInFlightRequests(key.kind, key.namespace, key.name, bgt.inFlight)
+}
+
+// pruneWaiting stops counting requests that have not retried within the
+// grace period, e.g. because they have been deleted.
+func (bgt *budget) pruneWaiting(now time.Time) {
+	for requestKey, expiry := range bgt.waiting {
+		if now.After(expiry) {
+			delete(bgt.waiting, requestKey)
+		}
+	}
+}
+
+func periodFor(spec *cmapi.IssuerRateLimit) time.Duration {
+	if spec.Period == nil || spec.Period.Duration <= 0 {
+		return defaultPeriod
+	}
+	return spec.Period.Duration
+}
+
+func keyForIssuer(issuer cmapi.GenericIssuer) issuerKey {
+	if _, ok := issuer.(*cmapi.ClusterIssuer); ok {
+		return issuerKey{kind: cmapi.ClusterIssuerKind, name: issuer.GetName()}
+	}
+	return issuerKey{kind: cmapi.IssuerKind, namespace: issuer.GetNamespace(), name: issuer.GetName()}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerbudget
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func issuerWithRateLimit(rl *cmapi.IssuerRateLimit) *cmapi.Issuer {
+	return &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "issuer"},
+		Spec:       cmapi.IssuerSpec{RateLimit: rl},
+	}
+}
+
+func mustBeExhausted(t *testing.T, err error) *ExhaustedError {
+	t.Helper()
+	var exhausted *ExhaustedError
+	if !errors.As(err, &exhausted) {
+		t.Fatalf("expected ExhaustedError, got %v", err)
+	}
+	return exhausted
+}
+
+func TestAcquireNoRateLimit(t *testing.T) {
+	b := New(fakeclock.NewFakeClock(time.Now()), nil)
+	iss := issuerWithRateLimit(nil)
+	for i := 0; i < 100; i++ {
+		if err := b.Acquire(iss, "ns/cr"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var nilBudgets *Budgets
+	if err := nilBudgets.Acquire(iss, "ns/cr"); err != nil {
+		t.Fatalf("unexpected error from nil Budgets: %v", err)
+	}
+	nilBudgets.Release("ns/cr")
+}
+
+func TestAcquireRequestsPerPeriod(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	b := New(clock, nil)
+	iss := issuerWithRateLimit(&cmapi.IssuerRateLimit{
+		Requests: pointer.Int32(2),
+		Period:   &metav1.Duration{Duration: time.Minute},
+	})
+
+	for _, key := range []string{"ns/a", "ns/b"} {
+		if err := b.Acquire(iss, key); err != nil {
+			t.Fatalf("unexpected error for %s: %v", key, err)
+		}
+		b.Release(key)
+	}
+
+	err := b.Acquire(iss, "ns/c")
+	exhausted := mustBeExhausted(t, err)
+	if exhausted.RetryAfter != 30*time.Second {
+		t.Errorf("expected retry after 30s, got %s", exhausted.RetryAfter)
+	}
+	if got := len(b.budgets[keyForIssuer(iss)].waiting); got != 1 {
+		t.Errorf("expected 1 waiting request, got %d", got)
+	}
+
+	clock.Step(30 * time.Second)
+	if err := b.Acquire(iss, "ns/c"); err != nil {
+		t.Fatalf("unexpected error after waiting: %v", err)
+	}
+	if got := len(b.budgets[keyForIssuer(iss)].waiting); got != 0 {
+		t.Errorf("expected no waiting requests, got %d", got)
+	}
+}
+
+func TestAcquireBurst(t *testing.T) {
+	b := New(fakeclock.NewFakeClock(time.Now()), nil)
+	iss := issuerWithRateLimit(&cmapi.IssuerRateLimit{
+		Requests: pointer.Int32(1),
+		Burst:    pointer.Int32(3),
+	})
+
+	for _, key := range []string{"ns/a", "ns/b", "ns/c"} {
+		if err := b.Acquire(iss, key); err != nil {
+			t.Fatalf("unexpected error for %s: %v", key, err)
+		}
+	}
+	err := b.Acquire(iss, "ns/d")
+	exhausted := mustBeExhausted(t, err)
+	if exhausted.RetryAfter != time.Minute {
+		t.Errorf("expected retry after the default period of 1m, got %s", exhausted.RetryAfter)
+	}
+}
+
+func TestAcquireChargesOncePerRequest(t *testing.T) {
+	b := New(fakeclock.NewFakeClock(time.Now()), nil)
+	iss := issuerWithRateLimit(&cmapi.IssuerRateLimit{
+		Requests: pointer.Int32(1),
+	})
+
+	// A request which is synced again before reaching a terminal state, e.g.
+	// whilst an ACME order is pending, must not be charged again.
+	for i := 0; i < 3; i++ {
+		if err := b.Acquire(iss, "ns/a"); err != nil {
+			t.Fatalf("unexpected error for sync %d: %v", i, err)
+		}
+	}
+	if got := b.budgets[keyForIssuer(iss)].inFlight; got != 1 {
+		t.Errorf("expected 1 request in flight, got %d", got)
+	}
+
+	mustBeExhausted(t, b.Acquire(iss, "ns/b"))
+
+	// Once released, the request is charged again if it is re-acquired.
+	b.Release("ns/a")
+	mustBeExhausted(t, b.Acquire(iss, "ns/a"))
+}
+
+func TestAcquireMaxInFlight(t *testing.T) {
+	b := New(fakeclock.NewFakeClock(time.Now()), nil)
+	iss := issuerWithRateLimit(&cmapi.IssuerRateLimit{
+		MaxInFlight: pointer.Int32(1),
+	})
+
+	if err := b.Acquire(iss, "ns/a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	exhausted := mustBeExhausted(t, b.Acquire(iss, "ns/b"))
+	if exhausted.RetryAfter != inFlightRetryPeriod {
+		t.Errorf("expected retry after %s, got %s", inFlightRetryPeriod, exhausted.RetryAfter)
+	}
+
+	b.Release("ns/a")
+	// Releasing more than once must not free additional slots.
+	b.Release("ns/a")
+
+	if err := b.Acquire(iss, "ns/b"); err != nil {
+		t.Fatalf("unexpected error after release: %v", err)
+	}
+	if err := b.Acquire(iss, "ns/c"); err == nil {
+		t.Fatal("expected ns/c to be refused whilst ns/b is in flight")
+	}
+	b.Release("ns/b")
+}
+
+func TestAcquireRateLimitRemoved(t *testing.T) {
+	b := New(fakeclock.NewFakeClock(time.Now()), nil)
+	iss := issuerWithRateLimit(&cmapi.IssuerRateLimit{
+		MaxInFlight: pointer.Int32(1),
+	})
+
+	if err := b.Acquire(iss, "ns/a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	iss.Spec.RateLimit = nil
+	if err := b.Acquire(iss, "ns/b"); err != nil {
+		t.Fatalf("unexpected error once the rate limit is removed: %v", err)
+	}
+	if _, ok := b.budgets[keyForIssuer(iss)]; ok {
+		t.Error("expected budget to be removed")
+	}
+}
+
+func TestWaitingRequestsArePruned(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	b := New(clock, nil)
+	iss := issuerWithRateLimit(&cmapi.IssuerRateLimit{
+		MaxInFlight: pointer.Int32(1),
+	})
+
+	if err := b.Acquire(iss, "ns/a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := b.Acquire(iss, "ns/deleted"); err == nil {
+		t.Fatal("expected ns/deleted to be refused")
+	}
+
+	clock.Step(inFlightRetryPeriod + waitingGracePeriod + time.Second)
+	if err := b.Acquire(iss, "ns/b"); err == nil {
+		t.Fatal("expected ns/b to be refused")
+	}
+
+	waiting := b.budgets[keyForIssuer(iss)].waiting
+	if _, ok := waiting["ns/deleted"]; ok || len(waiting) != 1 {
+		t.Errorf("expected only ns/b to be waiting, got %v", waiting)
+	}
+}
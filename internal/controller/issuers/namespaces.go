@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuers
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// NamespaceGetter gets Namespaces by name. It is implemented by client-go's
+// NamespaceLister, which controllers should use to avoid an API call per
+// sync.
+type NamespaceGetter interface {
+	Get(name string) (*corev1.Namespace, error)
+}
+
+// NamespaceGetterFunc adapts a function to a NamespaceGetter.
+type NamespaceGetterFunc func(name string) (*corev1.Namespace, error)
+
+func (f NamespaceGetterFunc) Get(name string) (*corev1.Namespace, error) {
+	return f(name)
+}
+
+// NamespaceAllowed returns whether the given issuer may be used by requests
+// in the given namespace. Only ClusterIssuers can restrict the namespaces that
+// use them, using spec.namespaceSelector. The Namespace is only fetched with
+// the given getter if the ClusterIssuer has a namespaceSelector. A Namespace
+// that is not found returns an error rather than false, as the getter may be
+// a lister whose cache has not observed the Namespace yet, and callers should
+// retry rather than permanently reject the request.
+func NamespaceAllowed(namespaces NamespaceGetter, issuer cmapi.GenericIssuer, namespace string) (bool, error) {
+	if _, ok := issuer.(*cmapi.ClusterIssuer); !ok {
+		return true, nil
+	}
+
+	if issuer.GetSpec().NamespaceSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(issuer.GetSpec().NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespaceSelector on ClusterIssuer %q: %w", issuer.GetName(), err)
+	}
+
+	ns, err := namespaces.Get(namespace)
+	if err != nil {
+		return false, fmt.Errorf("failed to get namespace %q: %w", namespace, err)
+	}
+
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestNamespaceAllowed(t *testing.T) {
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"team": "a"},
+	}
+	invalidSelector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "team", Operator: "Unknown"},
+		},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	_ = indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}})
+	_ = indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}})
+	namespaceLister := corelisters.NewNamespaceLister(indexer)
+
+	tests := map[string]struct {
+		issuer    cmapi.GenericIssuer
+		namespace string
+		expected  bool
+		expectErr bool
+	}{
+		"ClusterIssuer without namespaceSelector": {
+			issuer:    &cmapi.ClusterIssuer{},
+			namespace: "team-b",
+			expected:  true,
+		},
+		"ClusterIssuer with matching namespaceSelector": {
+			issuer:    &cmapi.ClusterIssuer{Spec: cmapi.IssuerSpec{NamespaceSelector: selector}},
+			namespace: "team-a",
+			expected:  true,
+		},
+		"ClusterIssuer with non matching namespaceSelector": {
+			issuer:    &cmapi.ClusterIssuer{Spec: cmapi.IssuerSpec{NamespaceSelector: selector}},
+			namespace: "team-b",
+			expected:  false,
+		},
+		"ClusterIssuer with namespaceSelector and missing namespace": {
+			issuer:    &cmapi.ClusterIssuer{Spec: cmapi.IssuerSpec{NamespaceSelector: selector}},
+			namespace: "missing",
+			expectErr: true,
+		},
+		"ClusterIssuer with invalid namespaceSelector": {
+			issuer:    &cmapi.ClusterIssuer{Spec: cmapi.IssuerSpec{NamespaceSelector: invalidSelector}},
+			namespace: "team-a",
+			expectErr: true,
+		},
+		"Issuers ignore namespaceSelector": {
+			issuer:    &cmapi.Issuer{Spec: cmapi.IssuerSpec{NamespaceSelector: selector}},
+			namespace: "team-b",
+			expected:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowed, err := NamespaceAllowed(namespaceLister, test.issuer, test.namespace)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, allowed)
+		})
+	}
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// GroupLabelKey is set on every shard Lease and holds the name of the group of
+// replicas that share work between them.
+const GroupLabelKey = "controller.cert-manager.io/shard-group"
+
+// Options configures a Sharder.
+type Options struct {
+	// Namespace is the namespace the shard Leases are stored in.
+	Namespace string
+
+	// Group is the name of the group of replicas sharing work. It is used as
+	// the prefix of each replica's Lease name.
+	Group string
+
+	// Identity uniquely identifies this replica within the group.
+	Identity string
+
+	// LeaseDuration is the duration after which a replica that has not
+	// renewed its Lease is considered gone, and its keys are reassigned.
+	// Leases are renewed every third of this duration.
+	LeaseDuration time.Duration
+
+	// Clock is used to access the current time.
+	Clock clock.Clock
+}
+
+// Sharder assigns keys to the live replicas of a group. Each replica holds a
+// Lease which it renews periodically, and keys are distributed between the
+// holders of unexpired Leases using rendezvous hashing, so that only the keys
+// of replicas joining or leaving the group move when the group changes.
+//
+// Replicas observe membership changes at different times, so a key which
+// moves to this replica is only taken over once a handover period of one
+// lease duration has passed. By then the previous owner has either observed
+// the change, as replicas refresh the membership every third of the lease
+// duration, or stopped owning any key because it failed to renew its Lease.
+// A sync of the key which the previous owner started before observing the
+// change, and which lasts longer than the rest of the handover period, can
+// still overlap with the first sync on this replica.
+type Sharder struct {
+	client coordinationclient.LeasesGetter
+	opts   Options
+	log    logr.Logger
+
+	lock      sync.RWMutex
+	members   []string
+	lastRenew time.Time
+	handlers  []func()
+
+	// previous are the sets of members which keys were assigned to since
+	// the start of the handover period, which ends at handoverEnd. A key
+	// is only owned if it was assigned to this replica in all of them.
+	previous    [][]string
+	handoverEnd time.Time
+}
+
+// New returns a Sharder that manages Leases using the given client.
+func New(client coordinationclient.LeasesGetter, opts Options) *Sharder {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	return &Sharder{
+		client: client,
+		opts:   opts,
+		log:    logf.Log.WithName("sharding").WithValues("identity", opts.Identity),
+	}
+}
+
+// AddEventHandler registers a function that is called every time the set of
+// live replicas, and therefore the assignment of keys, changes.
+func (s *Sharder) AddEventHandler(fn func()) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers = append(s.handlers, fn)
+}
+
+// Owns returns true if the given key is assigned to this replica.
+// No keys are owned until this replica's Lease has been acquired, or once it
+// has failed to renew it for longer than the lease duration. Keys which were
+// assigned to another replica are not owned until the handover period that
+// follows a membership change has ended.
+func (s *Sharder) Owns(key string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if len(s.members) == 0 || s.opts.Clock.Since(s.lastRenew) > s.opts.LeaseDuration {
+		return false
+	}
+	if assign(s.members, key) != s.opts.Identity {
+		return false
+	}
+	if s.opts.Clock.Now().Before(s.handoverEnd) {
+		for _, members := range s.previous {
+			if owner := assign(members, key); owner != "" && owner != s.opts.Identity {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Members returns the identities of the replicas currently sharing work.
+func (s *Sharder) Members() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return append([]string(nil), s.members...)
+}
+
+// Run maintains this replica's Lease and tracks the Leases held by the other
+// replicas until the context is cancelled, after which the Lease is released
+// so the remaining replicas can take over its keys immediately.
+func (s *Sharder) Run(ctx context.Context) {
+	s.log.V(logf.InfoLevel).Info("starting shard membership loop", "namespace", s.opts.Namespace, "group", s.opts.Group)
+	wait.UntilWithContext(ctx, s.sync, s.opts.LeaseDuration/3)
+
+	releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.client.Leases(s.opts.Namespace).Delete(releaseCtx, s.leaseName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		s.log.Error(err, "failed to release shard lease")
+	}
+}
+
+func (s *Sharder) sync(ctx context.Context) {
+	if err := s.renew(ctx); err != nil {
+		s.log.Error(err, "failed to renew shard lease")
+		s.setMembers(s.Members(), false)
+		return
+	}
+
+	members, err := s.liveMembers(ctx)
+	if err != nil {
+		s.log.Error(err, "failed to list shard leases")
+		s.setMembers(s.Members(), true)
+		return
+	}
+	s.setMembers(members, true)
+}
+
+// setMembers records the given members, and the time of the last successful
+// renewal if renewed is true, and notifies the registered handlers if the
+// effective set of members changed or a handover period ended.
+func (s *Sharder) setMembers(members []string, renewed bool) {
+	s.lock.Lock()
+	now := s.opts.Clock.Now()
+	wasOwning := len(s.members) > 0 && now.Sub(s.lastRenew) <= s.opts.LeaseDuration
+	if renewed {
+		s.lastRenew = now
+	}
+	isOwning := len(members) > 0 && now.Sub(s.lastRenew) <= s.opts.LeaseDuration
+	changed := wasOwning != isOwning || !equal(s.members, members)
+
+	handoverEnded := len(s.previous) > 0 && !now.Before(s.handoverEnd)
+	if handoverEnded {
+		s.previous = nil
+	}
+	if changed && isOwning {
+		// while this replica did not own any key, its keys were assigned
+		// to the other members
+		previous := s.members
+		if !wasOwning {
+			previous = without(members, s.opts.Identity)
+		}
+		s.previous = append(s.previous, previous)
+		s.handoverEnd = now.Add(s.opts.LeaseDuration)
+	}
+
+	s.members = members
+	handlers := s.handlers
+	s.lock.Unlock()
+
+	if !changed && !handoverEnded {
+		return
+	}
+	if changed {
+		s.log.V(logf.InfoLevel).Info("shard membership changed", "members", members, "active", isOwning)
+	}
+	for _, fn := range handlers {
+		fn()
+	}
+}
+
+func (s *Sharder) renew(ctx context.Context) error {
+	leases := s.client.Leases(s.opts.Namespace)
+	now := metav1.NewMicroTime(s.opts.Clock.Now())
+	lease, err := leases.Get(ctx, s.leaseName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.leaseName(),
+				Namespace: s.opts.Namespace,
+				Labels:    map[string]string{GroupLabelKey: s.opts.Group},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       pointer.String(s.opts.Identity),
+				LeaseDurationSeconds: pointer.Int32(int32(s.opts.LeaseDuration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	case err != nil:
+		return err
+	}
+
+	lease = lease.DeepCopy()
+	lease.Spec.HolderIdentity = pointer.String(s.opts.Identity)
+	lease.Spec.LeaseDurationSeconds = pointer.Int32(int32(s.opts.LeaseDuration.Seconds()))
+	lease.Spec.RenewTime = &now
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// liveMembers returns the sorted identities of the holders of all unexpired
+// Leases in the group. Leases which expired a long time ago are deleted, as
+// their replicas are not expected to come back.
+func (s *Sharder) liveMembers(ctx context.Context) ([]string, error) {
+	leases := s.client.Leases(s.opts.Namespace)
+	list, err := leases.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{GroupLabelKey: s.opts.Group}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.opts.Clock.Now()
+	members := []string{s.opts.Identity}
+	for _, lease := range list.Items {
+		if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == s.opts.Identity {
+			continue
+		}
+		expiry := leaseExpiry(&lease)
+		if now.Before(expiry) {
+			members = append(members, *lease.Spec.HolderIdentity)
+			continue
+		}
+		if now.Sub(expiry) > 10*s.opts.LeaseDuration {
+			s.log.V(logf.DebugLevel).Info("deleting expired shard lease", "lease", lease.Name)
+			if err := leases.Delete(ctx, lease.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				s.log.Error(err, "failed to delete expired shard lease", "lease", lease.Name)
+			}
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+func (s *Sharder) leaseName() string {
+	return fmt.Sprintf("%s-%s", s.opts.Group, s.opts.Identity)
+}
+
+func leaseExpiry(lease *coordinationv1.Lease) time.Time {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return time.Time{}
+	}
+	return lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+}
+
+// assign returns the member with the highest hash for the given key.
+func assign(members []string, key string) string {
+	var (
+		owner string
+		max   uint64
+	)
+	for _, member := range members {
+		h := fnv.New64a()
+		h.Write([]byte(member))
+		h.Write([]byte{0})
+		h.Write([]byte(key))
+		if sum := h.Sum64(); owner == "" || sum > max {
+			owner, max = member, sum
+		}
+	}
+	return owner
+}
+
+// without returns the members other than the given identity.
+func without(members []string, identity string) []string {
+	var out []string
+	for _, member := range members {
+		if member != identity {
+			out = append(out, member)
+		}
+	}
+	return out
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	fakeclock "k8s.io/utils/clock/testing"
+)
+
+const leaseDuration = 30 * time.Second
+
+func newTestSharder(cl *fake.Clientset, clock *fakeclock.FakeClock, identity string) *Sharder {
+	return New(cl.CoordinationV1(), Options{
+		Namespace:     "kube-system",
+		Group:         "test",
+		Identity:      identity,
+		LeaseDuration: leaseDuration,
+		Clock:         clock,
+	})
+}
+
+func testKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("namespace-%d/name-%d", i%7, i)
+	}
+	return keys
+}
+
+func TestSharder(t *testing.T) {
+	ctx := context.Background()
+	cl := fake.NewSimpleClientset()
+	clock := fakeclock.NewFakeClock(time.Now())
+
+	a := newTestSharder(cl, clock, "a")
+	b := newTestSharder(cl, clock, "b")
+
+	var aChanges int
+	a.AddEventHandler(func() { aChanges++ })
+
+	keys := testKeys(1000)
+	for _, key := range keys {
+		if a.Owns(key) {
+			t.Fatalf("expected no keys to be owned before the lease is acquired, but %q is owned", key)
+		}
+	}
+
+	a.sync(ctx)
+	b.sync(ctx)
+	a.sync(ctx)
+
+	if aChanges != 2 {
+		t.Errorf("expected 2 membership changes, got %d", aChanges)
+	}
+	if members := a.Members(); len(members) != 2 {
+		t.Errorf("expected 2 members, got %v", members)
+	}
+
+	// the keys which moved to b are only taken over once the handover
+	// period has ended
+	for _, key := range keys {
+		if b.Owns(key) {
+			t.Fatalf("expected b to not own any key during the handover, but %q is owned", key)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		clock.Step(leaseDuration / 3)
+		a.sync(ctx)
+		b.sync(ctx)
+	}
+	if aChanges != 3 {
+		t.Errorf("expected the end of the handover to notify the handlers, got %d changes", aChanges)
+	}
+
+	var ownedByA, ownedByB int
+	for _, key := range keys {
+		switch {
+		case a.Owns(key) && b.Owns(key):
+			t.Fatalf("key %q is owned by both replicas", key)
+		case a.Owns(key):
+			ownedByA++
+		case b.Owns(key):
+			ownedByB++
+		default:
+			t.Fatalf("key %q is not owned by any replica", key)
+		}
+	}
+	if ownedByA < 400 || ownedByB < 400 {
+		t.Errorf("expected keys to be spread evenly, got a=%d b=%d", ownedByA, ownedByB)
+	}
+
+	// b stops renewing its lease, so its keys move to a once it expires
+	clock.Step(leaseDuration / 2)
+	a.sync(ctx)
+	if members := a.Members(); len(members) != 2 {
+		t.Errorf("expected b to still be a member before its lease expired, got %v", members)
+	}
+
+	clock.Step(leaseDuration)
+	a.sync(ctx)
+	if members := a.Members(); len(members) != 1 || members[0] != "a" {
+		t.Errorf("expected a to be the only member, got %v", members)
+	}
+	if b.Owns(keys[0]) {
+		t.Errorf("expected b to not own any keys after failing to renew its lease")
+	}
+	clock.Step(leaseDuration)
+	a.sync(ctx)
+	for _, key := range keys {
+		if !a.Owns(key) {
+			t.Fatalf("expected a to own all keys, but %q is not owned", key)
+		}
+	}
+	if aChanges != 5 {
+		t.Errorf("expected 5 membership changes, got %d", aChanges)
+	}
+
+	// the lease of b is garbage collected after it has been expired for a long time
+	clock.Step(11 * leaseDuration)
+	a.sync(ctx)
+	leases, err := cl.CoordinationV1().Leases("kube-system").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leases.Items) != 1 || leases.Items[0].Name != "test-a" {
+		t.Errorf("expected only the lease of a to remain, got %v", leases.Items)
+	}
+}
+
+// A replica joining the group must not take over keys before their previous
+// owner has observed that it joined.
+func TestSharderHandover(t *testing.T) {
+	ctx := context.Background()
+	cl := fake.NewSimpleClientset()
+	clock := fakeclock.NewFakeClock(time.Now())
+
+	a := newTestSharder(cl, clock, "a")
+	b := newTestSharder(cl, clock, "b")
+	a.sync(ctx)
+
+	keys := testKeys(1000)
+	for _, key := range keys {
+		if !a.Owns(key) {
+			t.Fatalf("expected a to own all keys, but %q is not owned", key)
+		}
+	}
+
+	// b joins, but a has not observed it yet
+	b.sync(ctx)
+	for _, key := range keys {
+		if b.Owns(key) {
+			t.Fatalf("key %q is owned by b before a observed that it joined", key)
+		}
+	}
+
+	// a observes b one sync period later, and gives up b's keys
+	clock.Step(leaseDuration / 3)
+	a.sync(ctx)
+	b.sync(ctx)
+	var moved int
+	for _, key := range keys {
+		if b.Owns(key) {
+			t.Fatalf("key %q is owned by b before the handover ended", key)
+		}
+		if !a.Owns(key) {
+			moved++
+		}
+	}
+	if moved == 0 {
+		t.Fatalf("expected some keys to move to b")
+	}
+
+	// b takes the keys over once the handover has ended
+	for i := 0; i < 3; i++ {
+		clock.Step(leaseDuration / 3)
+		a.sync(ctx)
+		b.sync(ctx)
+	}
+	for _, key := range keys {
+		if a.Owns(key) == b.Owns(key) {
+			t.Fatalf("expected key %q to be owned by exactly one replica", key)
+		}
+	}
+}
+
+func TestSharderRelease(t *testing.T) {
+	cl := fake.NewSimpleClientset()
+	clock := fakeclock.NewFakeClock(time.Now())
+	a := newTestSharder(cl, clock, "a")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	a.sync(ctx)
+	a.Run(ctx)
+
+	leases, err := cl.CoordinationV1().Leases("kube-system").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leases.Items) != 0 {
+		t.Errorf("expected the lease to be released, got %v", leases.Items)
+	}
+}
+
+// Adding a member must only move keys to the new member.
+func TestAssignStable(t *testing.T) {
+	before := []string{"a", "b", "c"}
+	after := []string{"a", "b", "c", "d"}
+	for _, key := range testKeys(1000) {
+		owner := assign(after, key)
+		if owner != "d" && owner != assign(before, key) {
+			t.Errorf("key %q moved from %q to %q", key, assign(before, key), owner)
+		}
+	}
+}
@@ -68,6 +68,10 @@ func (bf *baseFactory) Ingresses() networkingv1informers.IngressInformer {
 	return bf.f.Networking().V1().Ingresses()
 }
 
+func (bf *baseFactory) Namespaces() corev1informers.NamespaceInformer {
+	return bf.f.Core().V1().Namespaces()
+}
+
 func (bf *baseFactory) Secrets() SecretInformer {
 	return &baseSecretInformer{
 		f:         bf.f,
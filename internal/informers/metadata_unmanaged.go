@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// This file contains metadata-only informers for Secrets and ConfigMaps that
+// may contain certificates which are not managed by cert-manager. As only the
+// metadata is cached, memory usage does not grow with the size of the
+// Secrets and ConfigMaps in the cluster; their data has to be retrieved from
+// the kube apiserver when it is needed.
+
+var configMapsGVR = corev1.SchemeGroupVersion.WithResource("configmaps")
+
+// UnmanagedCertificateInformerFactory creates metadata-only informers for
+// Secrets and ConfigMaps that may contain certificates not managed by
+// cert-manager.
+type UnmanagedCertificateInformerFactory interface {
+	Start(<-chan struct{})
+	WaitForCacheSync(<-chan struct{}) map[string]bool
+	// TLSSecrets returns an informer for Secrets of type kubernetes.io/tls
+	// which are not labelled as part of cert-manager.
+	TLSSecrets() informers.GenericInformer
+	// ConfigMaps returns an informer for ConfigMaps with the
+	// cert-manager.io/monitor-expiry=true label.
+	ConfigMaps() informers.GenericInformer
+}
+
+type unmanagedCertificateFactory struct {
+	secretsFactory    metadatainformer.SharedInformerFactory
+	configMapsFactory metadatainformer.SharedInformerFactory
+
+	// The transforms of the informers must be set once, before they are
+	// started.
+	setSecretsTransform, setConfigMapsTransform sync.Once
+}
+
+// NewUnmanagedCertificateInformerFactory returns an
+// UnmanagedCertificateInformerFactory. Informers are only started for the
+// resources that have been requested from the factory.
+func NewUnmanagedCertificateInformerFactory(client metadata.Interface, resync time.Duration, namespace string) UnmanagedCertificateInformerFactory {
+	r, err := labels.NewRequirement(cmapi.MonitorExpiryLabelKey, selection.Equals, []string{"true"})
+	if err != nil {
+		panic(fmt.Errorf("internal error: failed to build label selector to filter monitored ConfigMaps: %w", err))
+	}
+	isMonitoredConfigMapLabelSelector := labels.NewSelector().Add(*r)
+
+	return &unmanagedCertificateFactory{
+		secretsFactory: metadatainformer.NewFilteredSharedInformerFactory(client, resync, namespace, func(listOptions *metav1.ListOptions) {
+			listOptions.FieldSelector = fields.OneTermEqualSelector("type", string(corev1.SecretTypeTLS)).String()
+			listOptions.LabelSelector = isNotCertManagerSecretLabelSelector.String()
+		}),
+		configMapsFactory: metadatainformer.NewFilteredSharedInformerFactory(client, resync, namespace, func(listOptions *metav1.ListOptions) {
+			listOptions.LabelSelector = isMonitoredConfigMapLabelSelector.String()
+		}),
+	}
+}
+
+func (f *unmanagedCertificateFactory) Start(stopCh <-chan struct{}) {
+	f.secretsFactory.Start(stopCh)
+	f.configMapsFactory.Start(stopCh)
+}
+
+func (f *unmanagedCertificateFactory) WaitForCacheSync(stopCh <-chan struct{}) map[string]bool {
+	caches := make(map[string]bool)
+	for key, val := range f.secretsFactory.WaitForCacheSync(stopCh) {
+		caches[key.String()] = val
+	}
+	for key, val := range f.configMapsFactory.WaitForCacheSync(stopCh) {
+		caches[key.String()] = val
+	}
+	return caches
+}
+
+func (f *unmanagedCertificateFactory) TLSSecrets() informers.GenericInformer {
+	informer := f.secretsFactory.ForResource(secretsGVR)
+	f.setSecretsTransform.Do(func() {
+		// Keep the annotations that identify Secrets created for a
+		// Certificate by older versions of cert-manager, which did not
+		// label them.
+		if err := informer.Informer().SetTransform(partialMetadataRemoveAllExcept(cmapi.CertificateNameKey)); err != nil {
+			panic(fmt.Sprintf("internal error: error setting transfomer on the metadata informer: %v", err))
+		}
+	})
+	return informer
+}
+
+func (f *unmanagedCertificateFactory) ConfigMaps() informers.GenericInformer {
+	informer := f.configMapsFactory.ForResource(configMapsGVR)
+	f.setConfigMapsTransform.Do(func() {
+		if err := informer.Informer().SetTransform(partialMetadataRemoveAll); err != nil {
+			panic(fmt.Sprintf("internal error: error setting transfomer on the metadata informer: %v", err))
+		}
+	})
+	return informer
+}
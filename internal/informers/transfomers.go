@@ -38,3 +38,28 @@ func partialMetadataRemoveAll(obj interface{}) (interface{}, error) {
 	partialMeta.Labels = nil
 	return partialMeta, nil
 }
+
+// partialMetadataRemoveAllExcept returns a cache.TransformFunc that removes
+// labels, managed fields and all annotations but the given ones from
+// PartialObjectMetadata.
+func partialMetadataRemoveAllExcept(annotationKeys ...string) cache.TransformFunc {
+	return func(obj interface{}) (interface{}, error) {
+		partialMeta, ok := obj.(*metav1.PartialObjectMetadata)
+		if !ok {
+			return nil, fmt.Errorf("internal error: cannot cast object %#+v to PartialObjectMetadata", obj)
+		}
+		var annotations map[string]string
+		for _, key := range annotationKeys {
+			if value, ok := partialMeta.Annotations[key]; ok {
+				if annotations == nil {
+					annotations = make(map[string]string)
+				}
+				annotations[key] = value
+			}
+		}
+		partialMeta.Annotations = annotations
+		partialMeta.ManagedFields = nil
+		partialMeta.Labels = nil
+		return partialMeta, nil
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatedefaults
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	"github.com/cert-manager/cert-manager/internal/certificatedefaults"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission/initializer"
+)
+
+const PluginName = "CertificateDefaults"
+
+// certificateDefaults sets the defaults configured on the Namespace of a
+// Certificate on the empty fields of the Certificate when it is created.
+type certificateDefaults struct {
+	*admission.Handler
+
+	client kubernetes.Interface
+}
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func() (admission.Interface, error) {
+		return NewPlugin(), nil
+	})
+}
+
+var _ admission.MutationInterface = &certificateDefaults{}
+var _ initializer.WantsExternalKubeClientSet = &certificateDefaults{}
+
+func NewPlugin() admission.Interface {
+	return &certificateDefaults{
+		Handler: admission.NewHandler(admissionv1.Create),
+	}
+}
+
+func (p *certificateDefaults) Mutate(ctx context.Context, request admissionv1.AdmissionRequest, obj runtime.Object) error {
+	// Only run this admission plugin when Certificates are created
+	if request.RequestResource.Group != "cert-manager.io" ||
+		request.RequestResource.Resource != "certificates" ||
+		request.Operation != admissionv1.Create {
+		return nil
+	}
+
+	crt, ok := obj.(*certmanager.Certificate)
+	if !ok {
+		return fmt.Errorf("internal error: object in admission request is not of type *certmanager.Certificate")
+	}
+
+	ns, err := p.client.CoreV1().Namespaces().Get(ctx, request.Namespace, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get namespace %q: %w", request.Namespace, err)
+	}
+
+	defaults, err := certificatedefaults.FromAnnotations(ns.Annotations)
+	if err != nil {
+		return fmt.Errorf("namespace %q has invalid Certificate defaults: %w", request.Namespace, err)
+	}
+	if defaults == nil {
+		return nil
+	}
+
+	applyDefaults(crt, defaults)
+
+	return nil
+}
+
+// applyDefaults is the equivalent of Defaults.Apply for the internal
+// Certificate type.
+func applyDefaults(crt *certmanager.Certificate, d *certificatedefaults.Defaults) {
+	if len(crt.Spec.IssuerRef.Name) == 0 && len(d.IssuerName) > 0 {
+		crt.Spec.IssuerRef.Name = d.IssuerName
+		crt.Spec.IssuerRef.Kind = d.IssuerKind
+		crt.Spec.IssuerRef.Group = d.IssuerGroup
+	}
+
+	if crt.Spec.Duration == nil && d.Duration != nil {
+		crt.Spec.Duration = d.Duration.DeepCopy()
+	}
+
+	if crt.Spec.RenewBefore == nil && d.RenewBefore != nil {
+		crt.Spec.RenewBefore = d.RenewBefore.DeepCopy()
+	}
+
+	if len(d.PrivateKeyAlgorithm) == 0 && d.PrivateKeySize == 0 && len(d.PrivateKeyRotationPolicy) == 0 {
+		return
+	}
+
+	if crt.Spec.PrivateKey == nil {
+		crt.Spec.PrivateKey = &certmanager.CertificatePrivateKey{}
+	}
+	pk := crt.Spec.PrivateKey
+
+	algorithm := certmanager.PrivateKeyAlgorithm(d.PrivateKeyAlgorithm)
+	sizeApplies := len(pk.Algorithm) == 0 || pk.Algorithm == algorithm
+	if len(pk.Algorithm) == 0 {
+		pk.Algorithm = algorithm
+	}
+	if pk.Size == 0 && sizeApplies {
+		pk.Size = d.PrivateKeySize
+	}
+	if len(pk.RotationPolicy) == 0 {
+		pk.RotationPolicy = certmanager.PrivateKeyRotationPolicy(d.PrivateKeyRotationPolicy)
+	}
+}
+
+func (p *certificateDefaults) SetExternalKubeClientSet(client kubernetes.Interface) {
+	p.client = client
+}
+
+func (p *certificateDefaults) ValidateInitialization() error {
+	if p.client == nil {
+		return fmt.Errorf("kube client not set")
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatedefaults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+func TestMutate(t *testing.T) {
+	certificatesResource := &metav1.GroupVersionResource{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Resource: "certificates",
+	}
+	defaultedNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "defaulted",
+			Annotations: map[string]string{
+				"cert-manager.io/default-issuer-name":                 "team-issuer",
+				"cert-manager.io/default-issuer-kind":                 "ClusterIssuer",
+				"cert-manager.io/default-duration":                    "720h",
+				"cert-manager.io/default-renew-before":                "240h",
+				"cert-manager.io/default-private-key-algorithm":       "RSA",
+				"cert-manager.io/default-private-key-size":            "4096",
+				"cert-manager.io/default-private-key-rotation-policy": "Always",
+			},
+		},
+	}
+	invalidNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "invalid",
+			Annotations: map[string]string{
+				"cert-manager.io/default-duration": "a month",
+			},
+		},
+	}
+	emptyNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "empty"},
+	}
+
+	tests := map[string]struct {
+		namespace string
+		operation admissionv1.Operation
+		resource  *metav1.GroupVersionResource
+		crt       *certmanager.Certificate
+		expected  *certmanager.Certificate
+		expectErr string
+	}{
+		"empty fields are defaulted": {
+			namespace: "defaulted",
+			operation: admissionv1.Create,
+			resource:  certificatesResource,
+			crt:       &certmanager.Certificate{},
+			expected: &certmanager.Certificate{
+				Spec: certmanager.CertificateSpec{
+					IssuerRef:   cmmeta.ObjectReference{Name: "team-issuer", Kind: "ClusterIssuer"},
+					Duration:    &metav1.Duration{Duration: 720 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 240 * time.Hour},
+					PrivateKey: &certmanager.CertificatePrivateKey{
+						Algorithm:      certmanager.RSAKeyAlgorithm,
+						Size:           4096,
+						RotationPolicy: certmanager.RotationPolicyAlways,
+					},
+				},
+			},
+		},
+		"set fields are not overridden": {
+			namespace: "defaulted",
+			operation: admissionv1.Create,
+			resource:  certificatesResource,
+			crt: &certmanager.Certificate{
+				Spec: certmanager.CertificateSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "my-issuer"},
+					Duration:  &metav1.Duration{Duration: time.Hour},
+					PrivateKey: &certmanager.CertificatePrivateKey{
+						Algorithm:      certmanager.ECDSAKeyAlgorithm,
+						RotationPolicy: certmanager.RotationPolicyNever,
+					},
+				},
+			},
+			expected: &certmanager.Certificate{
+				Spec: certmanager.CertificateSpec{
+					IssuerRef:   cmmeta.ObjectReference{Name: "my-issuer"},
+					Duration:    &metav1.Duration{Duration: time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 240 * time.Hour},
+					PrivateKey: &certmanager.CertificatePrivateKey{
+						Algorithm:      certmanager.ECDSAKeyAlgorithm,
+						RotationPolicy: certmanager.RotationPolicyNever,
+					},
+				},
+			},
+		},
+		"namespace without defaults": {
+			namespace: "empty",
+			operation: admissionv1.Create,
+			resource:  certificatesResource,
+			crt:       &certmanager.Certificate{},
+			expected:  &certmanager.Certificate{},
+		},
+		"namespace not found": {
+			namespace: "missing",
+			operation: admissionv1.Create,
+			resource:  certificatesResource,
+			crt:       &certmanager.Certificate{},
+			expected:  &certmanager.Certificate{},
+		},
+		"invalid defaults": {
+			namespace: "invalid",
+			operation: admissionv1.Create,
+			resource:  certificatesResource,
+			crt:       &certmanager.Certificate{},
+			expected:  &certmanager.Certificate{},
+			expectErr: `namespace "invalid" has invalid Certificate defaults: invalid annotation "cert-manager.io/default-duration": time: invalid duration "a month"`,
+		},
+		"updates are ignored": {
+			namespace: "defaulted",
+			operation: admissionv1.Update,
+			resource:  certificatesResource,
+			crt:       &certmanager.Certificate{},
+			expected:  &certmanager.Certificate{},
+		},
+		"other resources are ignored": {
+			namespace: "defaulted",
+			operation: admissionv1.Create,
+			resource: &metav1.GroupVersionResource{
+				Group:    "cert-manager.io",
+				Version:  "v1",
+				Resource: "certificaterequests",
+			},
+			crt:      &certmanager.Certificate{},
+			expected: &certmanager.Certificate{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPlugin().(*certificateDefaults)
+			p.SetExternalKubeClientSet(fake.NewSimpleClientset(defaultedNamespace, invalidNamespace, emptyNamespace))
+
+			err := p.Mutate(context.Background(), admissionv1.AdmissionRequest{
+				Operation:       test.operation,
+				RequestResource: test.resource,
+				Namespace:       test.namespace,
+			}, test.crt)
+			if test.expectErr != "" {
+				assert.EqualError(t, err, test.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, test.crt)
+		})
+	}
+}
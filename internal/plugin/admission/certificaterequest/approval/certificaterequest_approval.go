@@ -113,7 +113,12 @@ func (c *certificateRequestApproval) Validate(ctx context.Context, request admis
 		return nil, err
 	}
 
-	signerNames := signerNamesForAPIResource(cr.Spec.IssuerRef.Name, cr.Namespace, *apiResource)
+	// Issuers in other namespaces are named after their own namespace.
+	namespace := cr.Namespace
+	if cr.Spec.IssuerRef.Namespace != "" {
+		namespace = cr.Spec.IssuerRef.Namespace
+	}
+	signerNames := signerNamesForAPIResource(cr.Spec.IssuerRef.Name, namespace, *apiResource)
 	if !isAuthorizedForSignerNames(ctx, c.authorizer, userInfoForRequest(request), signerNames) {
 		return nil, field.Forbidden(field.NewPath("status.conditions"),
 			fmt.Sprintf("user %q does not have permissions to set approved/denied conditions for issuer %v", request.UserInfo.Username, cr.Spec.IssuerRef))
@@ -61,6 +61,16 @@ func TestValidate(t *testing.T) {
 		},
 	}
 
+	crossNamespaceCR := baseCR.DeepCopy()
+	crossNamespaceCR.Spec.IssuerRef = meta.ObjectReference{
+		Name:      "my-issuer",
+		Kind:      "Issuer",
+		Group:     "cert-manager.io",
+		Namespace: "issuers",
+	}
+	approvedCrossNamespaceCR := crossNamespaceCR.DeepCopy()
+	approvedCrossNamespaceCR.Status = approvedCR.Status
+
 	var alwaysPanicAuthorizer *fakeAuthorizer
 	tests := map[string]struct {
 		req          *admissionv1.AdmissionRequest
@@ -113,7 +123,7 @@ func TestValidate(t *testing.T) {
 					return &metav1.APIGroupList{}, nil
 				}),
 			expErr: field.Forbidden(field.NewPath("spec.issuerRef"),
-				"referenced signer resource does not exist: {my-issuer Issuer example.io}"),
+				"referenced signer resource does not exist: {my-issuer Issuer example.io }"),
 		},
 		"if the CertificateRequest references a signer that the approver doesn't have permissions for, error": {
 			req: &admissionv1.AdmissionRequest{
@@ -159,7 +169,7 @@ func TestValidate(t *testing.T) {
 				decision:    authorizer.DecisionNoOpinion,
 			},
 			expErr: field.Forbidden(field.NewPath("status.conditions"),
-				`user "user-1" does not have permissions to set approved/denied conditions for issuer {my-issuer Issuer example.io}`),
+				`user "user-1" does not have permissions to set approved/denied conditions for issuer {my-issuer Issuer example.io }`),
 		},
 		"if the CertificateRequest references a signer that the approver has permissions for, return nil": {
 			req: &admissionv1.AdmissionRequest{
@@ -205,6 +215,50 @@ func TestValidate(t *testing.T) {
 				decision:    authorizer.DecisionAllow,
 			},
 		},
+		"if the CertificateRequest references an Issuer in another namespace, the signer name contains the namespace of the Issuer": {
+			req: &admissionv1.AdmissionRequest{
+				UserInfo: authnv1.UserInfo{
+					Username: "user-1",
+				},
+				Operation: admissionv1.Update,
+				RequestResource: &metav1.GroupVersionResource{
+					Group:    "cert-manager.io",
+					Resource: "certificaterequests",
+				},
+				RequestSubResource: "status",
+			},
+			oldCR: crossNamespaceCR,
+			newCR: approvedCrossNamespaceCR,
+			discoverclient: discoveryfake.NewDiscovery().
+				WithServerGroups(func() (*metav1.APIGroupList, error) {
+					return &metav1.APIGroupList{
+						Groups: []metav1.APIGroup{
+							{
+								Name: "cert-manager.io",
+								Versions: []metav1.GroupVersionForDiscovery{
+									{GroupVersion: "cert-manager.io/v1", Version: "v1"},
+								},
+							},
+						},
+					}, nil
+				}).
+				WithServerResourcesForGroupVersion(func(groupVersion string) (*metav1.APIResourceList, error) {
+					return &metav1.APIResourceList{
+						APIResources: []metav1.APIResource{
+							{
+								Name:       "issuers",
+								Namespaced: true,
+								Kind:       "Issuer",
+							},
+						},
+					}, nil
+				}),
+			authorizer: &fakeAuthorizer{
+				verb:        "approve",
+				allowedName: "issuers.cert-manager.io/issuers.my-issuer",
+				decision:    authorizer.DecisionAllow,
+			},
+		},
 		"if the CertificateRequest references a signer that the approver has permissions for the wildcard of, return nil": {
 			req: &admissionv1.AdmissionRequest{
 				UserInfo: authnv1.UserInfo{
@@ -291,7 +345,7 @@ func TestValidate(t *testing.T) {
 				err: fmt.Errorf("authorizer error"),
 			},
 			expErr: field.Forbidden(field.NewPath("status.conditions"),
-				`user "user-1" does not have permissions to set approved/denied conditions for issuer {my-issuer Issuer example.io}`),
+				`user "user-1" does not have permissions to set approved/denied conditions for issuer {my-issuer Issuer example.io }`),
 		},
 	}
 
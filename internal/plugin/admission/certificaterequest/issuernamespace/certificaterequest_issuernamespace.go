@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuernamespace
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	"github.com/cert-manager/cert-manager/internal/controller/issuers"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission/initializer"
+)
+
+const PluginName = "CertificateRequestIssuerNamespace"
+
+// certificateRequestIssuerNamespace denies the creation of CertificateRequests
+// that reference a ClusterIssuer whose namespaceSelector does not match the
+// namespace of the CertificateRequest.
+type certificateRequestIssuerNamespace struct {
+	*admission.Handler
+
+	kubeClient kubernetes.Interface
+	cmClient   cmclient.Interface
+}
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func() (admission.Interface, error) {
+		return NewPlugin(), nil
+	})
+}
+
+var _ admission.ValidationInterface = &certificateRequestIssuerNamespace{}
+var _ initializer.WantsExternalKubeClientSet = &certificateRequestIssuerNamespace{}
+var _ initializer.WantsExternalCertManagerClientSet = &certificateRequestIssuerNamespace{}
+
+func NewPlugin() admission.Interface {
+	return &certificateRequestIssuerNamespace{
+		Handler: admission.NewHandler(admissionv1.Create),
+	}
+}
+
+func (p *certificateRequestIssuerNamespace) Validate(ctx context.Context, request admissionv1.AdmissionRequest, oldObj, obj runtime.Object) ([]string, error) {
+	// Only run this admission plugin when CertificateRequests are created
+	if request.RequestResource.Group != "cert-manager.io" ||
+		request.RequestResource.Resource != "certificaterequests" ||
+		request.Operation != admissionv1.Create {
+		return nil, nil
+	}
+
+	cr, ok := obj.(*certmanager.CertificateRequest)
+	if !ok {
+		return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.CertificateRequest")
+	}
+
+	ref := cr.Spec.IssuerRef
+	if ref.Kind != certmanager.ClusterIssuerKind || !(ref.Group == "" || ref.Group == "cert-manager.io") {
+		return nil, nil
+	}
+
+	issuer, err := p.cmClient.CertmanagerV1().ClusterIssuers().Get(ctx, ref.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		// The CertificateRequest controllers report missing issuers.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ClusterIssuer %q: %w", ref.Name, err)
+	}
+
+	getNamespace := func(name string) (*corev1.Namespace, error) {
+		return p.kubeClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	}
+	allowed, err := issuers.NamespaceAllowed(issuers.NamespaceGetterFunc(getNamespace), issuer, request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "issuerRef"), fmt.Sprintf("ClusterIssuer %q does not allow CertificateRequests in namespace %q", ref.Name, request.Namespace)),
+		}.ToAggregate()
+	}
+
+	return nil, nil
+}
+
+func (p *certificateRequestIssuerNamespace) SetExternalKubeClientSet(client kubernetes.Interface) {
+	p.kubeClient = client
+}
+
+func (p *certificateRequestIssuerNamespace) SetExternalCertManagerClientSet(client cmclient.Interface) {
+	p.cmClient = client
+}
+
+func (p *certificateRequestIssuerNamespace) ValidateInitialization() error {
+	if p.kubeClient == nil {
+		return fmt.Errorf("kube client not set")
+	}
+	if p.cmClient == nil {
+		return fmt.Errorf("cert-manager client not set")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuernamespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+)
+
+func TestValidate(t *testing.T) {
+	certificateRequestsResource := &metav1.GroupVersionResource{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Resource: "certificaterequests",
+	}
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+	)
+	cmClient := cmfake.NewSimpleClientset(
+		&cmapi.ClusterIssuer{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a-only"},
+			Spec: cmapi.IssuerSpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "a"},
+				},
+			},
+		},
+		&cmapi.ClusterIssuer{
+			ObjectMeta: metav1.ObjectMeta{Name: "everyone"},
+		},
+	)
+
+	tests := map[string]struct {
+		namespace string
+		resource  *metav1.GroupVersionResource
+		issuerRef cmmeta.ObjectReference
+		expectErr string
+	}{
+		"allowed namespace": {
+			namespace: "team-a",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "team-a-only", Kind: "ClusterIssuer"},
+		},
+		"namespace that is not allowed": {
+			namespace: "team-b",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "team-a-only", Kind: "ClusterIssuer", Group: "cert-manager.io"},
+			expectErr: `spec.issuerRef: Forbidden: ClusterIssuer "team-a-only" does not allow CertificateRequests in namespace "team-b"`,
+		},
+		"ClusterIssuer without namespaceSelector": {
+			namespace: "team-b",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "everyone", Kind: "ClusterIssuer"},
+		},
+		"ClusterIssuer not found": {
+			namespace: "team-b",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "missing", Kind: "ClusterIssuer"},
+		},
+		"Issuers are ignored": {
+			namespace: "team-b",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "team-a-only", Kind: "Issuer"},
+		},
+		"external issuers are ignored": {
+			namespace: "team-b",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "team-a-only", Kind: "ClusterIssuer", Group: "example.com"},
+		},
+		"other resources are ignored": {
+			namespace: "team-b",
+			resource: &metav1.GroupVersionResource{
+				Group:    "cert-manager.io",
+				Version:  "v1",
+				Resource: "certificates",
+			},
+			issuerRef: cmmeta.ObjectReference{Name: "team-a-only", Kind: "ClusterIssuer"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPlugin().(*certificateRequestIssuerNamespace)
+			p.SetExternalKubeClientSet(kubeClient)
+			p.SetExternalCertManagerClientSet(cmClient)
+
+			cr := &certmanager.CertificateRequest{
+				Spec: certmanager.CertificateRequestSpec{IssuerRef: test.issuerRef},
+			}
+			warnings, err := p.Validate(context.Background(), admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Create,
+				RequestResource: test.resource,
+				Namespace:       test.namespace,
+			}, nil, cr)
+			assert.Empty(t, warnings)
+			if test.expectErr != "" {
+				assert.EqualError(t, err, test.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerreferencegrant
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gwclient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	"github.com/cert-manager/cert-manager/internal/referencegrant"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission/initializer"
+)
+
+const PluginName = "IssuerReferenceGrant"
+
+// issuerReferenceGrant denies Certificates and CertificateRequests that
+// reference an Issuer in another namespace, unless a Gateway API
+// ReferenceGrant in the namespace of the Issuer permits CertificateRequests
+// to reference it. Certificates require the same grant as
+// CertificateRequests, since the controller issues them through
+// CertificateRequests and only honours grants for CertificateRequests.
+type issuerReferenceGrant struct {
+	*admission.Handler
+
+	gatewayClient gwclient.Interface
+}
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func() (admission.Interface, error) {
+		return NewPlugin(), nil
+	})
+}
+
+var _ admission.ValidationInterface = &issuerReferenceGrant{}
+var _ initializer.WantsExternalGatewayClientSet = &issuerReferenceGrant{}
+
+func NewPlugin() admission.Interface {
+	return &issuerReferenceGrant{
+		Handler: admission.NewHandler(admissionv1.Create, admissionv1.Update),
+	}
+}
+
+func (p *issuerReferenceGrant) Validate(ctx context.Context, request admissionv1.AdmissionRequest, oldObj, obj runtime.Object) ([]string, error) {
+	if request.RequestResource.Group != "cert-manager.io" {
+		return nil, nil
+	}
+
+	var ref, oldRef *cmmeta.ObjectReference
+	switch request.RequestResource.Resource {
+	case "certificates":
+		crt, ok := obj.(*certmanager.Certificate)
+		if !ok {
+			return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.Certificate")
+		}
+		ref = &crt.Spec.IssuerRef
+		if oldCrt, ok := oldObj.(*certmanager.Certificate); ok {
+			oldRef = &oldCrt.Spec.IssuerRef
+		}
+	case "certificaterequests":
+		cr, ok := obj.(*certmanager.CertificateRequest)
+		if !ok {
+			return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.CertificateRequest")
+		}
+		ref = &cr.Spec.IssuerRef
+		if oldCR, ok := oldObj.(*certmanager.CertificateRequest); ok {
+			oldRef = &oldCR.Spec.IssuerRef
+		}
+	default:
+		return nil, nil
+	}
+
+	if ref.Namespace == "" || ref.Namespace == request.Namespace {
+		return nil, nil
+	}
+	// Don't block updates of resources whose issuerRef hasn't changed, even
+	// if the ReferenceGrant has since been removed. The controllers will
+	// refuse to use the Issuer instead.
+	if request.Operation == admissionv1.Update && oldRef != nil && *oldRef == *ref {
+		return nil, nil
+	}
+
+	grants, err := p.gatewayClient.GatewayV1beta1().ReferenceGrants(ref.Namespace).List(ctx, metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "issuerRef", "namespace"), "cross-namespace issuer references require the Gateway API ReferenceGrant CRD to be installed"),
+		}.ToAggregate()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ReferenceGrants in namespace %q: %w", ref.Namespace, err)
+	}
+
+	grantPtrs := make([]*gwapi.ReferenceGrant, len(grants.Items))
+	for i := range grants.Items {
+		grantPtrs[i] = &grants.Items[i]
+	}
+	if !referencegrant.Permits(grantPtrs, certmanager.CertificateRequestKind, request.Namespace, ref.Name) {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "issuerRef", "namespace"), fmt.Sprintf("no ReferenceGrant in namespace %q allows CertificateRequests in namespace %q to reference Issuer %q", ref.Namespace, request.Namespace, ref.Name)),
+		}.ToAggregate()
+	}
+
+	return nil, nil
+}
+
+func (p *issuerReferenceGrant) SetExternalGatewayClientSet(client gwclient.Interface) {
+	p.gatewayClient = client
+}
+
+func (p *issuerReferenceGrant) ValidateInitialization() error {
+	if p.gatewayClient == nil {
+		return fmt.Errorf("gateway client not set")
+	}
+	return nil
+}
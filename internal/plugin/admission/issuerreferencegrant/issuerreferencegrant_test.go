@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerreferencegrant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gwfake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+func TestValidate(t *testing.T) {
+	certificatesResource := &metav1.GroupVersionResource{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Resource: "certificates",
+	}
+	certificateRequestsResource := &metav1.GroupVersionResource{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Resource: "certificaterequests",
+	}
+	sharedIssuer := gwapi.ObjectName("shared")
+	gatewayClient := gwfake.NewSimpleClientset(
+		&gwapi.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "issuers", Name: "team-a"},
+			Spec: gwapi.ReferenceGrantSpec{
+				From: []gwapi.ReferenceGrantFrom{
+					{Group: "cert-manager.io", Kind: "CertificateRequest", Namespace: "team-a"},
+				},
+				To: []gwapi.ReferenceGrantTo{
+					{Group: "cert-manager.io", Kind: "Issuer", Name: &sharedIssuer},
+				},
+			},
+		},
+		&gwapi.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "issuers", Name: "team-c"},
+			Spec: gwapi.ReferenceGrantSpec{
+				From: []gwapi.ReferenceGrantFrom{
+					{Group: "cert-manager.io", Kind: "Certificate", Namespace: "team-c"},
+				},
+				To: []gwapi.ReferenceGrantTo{
+					{Group: "cert-manager.io", Kind: "Issuer", Name: &sharedIssuer},
+				},
+			},
+		},
+	)
+
+	tests := map[string]struct {
+		operation admissionv1.Operation
+		namespace string
+		resource  *metav1.GroupVersionResource
+		issuerRef cmmeta.ObjectReference
+		oldRef    *cmmeta.ObjectReference
+		expectErr string
+	}{
+		"same namespace references are ignored": {
+			operation: admissionv1.Create,
+			namespace: "team-b",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "shared", Namespace: "team-b"},
+		},
+		"permitted cross-namespace reference": {
+			operation: admissionv1.Create,
+			namespace: "team-a",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "shared", Kind: "Issuer", Namespace: "issuers"},
+		},
+		"reference to an Issuer which isn't granted": {
+			operation: admissionv1.Create,
+			namespace: "team-a",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "private", Namespace: "issuers"},
+			expectErr: `spec.issuerRef.namespace: Forbidden: no ReferenceGrant in namespace "issuers" allows CertificateRequests in namespace "team-a" to reference Issuer "private"`,
+		},
+		"reference from a namespace which isn't granted": {
+			operation: admissionv1.Create,
+			namespace: "team-b",
+			resource:  certificateRequestsResource,
+			issuerRef: cmmeta.ObjectReference{Name: "shared", Namespace: "issuers"},
+			expectErr: `spec.issuerRef.namespace: Forbidden: no ReferenceGrant in namespace "issuers" allows CertificateRequests in namespace "team-b" to reference Issuer "shared"`,
+		},
+		"certificates are permitted by grants for CertificateRequests": {
+			operation: admissionv1.Create,
+			namespace: "team-a",
+			resource:  certificatesResource,
+			issuerRef: cmmeta.ObjectReference{Name: "shared", Namespace: "issuers"},
+		},
+		"certificates aren't permitted by grants for Certificates only": {
+			operation: admissionv1.Create,
+			namespace: "team-c",
+			resource:  certificatesResource,
+			issuerRef: cmmeta.ObjectReference{Name: "shared", Namespace: "issuers"},
+			expectErr: `spec.issuerRef.namespace: Forbidden: no ReferenceGrant in namespace "issuers" allows CertificateRequests in namespace "team-c" to reference Issuer "shared"`,
+		},
+		"updates which don't change the issuerRef are allowed": {
+			operation: admissionv1.Update,
+			namespace: "team-a",
+			resource:  certificatesResource,
+			issuerRef: cmmeta.ObjectReference{Name: "shared", Namespace: "issuers"},
+			oldRef:    &cmmeta.ObjectReference{Name: "shared", Namespace: "issuers"},
+		},
+		"updates which change the issuerRef are checked": {
+			operation: admissionv1.Update,
+			namespace: "team-b",
+			resource:  certificatesResource,
+			issuerRef: cmmeta.ObjectReference{Name: "shared", Namespace: "issuers"},
+			oldRef:    &cmmeta.ObjectReference{Name: "shared"},
+			expectErr: `spec.issuerRef.namespace: Forbidden: no ReferenceGrant in namespace "issuers" allows CertificateRequests in namespace "team-b" to reference Issuer "shared"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPlugin().(*issuerReferenceGrant)
+			p.SetExternalGatewayClientSet(gatewayClient)
+
+			var obj, oldObj runtime.Object
+			if test.resource.Resource == "certificates" {
+				obj = &certmanager.Certificate{Spec: certmanager.CertificateSpec{IssuerRef: test.issuerRef}}
+				if test.oldRef != nil {
+					oldObj = &certmanager.Certificate{Spec: certmanager.CertificateSpec{IssuerRef: *test.oldRef}}
+				}
+			} else {
+				obj = &certmanager.CertificateRequest{Spec: certmanager.CertificateRequestSpec{IssuerRef: test.issuerRef}}
+				if test.oldRef != nil {
+					oldObj = &certmanager.CertificateRequest{Spec: certmanager.CertificateRequestSpec{IssuerRef: *test.oldRef}}
+				}
+			}
+			warnings, err := p.Validate(context.Background(), admissionv1.AdmissionRequest{
+				Operation:       test.operation,
+				RequestResource: test.resource,
+				Namespace:       test.namespace,
+			}, oldObj, obj)
+			assert.Empty(t, warnings)
+			if test.expectErr != "" {
+				assert.EqualError(t, err, test.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,8 +18,11 @@ package plugin
 
 import (
 	"github.com/cert-manager/cert-manager/internal/plugin/admission/apideprecation"
+	"github.com/cert-manager/cert-manager/internal/plugin/admission/certificatedefaults"
 	certificaterequestapproval "github.com/cert-manager/cert-manager/internal/plugin/admission/certificaterequest/approval"
 	certificaterequestidentity "github.com/cert-manager/cert-manager/internal/plugin/admission/certificaterequest/identity"
+	certificaterequestissuernamespace "github.com/cert-manager/cert-manager/internal/plugin/admission/certificaterequest/issuernamespace"
+	"github.com/cert-manager/cert-manager/internal/plugin/admission/issuerreferencegrant"
 	"github.com/cert-manager/cert-manager/internal/plugin/admission/resourcevalidation"
 	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -27,24 +30,33 @@ import (
 
 var AllOrderedPlugins = []string{
 	apideprecation.PluginName,
+	certificatedefaults.PluginName,
 	resourcevalidation.PluginName,
 	certificaterequestidentity.PluginName,
 	certificaterequestapproval.PluginName,
+	certificaterequestissuernamespace.PluginName,
+	issuerreferencegrant.PluginName,
 }
 
 func RegisterAllPlugins(plugins *admission.Plugins) {
 	apideprecation.Register(plugins)
+	certificatedefaults.Register(plugins)
 	certificaterequestidentity.Register(plugins)
 	certificaterequestapproval.Register(plugins)
+	certificaterequestissuernamespace.Register(plugins)
+	issuerreferencegrant.Register(plugins)
 	resourcevalidation.Register(plugins)
 }
 
 func DefaultOnAdmissionPlugins() sets.String {
 	return sets.NewString(
 		apideprecation.PluginName,
+		certificatedefaults.PluginName,
 		resourcevalidation.PluginName,
 		certificaterequestidentity.PluginName,
 		certificaterequestapproval.PluginName,
+		certificaterequestissuernamespace.PluginName,
+		issuerreferencegrant.PluginName,
 	)
 }
 
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package referencegrant evaluates Gateway API ReferenceGrants which permit
+// cert-manager resources to reference Issuers in other namespaces.
+package referencegrant
+
+import (
+	gwapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Permits returns true if any of the given Gateway API
+// ReferenceGrants, which must all live in the namespace of the Issuer named
+// issuerName, permits a resource of kind fromKind in namespace fromNamespace
+// to reference that Issuer.
+// A ReferenceGrant permits the reference if one of its `from` entries has the
+// group "cert-manager.io", the given kind and the given namespace, and one of
+// its `to` entries has the group "cert-manager.io", the kind "Issuer" and
+// either no name or the name of the Issuer.
+func Permits(grants []*gwapi.ReferenceGrant, fromKind, fromNamespace, issuerName string) bool {
+	for _, grant := range grants {
+		if grantFromMatches(grant.Spec.From, fromKind, fromNamespace) &&
+			grantToMatches(grant.Spec.To, issuerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func grantFromMatches(from []gwapi.ReferenceGrantFrom, kind, namespace string) bool {
+	for _, f := range from {
+		if string(f.Group) == cmapi.SchemeGroupVersion.Group &&
+			string(f.Kind) == kind &&
+			string(f.Namespace) == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func grantToMatches(to []gwapi.ReferenceGrantTo, name string) bool {
+	for _, t := range to {
+		if string(t.Group) != cmapi.SchemeGroupVersion.Group || string(t.Kind) != cmapi.IssuerKind {
+			continue
+		}
+		if t.Name == nil || string(*t.Name) == "" || string(*t.Name) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing configures OpenTelemetry tracing for cert-manager and
+// propagates trace context between the resources of the issuance pipeline.
+//
+// A trace covers one revision of a Certificate. The Certificate controllers
+// derive the trace ID from the Certificate UID and the revision being issued,
+// so that they contribute spans to the same trace without having to store it.
+// From there, the trace context is propagated to CertificateRequests, Orders
+// and Challenges using the `cert-manager.io/traceparent` annotation, which
+// holds a W3C traceparent.
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// instrumentationName is the name of the tracer used by cert-manager.
+const instrumentationName = "github.com/cert-manager/cert-manager"
+
+// Options configures the export of traces.
+type Options struct {
+	// Endpoint is the host:port of an OTLP gRPC collector.
+	Endpoint string
+
+	// Insecure disables TLS when connecting to the collector.
+	Insecure bool
+
+	// SamplingRatePerMillion is the number of traces sampled per million
+	// Certificate revisions. Spans are always sampled if the trace context
+	// propagated to a resource is sampled.
+	SamplingRatePerMillion int32
+
+	// ServiceName is reported as the `service.name` resource attribute.
+	ServiceName string
+}
+
+// Setup exports traces to the OTLP collector configured in opts, and installs
+// the tracer provider globally. The returned function flushes any remaining
+// spans and must be called before the process exits.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	tp := NewTracerProvider(opts, sdktrace.WithBatcher(exporter))
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// NewTracerProvider returns a tracer provider that samples according to opts
+// and passes spans to the given span processors. Tests can pass an in-memory
+// exporter using sdktrace.WithSyncer.
+func NewTracerProvider(opts Options, processors ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	ratio := sdktrace.TraceIDRatioBased(float64(opts.SamplingRatePerMillion) / 1000000)
+	// The remote parent of the Certificate controllers' spans is derived
+	// from the Certificate revision and never sampled, so sample by trace ID
+	// to make the same decision in every controller.
+	sampler := sdktrace.ParentBased(ratio, sdktrace.WithRemoteParentNotSampled(ratio))
+
+	tpOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceNameKey.String(opts.ServiceName))),
+	}
+	return sdktrace.NewTracerProvider(append(tpOpts, processors...)...)
+}
+
+// Start starts a span using the globally installed tracer provider. If
+// tracing is not set up, the returned span is not recorded.
+func Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, opts...)
+}
+
+// WithResource returns a span option that identifies the resource the span is
+// about.
+func WithResource(obj metav1.Object) trace.SpanStartOption {
+	return trace.WithAttributes(
+		semconv.K8SNamespaceNameKey.String(obj.GetNamespace()),
+		attribute.String("k8s.resource.name", obj.GetName()),
+	)
+}
+
+// End records err on the span, if it is not nil, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ContextForCertificateRevision returns a context whose remote parent span
+// is derived from the Certificate UID and the revision that is being issued.
+// All spans started from such contexts for the same revision belong to the
+// same trace.
+func ContextForCertificateRevision(ctx context.Context, crt *cmapi.Certificate) context.Context {
+	nextRevision := 1
+	if crt.Status.Revision != nil {
+		nextRevision = *crt.Status.Revision + 1
+	}
+	sum := sha256.Sum256([]byte(string(crt.UID) + "/" + strconv.Itoa(nextRevision)))
+
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Remote:  true,
+	}))
+}
+
+// ContextFromAnnotations returns a context whose remote parent span is read
+// from the traceparent annotation of obj. If obj has no valid traceparent
+// annotation, ctx is returned unchanged.
+func ContextFromAnnotations(ctx context.Context, obj metav1.Object) context.Context {
+	traceParent, ok := obj.GetAnnotations()[cmapi.TraceParentAnnotationKey]
+	if !ok {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
+
+// InjectAnnotations returns a copy of annotations with the traceparent
+// annotation set to the span in ctx, so that resources created within the
+// span continue its trace. If the span is not being recorded, annotations is
+// returned unchanged.
+func InjectAnnotations(ctx context.Context, annotations map[string]string) map[string]string {
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return annotations
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	traceParent := carrier.Get("traceparent")
+	if traceParent == "" {
+		return annotations
+	}
+
+	out := make(map[string]string, len(annotations)+1)
+	for k, v := range annotations {
+		out[k] = v
+	}
+	out[cmapi.TraceParentAnnotationKey] = traceParent
+	return out
+}
+
+// NewTransport wraps rt so that every request is recorded as a client span,
+// child of the span in the request context. Trace context is not propagated
+// to the server, as ACME servers, Vault, Venafi and DNS providers are
+// outside of the cluster.
+func NewTransport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(rt,
+		otelhttp.WithPropagators(propagation.NewCompositeTextMapPropagator()),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "HTTP " + r.Method + " " + r.URL.Host
+		}),
+	)
+}
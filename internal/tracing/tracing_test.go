@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// setupInMemory installs a tracer provider that samples every trace and
+// records spans in memory for the duration of the test.
+func setupInMemory(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := NewTracerProvider(Options{SamplingRatePerMillion: 1000000}, sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return exporter
+}
+
+func TestContextForCertificateRevision(t *testing.T) {
+	exporter := setupInMemory(t)
+
+	revision := 1
+	crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{UID: "uid"}, Status: cmapi.CertificateStatus{Revision: &revision}}
+
+	_, trigger := Start(ContextForCertificateRevision(context.Background(), crt), "trigger")
+	trigger.End()
+	_, keymanager := Start(ContextForCertificateRevision(context.Background(), crt), "keymanager")
+	keymanager.End()
+
+	nextRevision := 2
+	crt.Status.Revision = &nextRevision
+	_, next := Start(ContextForCertificateRevision(context.Background(), crt), "next")
+	next.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID(), "spans for the same revision should share a trace")
+	assert.NotEqual(t, spans[0].SpanContext.TraceID(), spans[2].SpanContext.TraceID(), "spans for different revisions should not share a trace")
+}
+
+func TestAnnotationsRoundTrip(t *testing.T) {
+	exporter := setupInMemory(t)
+
+	ctx, parent := Start(context.Background(), "parent")
+	annotations := map[string]string{"foo": "bar"}
+	injected := InjectAnnotations(ctx, annotations)
+	parent.End()
+
+	assert.Equal(t, map[string]string{"foo": "bar"}, annotations, "annotations should not be modified")
+	assert.Contains(t, injected, cmapi.TraceParentAnnotationKey)
+
+	cr := &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{Annotations: injected}}
+	_, child := Start(ContextFromAnnotations(context.Background(), cr), "child")
+	child.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+}
+
+func TestInjectAnnotationsNotRecording(t *testing.T) {
+	annotations := map[string]string{"foo": "bar"}
+	assert.Equal(t, annotations, InjectAnnotations(context.Background(), annotations))
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.SpanContext{})
+	assert.Equal(t, annotations, InjectAnnotations(ctx, annotations))
+}
+
+func TestNewTransport(t *testing.T) {
+	exporter := setupInMemory(t)
+
+	var gotTraceParent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceParent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	ctx, parent := Start(context.Background(), "parent")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: NewTransport(nil)}).Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	parent.End()
+
+	assert.Empty(t, gotTraceParent, "trace context should not be propagated to the server")
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "HTTP GET "+req.URL.Host, spans[0].Name)
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+}
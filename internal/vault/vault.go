@@ -34,6 +34,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -110,6 +111,9 @@ func New(namespace string, createTokenFn func(ns string) CreateToken, secretsLis
 	if err != nil {
 		return nil, fmt.Errorf("error initializing Vault client: %s", err.Error())
 	}
+	// The Vault client expects an *http.Transport while it is initialized, so
+	// only instrument the transport afterwards.
+	cfg.HttpClient.Transport = tracing.NewTransport(cfg.HttpClient.Transport)
 
 	// Set the Vault namespace.
 	// An empty namespace string will cause the client to not send the namespace related HTTP headers to Vault.
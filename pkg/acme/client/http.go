@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
@@ -42,7 +43,8 @@ type Transport struct {
 }
 
 // NewInstrumentedClient takes a *http.Client and returns a *http.Client that
-// has its RoundTripper wrapped with instrumentation.
+// has its RoundTripper wrapped with instrumentation. Requests are recorded
+// as Prometheus metrics and as spans of the trace in the request context.
 func NewInstrumentedClient(metrics *metrics.Metrics, client *http.Client) *http.Client {
 	// If next client is not defined we'll use http.DefaultClient.
 	if client == nil {
@@ -54,7 +56,7 @@ func NewInstrumentedClient(metrics *metrics.Metrics, client *http.Client) *http.
 	}
 
 	client.Transport = &Transport{
-		wrappedRT: client.Transport,
+		wrappedRT: tracing.NewTransport(client.Transport),
 		metrics:   metrics,
 	}
 
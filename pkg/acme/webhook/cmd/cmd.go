@@ -52,3 +52,26 @@ func RunWebhookServer(groupName string, hooks ...webhook.Solver) {
 		util.SetExitCode(err)
 	}
 }
+
+// RunGRPCWebhookServer serves the provided solver implementations over gRPC,
+// secured using mutual TLS. Unlike RunWebhookServer, this does not require
+// registering an APIService with the Kubernetes apiserver. Issuers use these
+// solvers by setting the 'grpc' field of their webhook solver configuration.
+func RunGRPCWebhookServer(hooks ...webhook.Solver) {
+	stopCh, exit := util.SetupExitHandler(util.GracefulShutdown)
+	defer exit() // This function might call os.Exit, so defer last
+
+	logs.InitLogs()
+	defer logs.FlushLogs()
+
+	if len(os.Getenv("GOMAXPROCS")) == 0 {
+		runtime.GOMAXPROCS(runtime.NumCPU())
+	}
+
+	cmd := server.NewCommandStartGRPCWebhookServer(os.Stdout, os.Stderr, stopCh, hooks...)
+	cmd.Flags().AddGoFlagSet(flag.CommandLine)
+	if err := cmd.Execute(); err != nil {
+		logf.Log.Error(err, "error executing command")
+		util.SetExitCode(err)
+	}
+}
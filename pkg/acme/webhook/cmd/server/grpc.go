@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// GRPCWebhookServerOptions configures a server that serves solvers over gRPC,
+// without running an aggregated apiserver.
+type GRPCWebhookServerOptions struct {
+	BindAddress  string
+	Port         int
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+	Kubeconfig   string
+
+	Solvers []webhook.Solver
+
+	StdOut io.Writer
+	StdErr io.Writer
+}
+
+func NewGRPCWebhookServerOptions(out, errOut io.Writer, solvers ...webhook.Solver) *GRPCWebhookServerOptions {
+	return &GRPCWebhookServerOptions{
+		BindAddress: "0.0.0.0",
+		Port:        8443,
+
+		Solvers: solvers,
+
+		StdOut: out,
+		StdErr: errOut,
+	}
+}
+
+func NewCommandStartGRPCWebhookServer(out, errOut io.Writer, stopCh <-chan struct{}, solvers ...webhook.Solver) *cobra.Command {
+	o := NewGRPCWebhookServerOptions(out, errOut, solvers...)
+
+	cmd := &cobra.Command{
+		Short: "Launch an ACME solver gRPC server",
+		Long:  "Launch an ACME solver gRPC server",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			if err := o.RunGRPCWebhookServer(stopCh); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&o.BindAddress, "bind-address", o.BindAddress, "The IP address on which to serve gRPC.")
+	flags.IntVar(&o.Port, "secure-port", o.Port, "The port on which to serve gRPC with mutual TLS.")
+	flags.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "File containing the x509 certificate used to serve gRPC.")
+	flags.StringVar(&o.KeyFile, "tls-private-key-file", o.KeyFile, "File containing the private key matching --tls-cert-file.")
+	flags.StringVar(&o.ClientCAFile, "client-ca-file", o.ClientCAFile, "File containing the CA certificates used to verify client certificates.")
+	flags.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path to a kubeconfig file passed to the solvers. If empty, the in-cluster configuration is used.")
+
+	return cmd
+}
+
+func (o GRPCWebhookServerOptions) Validate(args []string) error {
+	if o.CertFile == "" || o.KeyFile == "" {
+		return fmt.Errorf("--tls-cert-file and --tls-private-key-file must be specified")
+	}
+	if o.ClientCAFile == "" {
+		return fmt.Errorf("--client-ca-file must be specified")
+	}
+	if o.Port <= 0 || o.Port > 65535 {
+		return fmt.Errorf("invalid --secure-port %d", o.Port)
+	}
+	return nil
+}
+
+// RunGRPCWebhookServer initializes the configured solvers and serves them
+// over gRPC until stopCh is closed.
+func (o GRPCWebhookServerOptions) RunGRPCWebhookServer(stopCh <-chan struct{}) error {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", o.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("error loading kubeconfig: %v", err)
+	}
+
+	for _, solver := range o.Solvers {
+		if err := solver.Initialize(restConfig, stopCh); err != nil {
+			return fmt.Errorf("error initializing solver %q: %v", solver.Name(), err)
+		}
+	}
+
+	addr := net.JoinHostPort(o.BindAddress, strconv.Itoa(o.Port))
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(grpc.Creds(webhook.NewGRPCServerCredentials(o.CertFile, o.KeyFile, o.ClientCAFile)))
+	webhook.RegisterGRPCSolvers(server, o.Solvers...)
+
+	go func() {
+		<-stopCh
+		server.GracefulStop()
+	}()
+
+	logf.Log.WithValues("address", addr).Info("serving solvers over gRPC")
+	return server.Serve(l)
+}
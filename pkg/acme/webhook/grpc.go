@@ -0,0 +1,222 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+)
+
+const (
+	// GRPCServiceName is the fully qualified name of the gRPC service
+	// implemented by DNS01 webhook solvers served over gRPC.
+	GRPCServiceName = "acme.cert-manager.io.v1alpha1.Solver"
+
+	// grpcSolveMethod is the full name of the only method of the service,
+	// which presents or cleans up a challenge.
+	grpcSolveMethod = "/" + GRPCServiceName + "/Solve"
+
+	// grpcCodecName is the content-subtype used for messages. Messages are
+	// encoded as JSON so that the existing webhook API types can be used
+	// without generating protobuf bindings for them.
+	grpcCodecName = "json"
+)
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// GRPCRequest is the message sent to the Solve method of a gRPC webhook
+// solver.
+type GRPCRequest struct {
+	// SolverName is the name of the solver that should handle the request,
+	// as returned by its Name method.
+	SolverName string `json:"solverName"`
+
+	// Request is the challenge to present or clean up.
+	Request *whapi.ChallengeRequest `json:"request"`
+}
+
+// jsonCodec implements the gRPC encoding.Codec interface using JSON.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return grpcCodecName
+}
+
+// grpcSolverService is the handler type of the gRPC solver service.
+type grpcSolverService interface {
+	Solve(context.Context, *GRPCRequest) (*whapi.ChallengeResponse, error)
+}
+
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: GRPCServiceName,
+	HandlerType: (*grpcSolverService)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Solve",
+			Handler:    grpcSolveHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func grpcSolveHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GRPCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(grpcSolverService).Solve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: grpcSolveMethod,
+	}
+	return interceptor(ctx, in, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(grpcSolverService).Solve(ctx, req.(*GRPCRequest))
+	})
+}
+
+// grpcSolverServer dispatches requests to the Solver with the requested name.
+type grpcSolverServer struct {
+	solvers map[string]Solver
+}
+
+// RegisterGRPCSolvers registers the gRPC solver service on s, handling
+// requests using the given solvers. This allows Solver implementations
+// served using the aggregated API to also be served over gRPC.
+// Solvers must have been initialized before requests are served.
+func RegisterGRPCSolvers(s grpc.ServiceRegistrar, solvers ...Solver) {
+	srv := &grpcSolverServer{solvers: make(map[string]Solver)}
+	for _, solver := range solvers {
+		srv.solvers[solver.Name()] = solver
+	}
+	s.RegisterService(&grpcServiceDesc, srv)
+}
+
+// Solve calls the appropriate method of the requested solver.
+// As with the aggregated API, errors returned by the solver are reported in
+// the response rather than as a gRPC error.
+func (s *grpcSolverServer) Solve(_ context.Context, req *GRPCRequest) (*whapi.ChallengeResponse, error) {
+	solver, ok := s.solvers[req.SolverName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown solver %q", req.SolverName)
+	}
+	if req.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "request field cannot be empty")
+	}
+
+	var fn func(*whapi.ChallengeRequest) error
+	switch req.Request.Action {
+	case whapi.ChallengeActionPresent:
+		fn = solver.Present
+	case whapi.ChallengeActionCleanUp:
+		fn = solver.CleanUp
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown action type %q", req.Request.Action)
+	}
+
+	if err := fn(req.Request); err != nil {
+		return &whapi.ChallengeResponse{
+			UID: req.Request.UID,
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	return &whapi.ChallengeResponse{
+		UID:     req.Request.UID,
+		Success: true,
+	}, nil
+}
+
+// GRPCClient calls DNS01 webhook solvers served over gRPC.
+type GRPCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewGRPCClient returns a client calling the solver service using cc.
+func NewGRPCClient(cc grpc.ClientConnInterface) *GRPCClient {
+	return &GRPCClient{cc: cc}
+}
+
+// Solve sends req to the solver service. An error is only returned if the
+// call itself fails; failures to present or clean up the challenge are
+// reported in the response.
+func (c *GRPCClient) Solve(ctx context.Context, req *GRPCRequest, opts ...grpc.CallOption) (*whapi.ChallengeResponse, error) {
+	out := new(whapi.ChallengeResponse)
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(grpcCodecName)}, opts...)
+	if err := c.cc.Invoke(ctx, grpcSolveMethod, req, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NewGRPCServerCredentials returns transport credentials for a gRPC solver
+// server using the certificate and private key in certFile and keyFile, and
+// requiring clients to present a certificate signed by a CA in clientCAFile.
+// The files are read for every new connection so that renewed certificates,
+// such as those in a Secret managed by cert-manager, are used without
+// restarting the server.
+func NewGRPCServerCredentials(certFile, keyFile, clientCAFile string) credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return nil, fmt.Errorf("error loading serving certificate: %v", err)
+			}
+			caPEM, err := os.ReadFile(clientCAFile)
+			if err != nil {
+				return nil, fmt.Errorf("error loading client CA: %v", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caPEM) {
+				return nil, fmt.Errorf("no certificates found in client CA file %q", clientCAFile)
+			}
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{cert},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    pool,
+			}, nil
+		},
+	})
+}
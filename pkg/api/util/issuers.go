@@ -61,3 +61,12 @@ func IssuerKind(ref cmmeta.ObjectReference) string {
 	}
 	return ref.Kind
 }
+
+// IssuerNamespace returns the namespace of the Issuer referenced by ref from a
+// resource in the given namespace.
+func IssuerNamespace(ref cmmeta.ObjectReference, namespace string) string {
+	if ref.Namespace != "" {
+		return ref.Namespace
+	}
+	return namespace
+}
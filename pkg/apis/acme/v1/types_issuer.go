@@ -225,6 +225,42 @@ type ACMEChallengeSolverHTTP01 struct {
 	// This solver is experimental, and fields / behaviour may change in the future.
 	// +optional
 	GatewayHTTPRoute *ACMEChallengeSolverHTTP01GatewayHTTPRoute `json:"gatewayHTTPRoute,omitempty"`
+
+	// The shared solver routes HTTP01 challenge requests to a long-running
+	// acmesolver Deployment that serves the keys for every pending Challenge,
+	// instead of provisioning a solver Pod and Service for each Challenge.
+	// It may be combined with `ingress` or `gatewayHTTPRoute` to have
+	// cert-manager create routes to the shared solver Service, or used alone
+	// with `catchAllRoute` if a route for '/.well-known/acme-challenge/'
+	// already exists.
+	// +optional
+	SharedSolver *ACMEChallengeSolverHTTP01SharedSolver `json:"sharedSolver,omitempty"`
+}
+
+// ACMEChallengeSolverHTTP01SharedSolver configures HTTP01 challenges to be
+// solved by a shared, long-running acmesolver Deployment.
+// The acmesolver must be started with the `shared` subcommand, which watches
+// Challenge resources and responds to any pending HTTP01 challenge that uses a
+// shared solver.
+type ACMEChallengeSolverHTTP01SharedSolver struct {
+	// The name of the Service that fronts the shared acmesolver Deployment.
+	// The Service must exist in the same namespace as the Challenge resources,
+	// as Ingress and HTTPRoute backends cannot reference other namespaces.
+	// Required unless `catchAllRoute` is true.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// The port of the shared acmesolver Service that challenge requests should
+	// be routed to. If unset, defaults to 8089.
+	// +optional
+	ServicePort int32 `json:"servicePort,omitempty"`
+
+	// If true, cert-manager will not create any Ingress or HTTPRoute rules for
+	// challenges using this solver, as a catch-all route for
+	// '/.well-known/acme-challenge/' already directs traffic to the shared
+	// acmesolver.
+	// +optional
+	CatchAllRoute bool `json:"catchAllRoute,omitempty"`
 }
 
 type ACMEChallengeSolverHTTP01Ingress struct {
@@ -397,11 +433,23 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	AcmeDNS *ACMEIssuerDNS01ProviderAcmeDNS `json:"acmeDNS,omitempty"`
 
+	// Use the PowerDNS authoritative server HTTP API
+	// (https://doc.powerdns.com/authoritative/http-api/) to manage DNS01
+	// challenge records.
+	// +optional
+	PowerDNS *ACMEIssuerDNS01ProviderPowerDNS `json:"powerDNS,omitempty"`
+
 	// Use RFC2136 ("Dynamic Updates in the Domain Name System") (https://datatracker.ietf.org/doc/rfc2136/)
 	// to manage DNS01 challenge records.
 	// +optional
 	RFC2136 *ACMEIssuerDNS01ProviderRFC2136 `json:"rfc2136,omitempty"`
 
+	// Use external-dns (https://github.com/kubernetes-sigs/external-dns) to
+	// manage DNS01 challenge records by creating DNSEndpoint resources.
+	// external-dns must be configured with the 'crd' source.
+	// +optional
+	ExternalDNS *ACMEIssuerDNS01ProviderExternalDNS `json:"externalDNS,omitempty"`
+
 	// Configure an external webhook based DNS01 challenge solver to manage
 	// DNS01 challenge records.
 	// +optional
@@ -577,6 +625,23 @@ type ACMEIssuerDNS01ProviderAcmeDNS struct {
 	AccountSecret cmmeta.SecretKeySelector `json:"accountSecretRef"`
 }
 
+// ACMEIssuerDNS01ProviderPowerDNS is a structure containing the
+// configuration for the PowerDNS authoritative server HTTP API
+type ACMEIssuerDNS01ProviderPowerDNS struct {
+	// The URL of the PowerDNS API, e.g. https://pdns.example.com:8081.
+	// The '/api/v1' path prefix is added automatically.
+	Host string `json:"host"`
+
+	// A reference to a specific 'key' within a Secret resource that
+	// contains the PowerDNS API key.
+	APIKey cmmeta.SecretKeySelector `json:"apiKeySecretRef"`
+
+	// The ID of the PowerDNS server to manage zones on.
+	// Defaults to 'localhost'.
+	// +optional
+	ServerID string `json:"serverID,omitempty"`
+}
+
 // ACMEIssuerDNS01ProviderRFC2136 is a structure containing the
 // configuration for RFC2136 DNS
 type ACMEIssuerDNS01ProviderRFC2136 struct {
@@ -602,6 +667,66 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// ``HMACSHA1``, ``HMACSHA256`` or ``HMACSHA512``.
 	// +optional
 	TSIGAlgorithm string `json:"tsigAlgorithm,omitempty"`
+
+	// Use GSS-TSIG (RFC 3645) to authenticate dynamic updates with Kerberos,
+	// as required by Active Directory integrated zones that only accept
+	// secure dynamic updates. When set, ``nameserver`` should be the hostname
+	// of the DNS server, as it is used to build the ``DNS/<hostname>`` service
+	// principal name.
+	// Mutually exclusive with ``tsigKeyName``, ``tsigSecretSecretRef`` and
+	// ``tsigAlgorithm``.
+	// +optional
+	GSSTSIG *ACMEIssuerDNS01ProviderRFC2136GSSTSIG `json:"gssTSIG,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderRFC2136GSSTSIG is a structure containing the
+// Kerberos credentials used to authenticate RFC2136 dynamic updates using
+// GSS-TSIG.
+type ACMEIssuerDNS01ProviderRFC2136GSSTSIG struct {
+	// The Kerberos realm of the principal, e.g. ``EXAMPLE.COM``.
+	Realm string `json:"realm"`
+
+	// The name of the Kerberos principal used to authenticate, without the
+	// realm.
+	Username string `json:"username"`
+
+	// A reference to a key in a Secret resource containing the password of
+	// the principal.
+	// Exactly one of ``passwordSecretRef`` or ``keytabSecretRef`` must be
+	// specified.
+	// +optional
+	PasswordSecretRef *cmmeta.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// A reference to a key in a Secret resource containing a keytab with the
+	// keys of the principal.
+	// Exactly one of ``passwordSecretRef`` or ``keytabSecretRef`` must be
+	// specified.
+	// +optional
+	KeytabSecretRef *cmmeta.SecretKeySelector `json:"keytabSecretRef,omitempty"`
+
+	// The Key Distribution Centers of the realm, in the form host:port.
+	// If not specified, KDCs are discovered using DNS SRV records.
+	// +optional
+	KDCs []string `json:"kdcs,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderExternalDNS is a structure containing the
+// configuration for publishing DNS01 challenge records using external-dns
+// DNSEndpoint resources.
+type ACMEIssuerDNS01ProviderExternalDNS struct {
+	// Labels to add to the DNSEndpoint resources created by cert-manager.
+	// This can be used to match the label filter configured on external-dns.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations to add to the DNSEndpoint resources created by cert-manager.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// The TTL, in seconds, of the TXT records published by external-dns.
+	// Defaults to 60.
+	// +optional
+	RecordTTL *int64 `json:"recordTTL,omitempty"`
 }
 
 // ACMEIssuerDNS01ProviderWebhook specifies configuration for a webhook DNS01
@@ -611,7 +736,9 @@ type ACMEIssuerDNS01ProviderWebhook struct {
 	// resources to the webhook apiserver.
 	// This should be the same as the GroupName specified in the webhook
 	// provider implementation.
-	GroupName string `json:"groupName"`
+	// Required unless grpc is specified.
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
 
 	// The name of the solver to use, as defined in the webhook provider
 	// implementation.
@@ -628,6 +755,35 @@ type ACMEIssuerDNS01ProviderWebhook struct {
 	// implementation's documentation.
 	// +optional
 	Config *apiextensionsv1.JSON `json:"config,omitempty"`
+
+	// GRPC configures the webhook to be called over gRPC, using the solver
+	// service provided by the webhook SDK, rather than by POSTing
+	// ChallengePayload resources to the Kubernetes aggregated API.
+	// If set, groupName is not used.
+	// +optional
+	GRPC *ACMEIssuerDNS01ProviderWebhookGRPC `json:"grpc,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderWebhookGRPC configures how to connect to a DNS01
+// webhook solver served over gRPC.
+// Connections are authenticated using mutual TLS.
+type ACMEIssuerDNS01ProviderWebhookGRPC struct {
+	// The name of the Service exposing the webhook's gRPC endpoint.
+	// The Service must be in the same namespace as the Issuer, or in the
+	// cluster resource namespace for ClusterIssuers.
+	ServiceName string `json:"serviceName"`
+
+	// The port of the Service serving the gRPC endpoint.
+	Port int32 `json:"port"`
+
+	// A reference to a Secret in the same namespace as the Service,
+	// containing the client certificate and private key presented to the
+	// webhook (tls.crt and tls.key) and the CA certificate used to verify
+	// the webhook's serving certificate (ca.crt), such as a Secret created
+	// by a cert-manager Certificate.
+	// The webhook's serving certificate must be valid for the DNS name
+	// <serviceName>.<namespace>.svc.
+	TLSSecretRef cmmeta.LocalObjectReference `json:"tlsSecretRef"`
 }
 
 type ACMEIssuerStatus struct {
@@ -126,6 +126,42 @@ const (
 	// Annotation key used to set the PrivateKeyRotationPolicy for a Certificate.
 	// If unset a policy `Never` will be used.
 	PrivateKeyRotationPolicyAnnotationKey = "cert-manager.io/private-key-rotation-policy"
+
+	// Annotation key used to suspend renewal and reissuance of a Certificate.
+	// Must be a boolean; if unset the Certificate is not suspended.
+	SuspendAnnotationKey = "cert-manager.io/suspend"
+
+	// Annotation key used to propagate trace context between the resources
+	// created while issuing a Certificate. The value is a W3C traceparent.
+	// It is set on CertificateRequests, Orders and Challenges by cert-manager
+	// when tracing is enabled.
+	TraceParentAnnotationKey = "cert-manager.io/traceparent"
+
+	// Annotation key set on Certificates by the notifications controller to
+	// record the NotificationPolicies that have been sent a NearingExpiry
+	// event for the current certificate. The value is a JSON object mapping
+	// policy names to the notAfter time of the certificate.
+	NearingExpiryNotifiedAnnotationKey = "cert-manager.io/nearing-expiry-notified"
+
+	// Label key used to opt ConfigMaps in to having the expiry of the
+	// certificates they contain monitored by the expiry-monitor controller.
+	// Must be set to "true".
+	MonitorExpiryLabelKey = "cert-manager.io/monitor-expiry"
+)
+
+const (
+	// Annotation keys that can be set on a Namespace to default the
+	// corresponding fields of the Certificates in that namespace. The
+	// defaults are applied by the webhook when a Certificate is created and
+	// by the ingress-shim, and never override a field that is already set.
+	DefaultIssuerNameAnnotationKey               = "cert-manager.io/default-issuer-name"
+	DefaultIssuerKindAnnotationKey               = "cert-manager.io/default-issuer-kind"
+	DefaultIssuerGroupAnnotationKey              = "cert-manager.io/default-issuer-group"
+	DefaultDurationAnnotationKey                 = "cert-manager.io/default-duration"
+	DefaultRenewBeforeAnnotationKey              = "cert-manager.io/default-renew-before"
+	DefaultPrivateKeyAlgorithmAnnotationKey      = "cert-manager.io/default-private-key-algorithm"
+	DefaultPrivateKeySizeAnnotationKey           = "cert-manager.io/default-private-key-size"
+	DefaultPrivateKeyRotationPolicyAnnotationKey = "cert-manager.io/default-private-key-rotation-policy"
 )
 
 const (
@@ -193,6 +229,13 @@ const (
 	// as namespace/name.
 	WantInjectFromSecretAnnotation = "cert-manager.io/inject-ca-from-secret"
 
+	// WantInjectKeyAnnotation is the annotation that specifies the key of a
+	// ConfigMap or Secret that CA data is injected into. If not set, the CA
+	// data is injected into the `ca.crt` key. ConfigMaps and Secrets may only
+	// be injected with CA data from Certificates and Secrets in their own
+	// namespace.
+	WantInjectKeyAnnotation = "cert-manager.io/inject-ca-key"
+
 	// AllowsInjectionFromSecretAnnotation is an annotation that must be added
 	// to Secret resource that want to denote that they can be directly
 	// injected into injectables that have a `inject-ca-from-secret` annotation.
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+
+// A NotificationPolicy configures which cert-manager events are sent as
+// CloudEvents to external HTTP sinks, such as a bridge to Slack or PagerDuty.
+// It is cluster-scoped and may select Certificates and Issuers in any
+// namespace.
+type NotificationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the NotificationPolicy resource.
+	Spec NotificationPolicySpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationPolicyList is a list of NotificationPolicies
+type NotificationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []NotificationPolicy `json:"items"`
+}
+
+// NotificationPolicySpec defines which events are sent, for which resources
+// and to which sinks.
+type NotificationPolicySpec struct {
+	// Events is the list of event types to send.
+	// +kubebuilder:validation:MinItems=1
+	Events []NotificationEventType `json:"events"`
+
+	// NamespaceSelector restricts the policy to Certificates and Issuers in
+	// namespaces whose labels match the selector. ClusterIssuers are not
+	// namespaced and always match. If unset, resources in all namespaces
+	// match.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// IssuerRefs restricts the policy to Certificates issued by, and events
+	// about, the referenced issuers. An empty `kind` refers to an Issuer. If
+	// unset, all issuers match.
+	// +optional
+	IssuerRefs []cmmeta.ObjectReference `json:"issuerRefs,omitempty"`
+
+	// NearingExpiryThreshold is how long before a certificate expires a
+	// `NearingExpiry` event is sent. Defaults to 14 days (`336h`).
+	// +optional
+	NearingExpiryThreshold *metav1.Duration `json:"nearingExpiryThreshold,omitempty"`
+
+	// Sinks to which every matching event is sent.
+	// +kubebuilder:validation:MinItems=1
+	Sinks []NotificationSink `json:"sinks"`
+
+	// Retry configures how failed deliveries to sinks are retried.
+	// +optional
+	Retry *NotificationRetryPolicy `json:"retry,omitempty"`
+}
+
+// NotificationEventType is a type of event that can be sent by a
+// NotificationPolicy.
+// +kubebuilder:validation:Enum=Issued;Failed;NearingExpiry;IssuerNotReady
+type NotificationEventType string
+
+const (
+	// NotificationEventIssued is sent when a new certificate has been issued
+	// for a Certificate.
+	NotificationEventIssued NotificationEventType = "Issued"
+
+	// NotificationEventFailed is sent when an issuance attempt for a
+	// Certificate has failed.
+	NotificationEventFailed NotificationEventType = "Failed"
+
+	// NotificationEventNearingExpiry is sent once per certificate when it is
+	// within the policy's nearingExpiryThreshold of its expiry.
+	NotificationEventNearingExpiry NotificationEventType = "NearingExpiry"
+
+	// NotificationEventIssuerNotReady is sent when an Issuer or ClusterIssuer
+	// stops being Ready.
+	NotificationEventIssuerNotReady NotificationEventType = "IssuerNotReady"
+)
+
+// NotificationSink is an HTTP endpoint that receives CloudEvents.
+type NotificationSink struct {
+	// Name of the sink, used to identify it in logs and metrics.
+	Name string `json:"name"`
+
+	// URL to which events are sent as CloudEvents in structured content
+	// mode, using HTTP POST.
+	URL string `json:"url"`
+
+	// CABundle is a PEM encoded bundle of CA certificates used to verify
+	// the sink's serving certificate. If unset, the system trust store is
+	// used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// NotificationRetryPolicy configures how failed deliveries are retried.
+// Events that still cannot be delivered after all attempts are dropped and
+// counted by the `certmanager_notification_dead_letter_count` metric.
+type NotificationRetryPolicy struct {
+	// MaxAttempts is the maximum number of delivery attempts per event and
+	// sink, including the first one. Defaults to 5.
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+
+	// Backoff is the delay before the first retry. It doubles with every
+	// subsequent retry. Defaults to 1s.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
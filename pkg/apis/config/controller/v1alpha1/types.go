@@ -0,0 +1,263 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ControllerConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// apiServerHost is used to override the API server connection address.
+	// Deprecated: use `kubeConfig` instead.
+	APIServerHost string `json:"apiServerHost,omitempty"`
+
+	// kubeConfig is the kubeconfig file used to connect to the Kubernetes apiserver.
+	// If not specified, the controller will attempt to load the in-cluster-config.
+	KubeConfig string `json:"kubeConfig,omitempty"`
+
+	// kubernetesAPIQPS is the maximum queries-per-second of requests sent to
+	// the Kubernetes apiserver.
+	// Defaults to 20.
+	KubernetesAPIQPS *float32 `json:"kubernetesAPIQPS,omitempty"`
+
+	// kubernetesAPIBurst is the maximum burst queries-per-second of requests
+	// sent to the Kubernetes apiserver.
+	// Defaults to 50.
+	KubernetesAPIBurst *int `json:"kubernetesAPIBurst,omitempty"`
+
+	// clusterResourceNamespace is the namespace to store resources owned by
+	// cluster scoped resources such as ClusterIssuer in.
+	// Defaults to 'kube-system'.
+	ClusterResourceNamespace string `json:"clusterResourceNamespace,omitempty"`
+
+	// namespace limits the scope of cert-manager to a single namespace and
+	// disables ClusterIssuers. If not specified, all namespaces will be watched.
+	Namespace string `json:"namespace,omitempty"`
+
+	// leaderElectionConfig configures the behaviour of leader election.
+	LeaderElectionConfig LeaderElectionConfig `json:"leaderElectionConfig"`
+
+	// shardingConfig configures horizontal sharding of controllers across
+	// replicas.
+	ShardingConfig ShardingConfig `json:"shardingConfig"`
+
+	// tracingConfig configures the export of OpenTelemetry traces of the
+	// issuance pipeline.
+	TracingConfig TracingConfig `json:"tracingConfig"`
+
+	// controllers is the list of controllers to enable. '*' enables all
+	// on-by-default controllers, 'foo' enables the controller named 'foo' and
+	// '-foo' disables the controller named 'foo'.
+	// Defaults to ['*'].
+	Controllers []string `json:"controllers,omitempty"`
+
+	// issuerAmbientCredentials controls whether an Issuer may make use of
+	// ambient credentials drawn from the environment, metadata services or
+	// local files which are not explicitly configured in the Issuer.
+	IssuerAmbientCredentials bool `json:"issuerAmbientCredentials"`
+
+	// clusterIssuerAmbientCredentials controls whether a ClusterIssuer may make
+	// use of ambient credentials.
+	// Defaults to true.
+	ClusterIssuerAmbientCredentials *bool `json:"clusterIssuerAmbientCredentials,omitempty"`
+
+	// enableCertificateOwnerRef controls whether the Certificate resource is
+	// set as an owner of the Secret where the certificate is stored.
+	EnableCertificateOwnerRef bool `json:"enableCertificateOwnerRef"`
+
+	// copiedAnnotationPrefixes is a list of annotation key prefixes that
+	// control which annotations are copied from Certificate to
+	// CertificateRequest and Order. A prefix starting with a dash (-)
+	// excludes matching annotations.
+	CopiedAnnotationPrefixes []string `json:"copiedAnnotationPrefixes,omitempty"`
+
+	// workers configures the number of concurrent workers used by each
+	// controller.
+	Workers WorkersConfig `json:"workers"`
+
+	// maxConcurrentChallenges is the maximum number of challenges that can be
+	// scheduled as 'processing' at once.
+	// Defaults to 60.
+	MaxConcurrentChallenges *int `json:"maxConcurrentChallenges,omitempty"`
+
+	// metricsListenAddress is the host and port that the metrics endpoint
+	// should listen on.
+	// Defaults to '0.0.0.0:9402'.
+	MetricsListenAddress string `json:"metricsListenAddress,omitempty"`
+
+	// enablePprof configures whether pprof is enabled.
+	EnablePprof bool `json:"enablePprof"`
+
+	// pprofAddress configures the address on which /debug/pprof endpoint will be served if enabled.
+	// Defaults to 'localhost:6060'.
+	PprofAddress string `json:"pprofAddress,omitempty"`
+
+	// featureGates is a map of feature names to bools that enable or disable experimental
+	// features.
+	// Default: nil
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// ingressShimConfig configures the ingress-shim and gateway-shim controllers.
+	IngressShimConfig IngressShimConfig `json:"ingressShimConfig"`
+
+	// acmeHTTP01Config configures the ACME HTTP01 challenge solver.
+	ACMEHTTP01Config ACMEHTTP01Config `json:"acmeHTTP01Config"`
+
+	// acmeDNS01Config configures the ACME DNS01 challenge solver.
+	ACMEDNS01Config ACMEDNS01Config `json:"acmeDNS01Config"`
+}
+
+type LeaderElectionConfig struct {
+	// enabled controls whether leader election is performed between
+	// instances to ensure no more than one instance operates at a time.
+	// Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// namespace is the namespace used to perform leader election.
+	// Defaults to 'kube-system'.
+	Namespace string `json:"namespace,omitempty"`
+
+	// leaseDuration is the duration that non-leader candidates will wait
+	// after observing a leadership renewal before attempting to acquire
+	// leadership.
+	// Defaults to 60s.
+	LeaseDuration metav1.Duration `json:"leaseDuration,omitempty"`
+
+	// renewDeadline is the interval between attempts by the acting leader
+	// to renew a leadership slot before it stops leading.
+	// Defaults to 40s.
+	RenewDeadline metav1.Duration `json:"renewDeadline,omitempty"`
+
+	// retryPeriod is the duration clients should wait between attempting
+	// acquisition and renewal of leadership.
+	// Defaults to 15s.
+	RetryPeriod metav1.Duration `json:"retryPeriod,omitempty"`
+}
+
+type ShardingConfig struct {
+	// enabled runs all replicas concurrently instead of electing a leader.
+	// Each replica holds a Lease in the leader election namespace and only
+	// reconciles the resources whose namespace/name hash is assigned to it.
+	// Keys are reassigned when replicas join or leave. Issuers and
+	// ClusterIssuers are reconciled by every replica.
+	Enabled bool `json:"enabled"`
+
+	// leaseDuration is the duration after which a replica that has not
+	// renewed its shard Lease is considered gone, and its resources are
+	// reassigned to the remaining replicas. It is also the handover period
+	// after which a replica starts reconciling the resources newly assigned
+	// to it, so that their previous owner has stopped reconciling them.
+	// Defaults to 30s.
+	LeaseDuration metav1.Duration `json:"leaseDuration,omitempty"`
+}
+
+type TracingConfig struct {
+	// endpoint is the host:port of an OTLP gRPC collector to which traces
+	// are exported. Tracing is disabled if not specified.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// insecure disables TLS when connecting to the collector.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// samplingRatePerMillion is the number of Certificate issuances out of
+	// every million that are traced.
+	// Defaults to 1000000, tracing every issuance.
+	SamplingRatePerMillion *int32 `json:"samplingRatePerMillion,omitempty"`
+}
+
+type WorkersConfig struct {
+	// default is the number of concurrent workers used by controllers which
+	// are not listed in `controllers`.
+	// Defaults to 5.
+	Default *int `json:"default,omitempty"`
+
+	// controllers maps controller names to the number of concurrent workers
+	// used by that controller, overriding `default`.
+	// +optional
+	Controllers map[string]int `json:"controllers,omitempty"`
+}
+
+type IngressShimConfig struct {
+	// defaultIssuerName is the name of the Issuer to use when the tls is
+	// requested but issuer name is not specified on the ingress resource.
+	DefaultIssuerName string `json:"defaultIssuerName,omitempty"`
+
+	// defaultIssuerKind is the kind of the Issuer to use when the tls is
+	// requested but issuer kind is not specified on the ingress resource.
+	// Defaults to 'Issuer'.
+	DefaultIssuerKind string `json:"defaultIssuerKind,omitempty"`
+
+	// defaultIssuerGroup is the group of the Issuer to use when the tls is
+	// requested but issuer group is not specified on the ingress resource.
+	// Defaults to 'cert-manager.io'.
+	DefaultIssuerGroup string `json:"defaultIssuerGroup,omitempty"`
+
+	// defaultAutoCertificateAnnotations is the list of annotations that
+	// indicate an ingress is requesting a certificate.
+	// Defaults to ['kubernetes.io/tls-acme'].
+	DefaultAutoCertificateAnnotations []string `json:"defaultAutoCertificateAnnotations,omitempty"`
+}
+
+type ACMEHTTP01Config struct {
+	// solverImage is the image used to solve ACME HTTP01 challenges.
+	SolverImage string `json:"solverImage,omitempty"`
+
+	// solverResourceRequestCPU is the CPU request of ACME HTTP01 solver pods.
+	// Defaults to '10m'.
+	SolverResourceRequestCPU string `json:"solverResourceRequestCPU,omitempty"`
+
+	// solverResourceRequestMemory is the memory request of ACME HTTP01 solver pods.
+	// Defaults to '64Mi'.
+	SolverResourceRequestMemory string `json:"solverResourceRequestMemory,omitempty"`
+
+	// solverResourceLimitsCPU is the CPU limit of ACME HTTP01 solver pods.
+	// Defaults to '100m'.
+	SolverResourceLimitsCPU string `json:"solverResourceLimitsCPU,omitempty"`
+
+	// solverResourceLimitsMemory is the memory limit of ACME HTTP01 solver pods.
+	// Defaults to '64Mi'.
+	SolverResourceLimitsMemory string `json:"solverResourceLimitsMemory,omitempty"`
+
+	// solverRunAsNonRoot controls whether ACME HTTP01 solver pods run as a
+	// non-root user.
+	// Defaults to true.
+	SolverRunAsNonRoot *bool `json:"solverRunAsNonRoot,omitempty"`
+
+	// solverNameservers is a list of DNS server endpoints used for ACME
+	// HTTP01 check requests, for example ['8.8.8.8:53', '8.8.4.4:53'].
+	SolverNameservers []string `json:"solverNameservers,omitempty"`
+}
+
+type ACMEDNS01Config struct {
+	// recursiveNameservers is a list of DNS server endpoints used for DNS01
+	// check requests. Plain DNS servers are given as host:port, DNS-over-HTTPS
+	// resolvers as https:// URLs and DNS-over-TLS resolvers as tls://host[:port].
+	RecursiveNameservers []string `json:"recursiveNameservers,omitempty"`
+
+	// recursiveNameserversOnly controls whether only the configured recursive
+	// nameservers are queried to perform the DNS01 self check, instead of the
+	// authoritative nameservers.
+	RecursiveNameserversOnly bool `json:"recursiveNameserversOnly"`
+
+	// checkRetryPeriod is the duration the controller waits between
+	// propagation checks for both DNS01 and HTTP01 challenges.
+	// Defaults to 10s.
+	CheckRetryPeriod metav1.Duration `json:"checkRetryPeriod,omitempty"`
+}
@@ -48,7 +48,8 @@ type LocalObjectReference struct {
 	Name string `json:"name"`
 }
 
-// ObjectReference is a reference to an object with a given name, kind and group.
+// ObjectReference is a reference to an object with a given name, kind, group
+// and optionally namespace.
 type ObjectReference struct {
 	// Name of the resource being referred to.
 	Name string `json:"name"`
@@ -58,6 +59,14 @@ type ObjectReference struct {
 	// Group of the resource being referred to.
 	// +optional
 	Group string `json:"group,omitempty"`
+	// Namespace of the resource being referred to.
+	// May only be set when referencing an Issuer in another namespace, in
+	// which case a Gateway API ReferenceGrant in that namespace must permit
+	// CertificateRequests in the namespace of the referring resource to
+	// reference the Issuer. Certificates require the same grant, as they are
+	// issued through CertificateRequests.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // A reference to a specific 'key' within a Secret resource.
@@ -30,8 +30,10 @@ type CertmanagerV1Interface interface {
 	RESTClient() rest.Interface
 	CertificatesGetter
 	CertificateRequestsGetter
+	CertificateRequestPoliciesGetter
 	ClusterIssuersGetter
 	IssuersGetter
+	NotificationPoliciesGetter
 }
 
 // CertmanagerV1Client is used to interact with features provided by the cert-manager.io group.
@@ -47,6 +49,10 @@ func (c *CertmanagerV1Client) CertificateRequests(namespace string) CertificateR
 	return newCertificateRequests(c, namespace)
 }
 
+func (c *CertmanagerV1Client) CertificateRequestPolicies() CertificateRequestPolicyInterface {
+	return newCertificateRequestPolicies(c)
+}
+
 func (c *CertmanagerV1Client) ClusterIssuers() ClusterIssuerInterface {
 	return newClusterIssuers(c)
 }
@@ -55,6 +61,10 @@ func (c *CertmanagerV1Client) Issuers(namespace string) IssuerInterface {
 	return newIssuers(c, namespace)
 }
 
+func (c *CertmanagerV1Client) NotificationPolicies() NotificationPolicyInterface {
+	return newNotificationPolicies(c)
+}
+
 // NewForConfig creates a new CertmanagerV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -36,6 +36,10 @@ func (c *FakeCertmanagerV1) CertificateRequests(namespace string) v1.Certificate
 	return &FakeCertificateRequests{c, namespace}
 }
 
+func (c *FakeCertmanagerV1) CertificateRequestPolicies() v1.CertificateRequestPolicyInterface {
+	return &FakeCertificateRequestPolicies{c}
+}
+
 func (c *FakeCertmanagerV1) ClusterIssuers() v1.ClusterIssuerInterface {
 	return &FakeClusterIssuers{c}
 }
@@ -44,6 +48,10 @@ func (c *FakeCertmanagerV1) Issuers(namespace string) v1.IssuerInterface {
 	return &FakeIssuers{c, namespace}
 }
 
+func (c *FakeCertmanagerV1) NotificationPolicies() v1.NotificationPolicyInterface {
+	return &FakeNotificationPolicies{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCertmanagerV1) RESTClient() rest.Interface {
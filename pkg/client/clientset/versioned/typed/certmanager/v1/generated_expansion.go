@@ -22,6 +22,10 @@ type CertificateExpansion interface{}
 
 type CertificateRequestExpansion interface{}
 
+type CertificateRequestPolicyExpansion interface{}
+
 type ClusterIssuerExpansion interface{}
 
 type IssuerExpansion interface{}
+
+type NotificationPolicyExpansion interface{}
@@ -28,10 +28,14 @@ type Interface interface {
 	Certificates() CertificateInformer
 	// CertificateRequests returns a CertificateRequestInformer.
 	CertificateRequests() CertificateRequestInformer
+	// CertificateRequestPolicies returns a CertificateRequestPolicyInformer.
+	CertificateRequestPolicies() CertificateRequestPolicyInformer
 	// ClusterIssuers returns a ClusterIssuerInformer.
 	ClusterIssuers() ClusterIssuerInformer
 	// Issuers returns a IssuerInformer.
 	Issuers() IssuerInformer
+	// NotificationPolicies returns a NotificationPolicyInformer.
+	NotificationPolicies() NotificationPolicyInformer
 }
 
 type version struct {
@@ -55,6 +59,11 @@ func (v *version) CertificateRequests() CertificateRequestInformer {
 	return &certificateRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// CertificateRequestPolicies returns a CertificateRequestPolicyInformer.
+func (v *version) CertificateRequestPolicies() CertificateRequestPolicyInformer {
+	return &certificateRequestPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterIssuers returns a ClusterIssuerInformer.
 func (v *version) ClusterIssuers() ClusterIssuerInformer {
 	return &clusterIssuerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -64,3 +73,8 @@ func (v *version) ClusterIssuers() ClusterIssuerInformer {
 func (v *version) Issuers() IssuerInformer {
 	return &issuerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// NotificationPolicies returns a NotificationPolicyInformer.
+func (v *version) NotificationPolicies() NotificationPolicyInformer {
+	return &notificationPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -18,6 +18,7 @@ package acmechallenges
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -26,9 +27,12 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	gwlisters "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -127,10 +131,33 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 		c.clusterIssuerLister = clusterIssuerInformer.Lister()
 	}
 
+	// ReferenceGrants may permit references to Issuers in other namespaces.
+	var referenceGrantLister gwlisters.ReferenceGrantLister
+	if ctx.GatewaySolverEnabled {
+		referenceGrantInformer := ctx.GWShared.Gateway().V1beta1().ReferenceGrants()
+		mustSync = append(mustSync, referenceGrantInformer.Informer().HasSynced)
+		referenceGrantLister = referenceGrantInformer.Lister()
+
+		// requeue the Challenges referencing an Issuer in the namespace of a
+		// ReferenceGrant, as it may permit the reference
+		if err := controllerpkg.AddCrossNamespaceIssuerIndex(challengeInformer.Informer(), func(obj interface{}) (cmmeta.ObjectReference, bool) {
+			ch, ok := obj.(*cmacme.Challenge)
+			if !ok {
+				return cmmeta.ObjectReference{}, false
+			}
+			return ch.Spec.IssuerRef, true
+		}); err != nil {
+			return nil, nil, fmt.Errorf("failed to add Challenge indexer: %w", err)
+		}
+		referenceGrantInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: controllerpkg.HandleReferenceGrantFunc(c.log, c.queue, challengeInformer.Informer().GetIndexer()),
+		})
+	}
+
 	// register handler functions
 	challengeInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
 
-	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister)
+	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister, referenceGrantLister)
 	c.scheduler = scheduler.New(logf.NewContext(ctx.RootContext, c.log), c.challengeLister, ctx.SchedulerOptions.MaxConcurrentChallenges)
 	c.recorder = ctx.Recorder
 	c.accountRegistry = ctx.ACMEOptions.AccountRegistry
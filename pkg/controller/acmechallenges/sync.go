@@ -20,12 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	"github.com/cert-manager/cert-manager/pkg/acme"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -42,6 +44,7 @@ const (
 	reasonPresentError   = "PresentError"
 	reasonPresented      = "Presented"
 	reasonFailed         = "Failed"
+	reasonCAACheckFailed = "CAACheckFailed"
 )
 
 // solver solves ACME challenges by presenting the given token and key in an
@@ -62,6 +65,8 @@ type solver interface {
 func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (err error) {
 	log := logf.FromContext(ctx).WithValues("dnsName", chOriginal.Spec.DNSName, "type", chOriginal.Spec.Type)
 	ctx = logf.NewContext(ctx, log)
+	ctx, span := tracing.Start(tracing.ContextFromAnnotations(ctx, chOriginal), ControllerName, tracing.WithResource(chOriginal))
+	defer func() { tracing.End(span, err) }()
 	ch := chOriginal.DeepCopy()
 
 	defer func() {
@@ -160,8 +165,16 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		// means no CAA check is performed by ACME server or if any valid
 		// CAA would stop issuance (strongly suspect the former)
 		if len(dir.CAA) != 0 {
-			err := dnsutil.ValidateCAA(ch.Spec.DNSName, dir.CAA, ch.Spec.Wildcard, c.dns01Nameservers)
+			// RFC 8657 allows CAA records to restrict issuance to specific
+			// ACME accounts and validation methods, so check these too in
+			// order to fail before attempting to solve the challenge.
+			account := dnsutil.CAAAccount{
+				URI:              genericIssuer.GetStatus().ACMEStatus().URI,
+				ValidationMethod: strings.ToLower(string(ch.Spec.Type)),
+			}
+			err := dnsutil.ValidateCAA(ch.Spec.DNSName, dir.CAA, ch.Spec.Wildcard, c.dns01Nameservers, account)
 			if err != nil {
+				c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCAACheckFailed, "CAA self-check failed: %v", err)
 				ch.Status.Reason = fmt.Sprintf("CAA self-check failed: %s", err)
 				return err
 			}
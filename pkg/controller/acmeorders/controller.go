@@ -18,6 +18,7 @@ package acmeorders
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -26,9 +27,12 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
+	gwlisters "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
@@ -77,7 +81,7 @@ func NewController(
 	log logr.Logger,
 	ctx *controllerpkg.Context,
 	isNamespaced bool,
-) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
 
 	// Create a queue used to queue up Orders to be processed.
 	queue := workqueue.NewNamedRateLimitingQueue(
@@ -122,6 +126,29 @@ func NewController(
 		)
 	}
 
+	// ReferenceGrants may permit references to Issuers in other namespaces.
+	var referenceGrantLister gwlisters.ReferenceGrantLister
+	if ctx.GatewaySolverEnabled {
+		referenceGrantInformer := ctx.GWShared.Gateway().V1beta1().ReferenceGrants()
+		mustSync = append(mustSync, referenceGrantInformer.Informer().HasSynced)
+		referenceGrantLister = referenceGrantInformer.Lister()
+
+		// requeue the Orders referencing an Issuer in the namespace of a
+		// ReferenceGrant, as it may permit the reference
+		if err := controllerpkg.AddCrossNamespaceIssuerIndex(orderInformer.Informer(), func(obj interface{}) (cmmeta.ObjectReference, bool) {
+			order, ok := obj.(*cmacme.Order)
+			if !ok {
+				return cmmeta.ObjectReference{}, false
+			}
+			return order.Spec.IssuerRef, true
+		}); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to add Order indexer: %w", err)
+		}
+		referenceGrantInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: controllerpkg.HandleReferenceGrantFunc(log, queue, orderInformer.Informer().GetIndexer()),
+		})
+	}
+
 	// register handler functions
 	orderInformer.Informer().AddEventHandler(
 		&controllerpkg.QueuingEventHandler{Queue: queue},
@@ -142,12 +169,12 @@ func NewController(
 		challengeLister:     challengeLister,
 		secretLister:        secretLister,
 		clusterIssuerLister: clusterIssuerLister,
-		helper:              issuer.NewHelper(issuerLister, clusterIssuerLister),
+		helper:              issuer.NewHelper(issuerLister, clusterIssuerLister, referenceGrantLister),
 		recorder:            ctx.Recorder,
 		cmClient:            ctx.CMClient,
 		accountRegistry:     ctx.AccountRegistry,
 		fieldManager:        ctx.FieldManager,
-	}, queue, mustSync
+	}, queue, mustSync, nil
 
 }
 
@@ -202,11 +229,14 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	// If --namespace flag was set thus limiting cert-manager to a single namespace.
 	isNamespaced := ctx.Namespace != ""
 
-	ctrl, queue, mustSync := NewController(
+	ctrl, queue, mustSync, err := NewController(
 		log,
 		ctx,
 		isNamespaced,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
 	c.controller = ctrl
 
 	return queue, mustSync, nil
@@ -36,6 +36,7 @@ import (
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalorders "github.com/cert-manager/cert-manager/internal/controller/orders"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	"github.com/cert-manager/cert-manager/pkg/acme"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -59,6 +60,9 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
 
+	ctx, span := tracing.Start(tracing.ContextFromAnnotations(ctx, o), ControllerName, tracing.WithResource(o))
+	defer func() { tracing.End(span, err) }()
+
 	oldOrder := o
 	o = o.DeepCopy()
 
@@ -418,6 +422,8 @@ func (c *controller) anyRequiredChallengesDoNotExist(requiredChallenges []*cmacm
 
 func (c *controller) createRequiredChallenges(ctx context.Context, o *cmacme.Order, requiredChallenges []*cmacme.Challenge) error {
 	for _, ch := range requiredChallenges {
+		// Propagate the trace of this Order to the Challenge.
+		ch.Annotations = tracing.InjectAnnotations(ctx, ch.Annotations)
 		_, err := c.cmClient.AcmeV1().Challenges(ch.Namespace).Create(ctx, ch, metav1.CreateOptions{})
 		if apierrors.IsAlreadyExists(err) {
 			continue
@@ -45,6 +45,10 @@ type Builder struct {
 	// runDurationFuncs are a list of functions that will be called every
 	// 'duration'
 	runDurationFuncs []runDurationFunc
+
+	// unsharded is true if the controller reconciles every key on every
+	// replica, even when controllers are sharded across replicas.
+	unsharded bool
 }
 
 // New creates a basic Builder, setting the sync call to the one given
@@ -79,6 +83,15 @@ func (b *Builder) First(function func(context.Context)) *Builder {
 	return b
 }
 
+// Unsharded makes the controller reconcile every key on every replica when
+// controllers are sharded across replicas. It is used by controllers which
+// set up state local to each replica that the other controllers depend on,
+// such as the ACME clients registered by the issuer controllers.
+func (b *Builder) Unsharded() *Builder {
+	b.unsharded = true
+	return b
+}
+
 func (b *Builder) Complete() (Interface, error) {
 	controllerctx, err := b.contextFactory.Build(b.name)
 	if err != nil {
@@ -95,5 +108,11 @@ func (b *Builder) Complete() (Interface, error) {
 		return nil, fmt.Errorf("error registering controller: %v", err)
 	}
 
-	return NewController(ctx, b.name, controllerctx.Metrics, b.impl.ProcessItem, mustSync, b.runDurationFuncs, queue), nil
+	syncFunc := b.impl.ProcessItem
+	if controllerctx.Sharder != nil && !b.unsharded {
+		// only reconcile the keys assigned to this replica
+		syncFunc = newShardFilter(controllerctx.Sharder, queue, syncFunc).ProcessItem
+	}
+
+	return NewController(ctx, b.name, controllerctx.Metrics, syncFunc, mustSync, b.runDurationFuncs, queue), nil
 }
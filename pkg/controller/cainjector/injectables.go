@@ -17,10 +17,21 @@ limitations under the License.
 package cainjector
 
 import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
 	admissionreg "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apireg "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
 // This file contains logic for dealing with injectables, such as injecting CA
@@ -54,6 +65,28 @@ func newCRDConversionInjectable() InjectTarget {
 	return &crdConversionTarget{}
 }
 
+var _ NewInjectableTarget = newConfigMapInjectable
+
+func newConfigMapInjectable() InjectTarget {
+	return &configMapTarget{}
+}
+
+var _ NewInjectableTarget = newSecretInjectable
+
+func newSecretInjectable() InjectTarget {
+	return &secretTarget{}
+}
+
+// newGenericInjectable returns a NewInjectableTarget for resources of the
+// given kind, which sets CA data at the given path.
+func newGenericInjectable(gvk schema.GroupVersionKind, path injectablePath, encoding CAEncoding) NewInjectableTarget {
+	return func() InjectTarget {
+		t := &genericTarget{path: path, encoding: encoding}
+		t.obj.SetGroupVersionKind(gvk)
+		return t
+	}
+}
+
 // InjectTarget knows how to set CA data to a particular instance of injectable,
 // for example an instance of ValidatingWebhookConfiguration.
 type InjectTarget interface {
@@ -133,3 +166,173 @@ func (t *crdConversionTarget) SetCA(data []byte) {
 	}
 	t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle = data
 }
+
+// defaultInjectKey is the key of a ConfigMap or Secret that CA data is
+// injected into if the injectable has no inject-ca-key annotation.
+const defaultInjectKey = "ca.crt"
+
+// injectKey returns the key that the injectable wants CA data injected into.
+func injectKey(obj client.Object) string {
+	if key := obj.GetAnnotations()[cmapi.WantInjectKeyAnnotation]; key != "" {
+		return key
+	}
+	return defaultInjectKey
+}
+
+// configMapTarget knows how to set CA data for a key in a ConfigMap, for
+// example a trust store read by an application or a ConfigMap referenced by
+// a Gateway API BackendTLSPolicy.
+type configMapTarget struct {
+	obj corev1.ConfigMap
+}
+
+func (t *configMapTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *configMapTarget) SetCA(data []byte) {
+	if t.obj.Data == nil {
+		t.obj.Data = make(map[string]string)
+	}
+	t.obj.Data[injectKey(&t.obj)] = string(data)
+}
+
+// secretTarget knows how to set CA data for a key in a Secret.
+type secretTarget struct {
+	obj corev1.Secret
+}
+
+func (t *secretTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *secretTarget) SetCA(data []byte) {
+	if t.obj.Data == nil {
+		t.obj.Data = make(map[string][]byte)
+	}
+	t.obj.Data[injectKey(&t.obj)] = data
+}
+
+// CAEncoding is the encoding used to store CA data in a field of a generic
+// injectable.
+type CAEncoding string
+
+const (
+	// CAEncodingPEM stores the PEM encoded CA data as a string.
+	CAEncodingPEM CAEncoding = "pem"
+
+	// CAEncodingBase64 stores the base64 encoding of the PEM encoded CA data,
+	// which is how fields of type []byte, such as the caBundle of a webhook
+	// client config, are represented in JSON.
+	CAEncodingBase64 CAEncoding = "base64"
+)
+
+// genericTarget knows how to set CA data at a path in a resource of any kind,
+// for example a third-party custom resource holding webhook configuration.
+type genericTarget struct {
+	obj      unstructured.Unstructured
+	path     injectablePath
+	encoding CAEncoding
+}
+
+func (t *genericTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *genericTarget) SetCA(data []byte) {
+	value := string(data)
+	if t.encoding == CAEncodingBase64 {
+		value = base64.StdEncoding.EncodeToString(data)
+	}
+	t.path.set(t.obj.Object, value)
+}
+
+// injectablePath is a parsed JSONPath expression pointing to the field(s) of
+// a generic injectable that CA data is injected into.
+type injectablePath []pathSegment
+
+// pathSegment is a single field of an injectablePath. If the field is a
+// list, either a single element or all elements of the list are selected.
+type pathSegment struct {
+	field string
+
+	list     bool
+	wildcard bool
+	index    int
+}
+
+var pathSegmentRegexp = regexp.MustCompile(`^([A-Za-z0-9_-]+)(?:\[(\*|[0-9]+)\])?$`)
+
+// parseInjectablePath parses a restricted JSONPath expression, such as
+// `{.spec.webhooks[*].clientConfig.caBundle}`. Only field names, optionally
+// followed by a list index or the `[*]` wildcard, are supported.
+func parseInjectablePath(path string) (injectablePath, error) {
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(path, "{"), "}")
+	trimmed = strings.TrimPrefix(trimmed, ".")
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid path %q: must not be empty", path)
+	}
+
+	var p injectablePath
+	for _, s := range strings.Split(trimmed, ".") {
+		matches := pathSegmentRegexp.FindStringSubmatch(s)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid path %q: unsupported segment %q", path, s)
+		}
+		seg := pathSegment{field: matches[1]}
+		switch matches[2] {
+		case "":
+		case "*":
+			seg.list, seg.wildcard = true, true
+		default:
+			index, err := strconv.Atoi(matches[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w", path, err)
+			}
+			seg.list, seg.index = true, index
+		}
+		p = append(p, seg)
+	}
+	return p, nil
+}
+
+// set sets all the fields selected by the path in obj to value. Missing
+// intermediate objects are created, but lists are never extended. Fields
+// whose type doesn't match the path are skipped.
+func (p injectablePath) set(obj map[string]interface{}, value string) {
+	if len(p) == 0 || obj == nil {
+		return
+	}
+	seg, rest := p[0], p[1:]
+
+	if !seg.list {
+		if len(rest) == 0 {
+			obj[seg.field] = value
+			return
+		}
+		child, ok := obj[seg.field]
+		if !ok {
+			child = make(map[string]interface{})
+			obj[seg.field] = child
+		}
+		childObj, _ := child.(map[string]interface{})
+		rest.set(childObj, value)
+		return
+	}
+
+	list, ok := obj[seg.field].([]interface{})
+	if !ok {
+		return
+	}
+	for i := range list {
+		if !seg.wildcard && i != seg.index {
+			continue
+		}
+		if len(rest) == 0 {
+			list[i] = value
+			continue
+		}
+		elem, _ := list[i].(map[string]interface{})
+		rest.set(elem, value)
+	}
+}
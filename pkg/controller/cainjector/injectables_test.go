@@ -0,0 +1,267 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+func TestConfigMapTarget(t *testing.T) {
+	target := newConfigMapInjectable().(*configMapTarget)
+	target.obj = corev1.ConfigMap{Data: map[string]string{"other": "value"}}
+	target.SetCA([]byte("ca"))
+	assert.Equal(t, map[string]string{"other": "value", "ca.crt": "ca"}, target.obj.Data)
+
+	target = newConfigMapInjectable().(*configMapTarget)
+	target.obj = corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{cmapi.WantInjectKeyAnnotation: "trust-bundle.pem"},
+	}}
+	target.SetCA([]byte("ca"))
+	assert.Equal(t, map[string]string{"trust-bundle.pem": "ca"}, target.obj.Data)
+}
+
+func TestSecretTarget(t *testing.T) {
+	target := newSecretInjectable().(*secretTarget)
+	target.obj = corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{cmapi.WantInjectKeyAnnotation: "ca.pem"},
+	}}
+	target.SetCA([]byte("ca"))
+	assert.Equal(t, map[string][]byte{"ca.pem": []byte("ca")}, target.obj.Data)
+}
+
+func TestNamespacedTargetSources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, cmapi.AddToScheme(scheme))
+
+	var objs []runtime.Object
+	for _, ns := range []string{"app", "other"} {
+		objs = append(objs,
+			&cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "ca"},
+				Spec:       cmapi.CertificateSpec{SecretName: "ca"},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "ca", Annotations: map[string]string{
+					cmapi.CertificateNameKey:                  "ca",
+					cmapi.AllowsInjectionFromSecretAnnotation: "true",
+				}},
+				Data: map[string][]byte{cmmeta.TLSCAKey: []byte("ca-" + ns)},
+			},
+		)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+	sources := map[string]caDataSource{
+		cmapi.WantInjectAnnotation:           &certificateDataSource{client: cl},
+		cmapi.WantInjectFromSecretAnnotation: &secretDataSource{client: cl},
+	}
+
+	tests := map[string]struct {
+		target       metav1.Object
+		expCA        []byte
+		expForbidden bool
+	}{
+		"should inject a ConfigMap from a source in its namespace": {
+			target: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "bundle"}},
+			expCA:  []byte("ca-app"),
+		},
+		"should not inject a ConfigMap from a source in another namespace": {
+			target:       &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "bundle"}},
+			expForbidden: true,
+		},
+		"should not inject a Secret from a source in another namespace": {
+			target:       &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "bundle"}},
+			expForbidden: true,
+		},
+		"should inject a cluster scoped resource from a source in any namespace": {
+			target: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cluster-scoped"}},
+			expCA:  []byte("ca-app"),
+		},
+	}
+
+	for name, test := range tests {
+		for annotation, source := range sources {
+			t.Run(name+"/"+annotation, func(t *testing.T) {
+				test.target.SetAnnotations(map[string]string{annotation: "app/ca"})
+				ca, err := source.ReadCA(context.Background(), logf.Log, test.target, "")
+				if test.expForbidden {
+					assert.True(t, apierrors.IsForbidden(err), "expected a Forbidden error, got %v", err)
+					assert.Nil(t, ca)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, test.expCA, ca)
+			})
+		}
+	}
+}
+
+func TestGenericTarget(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "WebhookConfig"}
+	tests := map[string]struct {
+		path     string
+		encoding CAEncoding
+		obj      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"missing intermediate objects are created": {
+			path:     "{.spec.tls.caBundle}",
+			encoding: CAEncodingPEM,
+			obj:      map[string]interface{}{},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"tls": map[string]interface{}{"caBundle": "ca"}},
+			},
+		},
+		"all list elements are set with a wildcard": {
+			path:     ".spec.webhooks[*].caBundle",
+			encoding: CAEncodingBase64,
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{"webhooks": []interface{}{
+					map[string]interface{}{"name": "a"},
+					map[string]interface{}{"name": "b"},
+				}},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"webhooks": []interface{}{
+					map[string]interface{}{"name": "a", "caBundle": "Y2E="},
+					map[string]interface{}{"name": "b", "caBundle": "Y2E="},
+				}},
+			},
+		},
+		"only the indexed list element is set": {
+			path:     "spec.webhooks[1].caBundle",
+			encoding: CAEncodingPEM,
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{"webhooks": []interface{}{
+					map[string]interface{}{"name": "a"},
+					map[string]interface{}{"name": "b"},
+				}},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"webhooks": []interface{}{
+					map[string]interface{}{"name": "a"},
+					map[string]interface{}{"name": "b", "caBundle": "ca"},
+				}},
+			},
+		},
+		"missing lists are not created": {
+			path:     "{.spec.webhooks[*].caBundle}",
+			encoding: CAEncodingPEM,
+			obj:      map[string]interface{}{"spec": map[string]interface{}{}},
+			expected: map[string]interface{}{"spec": map[string]interface{}{}},
+		},
+		"fields of the wrong type are skipped": {
+			path:     "{.spec.tls.caBundle}",
+			encoding: CAEncodingPEM,
+			obj:      map[string]interface{}{"spec": "invalid"},
+			expected: map[string]interface{}{"spec": "invalid"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path, err := parseInjectablePath(test.path)
+			require.NoError(t, err)
+
+			target := newGenericInjectable(gvk, path, test.encoding)().(*genericTarget)
+			assert.Equal(t, gvk, target.AsObject().GetObjectKind().GroupVersionKind())
+
+			target.obj.Object = test.obj
+			target.SetCA([]byte("ca"))
+			assert.Equal(t, test.expected, target.obj.Object)
+		})
+	}
+}
+
+func TestParseGenericInjectable(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		expected  GenericInjectable
+		expectErr bool
+	}{
+		"defaults to PEM encoding": {
+			input: "BackendTLSPolicy.v1alpha2.gateway.networking.k8s.io={.spec.tls.caBundle}",
+			expected: GenericInjectable{
+				GroupVersionKind: schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "BackendTLSPolicy"},
+				Path:             "{.spec.tls.caBundle}",
+				Encoding:         CAEncodingPEM,
+			},
+		},
+		"base64 encoding": {
+			input: "WebhookConfig.v1.example.com={.spec.webhooks[*].caBundle}=base64",
+			expected: GenericInjectable{
+				GroupVersionKind: schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "WebhookConfig"},
+				Path:             "{.spec.webhooks[*].caBundle}",
+				Encoding:         CAEncodingBase64,
+			},
+		},
+		"core group": {
+			input: "Pod.v1={.metadata.annotations.ca}",
+			expected: GenericInjectable{
+				GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+				Path:             "{.metadata.annotations.ca}",
+				Encoding:         CAEncodingPEM,
+			},
+		},
+		"missing path": {
+			input:     "WebhookConfig.v1.example.com",
+			expectErr: true,
+		},
+		"missing version": {
+			input:     "WebhookConfig={.spec.caBundle}",
+			expectErr: true,
+		},
+		"unknown encoding": {
+			input:     "WebhookConfig.v1.example.com={.spec.caBundle}=der",
+			expectErr: true,
+		},
+		"unsupported JSONPath": {
+			input:     "WebhookConfig.v1.example.com={.spec.webhooks[?(@.name=='a')].caBundle}",
+			expectErr: true,
+		},
+		"empty JSONPath": {
+			input:     "WebhookConfig.v1.example.com={}",
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseGenericInjectable(test.input)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -38,6 +38,8 @@ import (
 // reconciler is created for each of the injectables- CustomResourceDefinition,
 // Validating/MutatingWebhookConfiguration, APIService and gets triggered for
 // events on those resources as well as on Secrets and Certificates.
+// Reconcilers for ConfigMaps, Secrets and generic injectables are only
+// created if enabled.
 
 // reconciler syncs CA data from source to injectable.
 type reconciler struct {
@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	admissionreg "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apireg "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -45,6 +48,8 @@ const (
 	ValidatingWebhookConfigurationName = "validatingwebhookconfiguration"
 	APIServiceName                     = "apiservice"
 	CustomResourceDefinitionName       = "customresourcedefinition"
+	ConfigMapName                      = "configmap"
+	SecretName                         = "secret"
 )
 
 // setup is setup for a reconciler for a particular injectable type
@@ -60,6 +65,72 @@ type SetupOptions struct {
 	Namespace                    string
 	EnableCertificatesDataSource bool
 	EnabledReconcilersFor        map[string]bool
+	// GenericInjectables are additional kinds of injectables, which are
+	// always reconciled.
+	GenericInjectables []GenericInjectable
+}
+
+// GenericInjectable configures injection of CA data into annotated resources
+// of an arbitrary kind.
+type GenericInjectable struct {
+	GroupVersionKind schema.GroupVersionKind
+	// Path is a JSONPath expression selecting the field(s) that CA data is
+	// injected into, e.g. `{.spec.webhooks[*].clientConfig.caBundle}`. Only
+	// field names, list indexes and the `[*]` wildcard are supported.
+	Path string
+	// Encoding is the encoding used to store the CA data in those fields.
+	Encoding CAEncoding
+}
+
+// ParseGenericInjectable parses a GenericInjectable from a string of the form
+// `<Kind>.<version>.<group>=<jsonpath>[=<encoding>]`, where encoding is one
+// of `pem` (the default) or `base64`.
+func ParseGenericInjectable(s string) (GenericInjectable, error) {
+	parts := strings.Split(s, "=")
+	if len(parts) < 2 || len(parts) > 3 {
+		return GenericInjectable{}, fmt.Errorf("invalid injectable %q: must be of the form <Kind>.<version>.<group>=<jsonpath>[=<encoding>]", s)
+	}
+
+	gvkParts := strings.SplitN(parts[0], ".", 3)
+	if len(gvkParts) < 2 || gvkParts[0] == "" || gvkParts[1] == "" {
+		return GenericInjectable{}, fmt.Errorf("invalid injectable %q: kind must be of the form <Kind>.<version>.<group>", s)
+	}
+	gvk := schema.GroupVersionKind{Kind: gvkParts[0], Version: gvkParts[1]}
+	if len(gvkParts) == 3 {
+		gvk.Group = gvkParts[2]
+	}
+
+	encoding := CAEncodingPEM
+	if len(parts) == 3 {
+		encoding = CAEncoding(parts[2])
+	}
+	if encoding != CAEncodingPEM && encoding != CAEncodingBase64 {
+		return GenericInjectable{}, fmt.Errorf("invalid injectable %q: encoding must be one of %q or %q", s, CAEncodingPEM, CAEncodingBase64)
+	}
+
+	gi := GenericInjectable{GroupVersionKind: gvk, Path: parts[1], Encoding: encoding}
+	if _, err := parseInjectablePath(gi.Path); err != nil {
+		return GenericInjectable{}, fmt.Errorf("invalid injectable %q: %w", s, err)
+	}
+	return gi, nil
+}
+
+// newGenericSetup returns the setup for a reconciler for a generic injectable.
+func newGenericSetup(gi GenericInjectable) (setup, error) {
+	path, err := parseInjectablePath(gi.Path)
+	if err != nil {
+		return setup{}, err
+	}
+	objType := &unstructured.Unstructured{}
+	objType.SetGroupVersionKind(gi.GroupVersionKind)
+	listType := &unstructured.UnstructuredList{}
+	listType.SetGroupVersionKind(gi.GroupVersionKind.GroupVersion().WithKind(gi.GroupVersionKind.Kind + "List"))
+	return setup{
+		resourceName:        strings.ToLower(gi.GroupVersionKind.Kind + "." + gi.GroupVersionKind.Version + "." + gi.GroupVersionKind.Group),
+		newInjectableTarget: newGenericInjectable(gi.GroupVersionKind, path, gi.Encoding),
+		listType:            listType,
+		objType:             objType,
+	}, nil
 }
 
 var (
@@ -91,7 +162,23 @@ var (
 		objType:             &apiext.CustomResourceDefinition{},
 	}
 
-	injectorSetups = []setup{MutatingWebhookSetup, ValidatingWebhookSetup, APIServiceSetup, CRDSetup}
+	// ConfigMaps and Secrets are namespaced, so their CA data may only be
+	// read from Certificates and Secrets in the same namespace.
+	ConfigMapSetup = setup{
+		resourceName:        "configmap",
+		newInjectableTarget: newConfigMapInjectable,
+		listType:            &corev1.ConfigMapList{},
+		objType:             &corev1.ConfigMap{},
+	}
+
+	SecretSetup = setup{
+		resourceName:        "secret",
+		newInjectableTarget: newSecretInjectable,
+		listType:            &corev1.SecretList{},
+		objType:             &corev1.Secret{},
+	}
+
+	injectorSetups = []setup{MutatingWebhookSetup, ValidatingWebhookSetup, APIServiceSetup, CRDSetup, ConfigMapSetup, SecretSetup}
 )
 
 // registerAllInjectors registers all injectors and based on the
@@ -112,15 +199,25 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 	kds := &kubeconfigDataSource{
 		apiserverCABundle: caBundle,
 	}
-	injectorSetups := []setup{MutatingWebhookSetup, ValidatingWebhookSetup, APIServiceSetup, CRDSetup}
-	// Registers a c/r controller for each of APIService, CustomResourceDefinition, Mutating/ValidatingWebhookConfiguration
-	// TODO: add a flag to allow users to configure which of these controllers should be registered
+	var setups []setup
 	for _, setup := range injectorSetups {
-		log := ctrl.Log.WithValues("kind", setup.resourceName)
 		if !opts.EnabledReconcilersFor[setup.resourceName] {
-			log.Info("Not registering a reconcile for injectable kind as it's disabled")
+			ctrl.Log.WithValues("kind", setup.resourceName).Info("Not registering a reconcile for injectable kind as it's disabled")
 			continue
 		}
+		setups = append(setups, setup)
+	}
+	for _, gi := range opts.GenericInjectables {
+		setup, err := newGenericSetup(gi)
+		if err != nil {
+			return fmt.Errorf("error setting up injectable %s: %w", gi.GroupVersionKind, err)
+		}
+		setups = append(setups, setup)
+	}
+	// Registers a c/r controller for each of APIService, CustomResourceDefinition, Mutating/ValidatingWebhookConfiguration,
+	// ConfigMap, Secret and the configured generic injectables
+	for _, setup := range setups {
+		log := ctrl.Log.WithValues("kind", setup.resourceName)
 		log.Info("Registering a reconciler for injectable")
 		r := &reconciler{
 			namespace:           opts.Namespace,
@@ -158,10 +255,12 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 		}
 
 		b := ctrl.NewControllerManagedBy(mgr).
+			Named(setup.resourceName).
 			For(setup.objType,
 				// We watch all CRDs,
 				// Validating/MutatingWebhookConfigurations,
-				// APIServices because the only way how to tell
+				// APIServices (and any other enabled kind of
+				// injectable) because the only way how to tell
 				// if an object is an injectable is from
 				// annotation value and this cannot be used to
 				// filter List/Watch. The earliest point where
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -94,11 +95,9 @@ func (c *certificateDataSource) ReadCA(ctx context.Context, log logr.Logger, met
 		// don't return an error, requeuing won't help till this is changed
 		return nil, nil
 	}
-	if namespace != "" && certName.Namespace != namespace {
-		err := fmt.Errorf("cannot read CA data from Certificate in namespace %s, cainjector is scoped to namespace %s", certName.Namespace, namespace)
-		forbidenErr := apierrors.NewForbidden(cmapi.Resource("certificates"), certName.Name, err)
-		log.Error(forbidenErr, "cannot read data source")
-		return nil, forbidenErr
+	if err := checkSourceNamespace(cmapi.Resource("certificates"), certName, metaObj, namespace); err != nil {
+		log.Error(err, "cannot read data source")
+		return nil, err
 	}
 
 	var cert cmapi.Certificate
@@ -163,11 +162,9 @@ func (c *secretDataSource) ReadCA(ctx context.Context, log logr.Logger, metaObj
 		return nil, nil
 	}
 
-	if namespace != "" && secretName.Namespace != namespace {
-		err := fmt.Errorf("cannot read CA data from Secret in namespace %s, cainjector is scoped to namespace %s", secretName.Namespace, namespace)
-		forbidenErr := apierrors.NewForbidden(cmapi.Resource("certificates"), secretName.Name, err)
-		log.Error(forbidenErr, "cannot read data source")
-		return nil, forbidenErr
+	if err := checkSourceNamespace(corev1.Resource("secrets"), secretName, metaObj, namespace); err != nil {
+		log.Error(err, "cannot read data source")
+		return nil, err
 	}
 
 	// grab the associated secret
@@ -194,3 +191,20 @@ func (c *secretDataSource) ReadCA(ctx context.Context, log logr.Logger, metaObj
 
 	return caData, nil
 }
+
+// checkSourceNamespace returns a Forbidden error if the CA data source is
+// outside of the namespace the cainjector is scoped to. Namespaced targets,
+// such as ConfigMaps and Secrets, may only be injected from sources in their
+// own namespace, as otherwise anyone able to create them could read the CAs
+// of any namespace.
+func checkSourceNamespace(resource schema.GroupResource, source types.NamespacedName, metaObj metav1.Object, namespace string) error {
+	if namespace != "" && source.Namespace != namespace {
+		err := fmt.Errorf("cannot read CA data from %s in namespace %s, cainjector is scoped to namespace %s", resource.Resource, source.Namespace, namespace)
+		return apierrors.NewForbidden(resource, source.Name, err)
+	}
+	if targetNamespace := metaObj.GetNamespace(); targetNamespace != "" && source.Namespace != targetNamespace {
+		err := fmt.Errorf("cannot read CA data from %s in namespace %s into a resource in namespace %s", resource.Resource, source.Namespace, targetNamespace)
+		return apierrors.NewForbidden(resource, source.Name, err)
+	}
+	return nil
+}
@@ -48,6 +48,7 @@ var (
 //	    cert-manager.io/renew-before: 1440h
 //	    cert-manager.io/usages: "digital signature,key encipherment"
 //	    cert-manager.io/revision-history-limit: 7
+//	    cert-manager.io/suspend: "true"
 //
 // is mapped to the following Certificate:
 //
@@ -60,6 +61,7 @@ var (
 //	    - digital signature
 //	    - key encipherment
 //	  revisionHistoryLimit: 7
+//	  suspend: true
 func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]string) error {
 	if crt == nil {
 		return errNilCertificate
@@ -268,5 +270,13 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 		}
 	}
 
+	if suspend, found := ingLikeAnnotations[cmapi.SuspendAnnotationKey]; found {
+		suspended, err := strconv.ParseBool(suspend)
+		if err != nil {
+			return fmt.Errorf("%w %q: %v", errInvalidIngressAnnotation, cmapi.SuspendAnnotationKey, err)
+		}
+		crt.Spec.Suspend = suspended
+	}
+
 	return nil
 }
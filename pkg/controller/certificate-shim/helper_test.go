@@ -55,6 +55,7 @@ func Test_translateAnnotations(t *testing.T) {
 			cmapi.SubjectStreetAddressesAnnotationKey:     `"1725 Slough Avenue, Suite 200, Scranton Business Park","1800 Slough Avenue, Suite 200, Scranton Business Park"`,
 			cmapi.SubjectPostalCodesAnnotationKey:         "ABC123",
 			cmapi.SubjectSerialNumberAnnotationKey:        "123456",
+			cmapi.SuspendAnnotationKey:                    "true",
 		}
 	}
 
@@ -69,6 +70,7 @@ func Test_translateAnnotations(t *testing.T) {
 				a.Equal([]cmapi.KeyUsage{cmapi.UsageServerAuth, cmapi.UsageSigning}, crt.Spec.Usages)
 				a.Equal(pointer.Int32(7), crt.Spec.RevisionHistoryLimit)
 				a.Equal("123456", crt.Spec.Subject.SerialNumber)
+				a.True(crt.Spec.Suspend)
 
 				splitAddresses, splitErr := cmutil.SplitWithEscapeCSV(`"1725 Slough Avenue, Suite 200, Scranton Business Park","1800 Slough Avenue, Suite 200, Scranton Business Park"`)
 				a.Equal(nil, splitErr)
@@ -207,6 +209,14 @@ func Test_translateAnnotations(t *testing.T) {
 			},
 			expectedError: errInvalidIngressAnnotation,
 		},
+		"bad suspend": {
+			crt:         gen.Certificate("example-cert"),
+			annotations: validAnnotations(),
+			mutate: func(tc *testCase) {
+				tc.annotations[cmapi.SuspendAnnotationKey] = "maybe"
+			},
+			expectedError: errInvalidIngressAnnotation,
+		},
 		"bad private key algorithm": {
 			crt:         gen.Certificate("example-cert"),
 			annotations: validAnnotations(),
@@ -48,14 +48,18 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	ingressInformer := ctx.KubeSharedInformerFactory.Ingresses()
 	c.ingressLister = ingressInformer.Lister()
 
+	// Namespace annotations hold the Certificate defaults of the namespace.
+	namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+
 	log := logf.FromContext(ctx.RootContext, ControllerName)
-	c.sync = shimhelper.SyncFnFor(ctx.Recorder, log, ctx.CMClient, cmShared.Certmanager().V1().Certificates().Lister(), ctx.IngressShimOptions, ctx.FieldManager)
+	c.sync = shimhelper.SyncFnFor(ctx.Recorder, log, namespaceInformer.Lister(), ctx.CMClient, cmShared.Certmanager().V1().Certificates().Lister(), ctx.IngressShimOptions, ctx.FieldManager)
 
 	queue := workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
 
 	mustSync := []cache.InformerSynced{
 		ingressInformer.Informer().HasSynced,
 		cmShared.Certmanager().V1().Certificates().Informer().HasSynced,
+		namespaceInformer.Informer().HasSynced,
 	}
 
 	// We still requeue on "Deleted" for consistency with the rest of the
@@ -33,9 +33,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/cert-manager/cert-manager/internal/certificatedefaults"
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -68,10 +70,13 @@ type SyncFn func(context.Context, metav1.Object) error
 // HTTPRoute. Due to their similarity, the reconciliation function for them is
 // common. Reconciling an Ingress-like object means looking at its annotations
 // and creating a Certificate with matching DNS names and secretNames from the
-// TLS configuration of the Ingress-like object.
+// TLS configuration of the Ingress-like object. The Certificate defaults
+// configured on the Namespace of the Ingress-like object are applied to the
+// Certificates, in the same way as the webhook does.
 func SyncFnFor(
 	rec record.EventRecorder,
 	log logr.Logger,
+	namespaceLister corelisters.NamespaceLister,
 	cmClient clientset.Interface,
 	cmLister cmlisters.CertificateLister,
 	defaults controller.IngressShimOptions,
@@ -107,7 +112,24 @@ func SyncFnFor(
 			return nil
 		}
 
-		issuerName, issuerKind, issuerGroup, err := issuerForIngressLike(defaults, ingLike)
+		var nsAnnotations map[string]string
+		ns, err := namespaceLister.Get(ingLike.GetNamespace())
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return err
+		default:
+			nsAnnotations = ns.Annotations
+		}
+
+		nsDefaults, err := certificatedefaults.FromAnnotations(nsAnnotations)
+		if err != nil {
+			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, "Could not determine Certificate defaults due to bad namespace annotations: %s",
+				err)
+			return nil
+		}
+
+		issuerName, issuerKind, issuerGroup, err := issuerForIngressLike(defaults, nsDefaults, ingLike)
 		if err != nil {
 			log.Error(err, "failed to determine issuer to be used for ingress resource")
 			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, "Could not determine issuer for ingress due to bad annotations: %s",
@@ -121,7 +143,7 @@ func SyncFnFor(
 			return nil
 		}
 
-		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, issuerName, issuerKind, issuerGroup)
+		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, issuerName, issuerKind, issuerGroup, nsDefaults)
 		if err != nil {
 			return err
 		}
@@ -298,6 +320,7 @@ func buildCertificates(
 	cmLister cmlisters.CertificateLister,
 	ingLike metav1.Object,
 	issuerName, issuerKind, issuerGroup string,
+	nsDefaults *certificatedefaults.Defaults,
 ) (new, update []*cmapi.Certificate, _ error) {
 
 	var newCrts []*cmapi.Certificate
@@ -389,6 +412,8 @@ func buildCertificates(
 			return nil, nil, err
 		}
 
+		nsDefaults.Apply(crt)
+
 		// check if a Certificate for this TLS entry already exists, and if it
 		// does then skip this entry
 		if existingCrt != nil {
@@ -507,6 +532,10 @@ func certNeedsUpdate(a, b *cmapi.Certificate) bool {
 		return true
 	}
 
+	if a.Spec.Suspend != b.Spec.Suspend {
+		return true
+	}
+
 	var aAlgorithm, bAlgorithm cmapi.PrivateKeyAlgorithm
 	if a.Spec.PrivateKey != nil && a.Spec.PrivateKey.Algorithm != "" {
 		aAlgorithm = a.Spec.PrivateKey.Algorithm
@@ -677,20 +706,27 @@ func isDeletedInForeground(ingLike metav1.Object) bool {
 
 // issuerForIngressLike determines the Issuer that should be specified on a
 // Certificate created for the given ingress-like resource. If one is not set,
-// the default issuer given to the controller is used. We look up the following
-// Ingress annotations:
+// the default issuer of the namespace is used, falling back to the default
+// issuer given to the controller. We look up the following Ingress
+// annotations:
 //
 //	cert-manager.io/cluster-issuer
 //	cert-manager.io/issuer
 //	cert-manager.io/issuer-kind
 //	cert-manager.io/issuer-group
-func issuerForIngressLike(defaults controller.IngressShimOptions, ingLike metav1.Object) (name, kind, group string, err error) {
+func issuerForIngressLike(defaults controller.IngressShimOptions, nsDefaults *certificatedefaults.Defaults, ingLike metav1.Object) (name, kind, group string, err error) {
 	var errs []string
 
 	name = defaults.DefaultIssuerName
 	kind = defaults.DefaultIssuerKind
 	group = defaults.DefaultIssuerGroup
 
+	if nsDefaults != nil && len(nsDefaults.IssuerName) > 0 {
+		name = nsDefaults.IssuerName
+		kind = nsDefaults.IssuerKind
+		group = nsDefaults.IssuerGroup
+	}
+
 	annotations := ingLike.GetAnnotations()
 
 	if annotations == nil {
@@ -21,9 +21,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -33,6 +35,7 @@ import (
 	"k8s.io/utils/pointer"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/cert-manager/cert-manager/internal/certificatedefaults"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -85,6 +88,7 @@ func TestSync(t *testing.T) {
 		IssuerLister        []runtime.Object
 		ClusterIssuerLister []runtime.Object
 		CertificateLister   []runtime.Object
+		Namespace           *corev1.Namespace
 		DefaultIssuerName   string
 		DefaultIssuerKind   string
 		DefaultIssuerGroup  string
@@ -469,6 +473,102 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:                "should apply the Certificate defaults of the namespace",
+			Issuer:              clusterIssuer,
+			DefaultIssuerName:   "issuer-name",
+			DefaultIssuerKind:   "ClusterIssuer",
+			DefaultIssuerGroup:  "cert-manager.io",
+			ClusterIssuerLister: []runtime.Object{clusterIssuer},
+			Namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.DefaultIssuerNameAnnotationKey:          "namespace-issuer",
+						cmapi.DefaultIssuerKindAnnotationKey:          "Issuer",
+						cmapi.DefaultDurationAnnotationKey:            "720h",
+						cmapi.DefaultPrivateKeyAlgorithmAnnotationKey: "ECDSA",
+					},
+				},
+			},
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						"kubernetes.io/tls-acme":               "true",
+						cmapi.PrivateKeyAlgorithmAnnotationKey: "RSA",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ExpectedEvents: []string{`Normal CreateCertificate Successfully created Certificate "example-com-tls"`},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name", gen.DefaultTestNamespace),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "namespace-issuer",
+							Kind: "Issuer",
+						},
+						Usages:   cmapi.DefaultKeyUsages(),
+						Duration: &metav1.Duration{Duration: 720 * time.Hour},
+						PrivateKey: &cmapi.CertificatePrivateKey{
+							Algorithm: cmapi.RSAKeyAlgorithm,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:                "should not create Certificates if the namespace has invalid Certificate defaults",
+			Issuer:              clusterIssuer,
+			DefaultIssuerName:   "issuer-name",
+			DefaultIssuerKind:   "ClusterIssuer",
+			DefaultIssuerGroup:  "cert-manager.io",
+			ClusterIssuerLister: []runtime.Object{clusterIssuer},
+			Namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.DefaultPrivateKeySizeAnnotationKey: "big",
+					},
+				},
+			},
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						"kubernetes.io/tls-acme": "true",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ExpectedEvents: []string{`Warning BadConfig Could not determine Certificate defaults due to bad namespace annotations: invalid annotation "cert-manager.io/default-private-key-size": strconv.Atoi: parsing "big": invalid syntax`},
+		},
 		{
 			Name:                "should return a basic certificate when no provider specific config is provided",
 			Issuer:              clusterIssuer,
@@ -2878,6 +2978,10 @@ func TestSync(t *testing.T) {
 			allCMObjects = append(allCMObjects, test.IssuerLister...)
 			allCMObjects = append(allCMObjects, test.ClusterIssuerLister...)
 			allCMObjects = append(allCMObjects, test.CertificateLister...)
+			var allKubeObjects []runtime.Object
+			if test.Namespace != nil {
+				allKubeObjects = append(allKubeObjects, test.Namespace)
+			}
 			var expectedActions []testpkg.Action
 			for _, cr := range test.ExpectedCreate {
 				expectedActions = append(expectedActions,
@@ -2907,13 +3011,14 @@ func TestSync(t *testing.T) {
 			}
 			b := &testpkg.Builder{
 				T:                  t,
+				KubeObjects:        allKubeObjects,
 				CertManagerObjects: allCMObjects,
 				ExpectedActions:    expectedActions,
 				ExpectedEvents:     test.ExpectedEvents,
 			}
 			b.Init()
 			defer b.Stop()
-			sync := SyncFnFor(b.Recorder, logr.Discard(), b.CMClient, b.SharedInformerFactory.Certmanager().V1().Certificates().Lister(), controller.IngressShimOptions{
+			sync := SyncFnFor(b.Recorder, logr.Discard(), b.KubeSharedInformerFactory.Namespaces().Lister(), b.CMClient, b.SharedInformerFactory.Certmanager().V1().Certificates().Lister(), controller.IngressShimOptions{
 				DefaultIssuerName:                 test.DefaultIssuerName,
 				DefaultIssuerKind:                 test.DefaultIssuerKind,
 				DefaultIssuerGroup:                test.DefaultIssuerGroup,
@@ -2970,6 +3075,7 @@ func TestIssuerForIngress(t *testing.T) {
 		DefaultName   string
 		DefaultKind   string
 		DefaultGroup  string
+		NSDefaults    *certificatedefaults.Defaults
 		ExpectedName  string
 		ExpectedKind  string
 		ExpectedGroup string
@@ -3004,6 +3110,31 @@ func TestIssuerForIngress(t *testing.T) {
 			ExpectedKind:  "ClusterIssuer",
 			ExpectedGroup: "cert-manager.io",
 		},
+		{
+			Ingress: buildIngress("name", "namespace", map[string]string{
+				"kubernetes.io/tls-acme": "true",
+			}),
+			DefaultName:  "default-name",
+			DefaultKind:  "ClusterIssuer",
+			DefaultGroup: "cert-manager.io",
+			NSDefaults: &certificatedefaults.Defaults{
+				IssuerName: "namespace-name",
+				IssuerKind: "Issuer",
+			},
+			ExpectedName: "namespace-name",
+			ExpectedKind: "Issuer",
+		},
+		{
+			Ingress: buildIngress("name", "namespace", map[string]string{
+				cmapi.IngressIssuerNameAnnotationKey: "issuer",
+			}),
+			NSDefaults: &certificatedefaults.Defaults{
+				IssuerName: "namespace-name",
+				IssuerKind: "ClusterIssuer",
+			},
+			ExpectedName: "issuer",
+			ExpectedKind: "Issuer",
+		},
 		{
 			Ingress:       buildIngress("name", "namespace", nil),
 			ExpectedError: errors.New("failed to determine issuer name to be used for ingress resource"),
@@ -3029,7 +3160,7 @@ func TestIssuerForIngress(t *testing.T) {
 			DefaultIssuerName:  test.DefaultName,
 			DefaultIssuerGroup: test.DefaultGroup,
 		}
-		name, kind, group, err := issuerForIngressLike(defaults, test.Ingress)
+		name, kind, group, err := issuerForIngressLike(defaults, test.NSDefaults, test.Ingress)
 		if err != nil {
 			if test.ExpectedError == nil || err.Error() != test.ExpectedError.Error() {
 				t.Errorf("unexpected error, exp=%v got=%s", test.ExpectedError, err)
@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/labels"
 
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
@@ -66,7 +67,7 @@ func (c *Controller) certificatesRequestsForGenericIssuer(iss cmapi.GenericIssue
 			continue
 		}
 		if !isClusterIssuer {
-			if crt.Namespace != iss.GetObjectMeta().Namespace {
+			if apiutil.IssuerNamespace(crt.Spec.IssuerRef, crt.Namespace) != iss.GetObjectMeta().Namespace {
 				continue
 			}
 		}
@@ -22,13 +22,17 @@ import (
 
 	"github.com/go-logr/logr"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
+	gwlisters "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
 
+	"github.com/cert-manager/cert-manager/internal/controller/issuerbudget"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -64,6 +68,10 @@ type Controller struct {
 	// clientset used to update cert-manager API resources
 	cmClient cmclient.Interface
 
+	// namespaceLister is used to read the labels of Namespaces when a
+	// ClusterIssuer restricts the namespaces that may use it
+	namespaceLister corelisters.NamespaceLister
+
 	// fieldManager is the manager name used for the Apply operations.
 	fieldManager string
 
@@ -96,6 +104,10 @@ type Controller struct {
 	issuerConstructor IssuerConstructor
 	issuer            Issuer
 
+	// issuerBudgets enforces the rate limit and concurrency budget of the
+	// issuer before calling its sign function
+	issuerBudgets *issuerbudget.Budgets
+
 	// used for testing
 	clock clock.Clock
 
@@ -166,6 +178,12 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 		// register handler function for clusterissuer resources
 		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleGenericIssuer})
 		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+
+		// the labels of Namespaces are matched against the namespaceSelector
+		// of ClusterIssuers
+		namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+		c.namespaceLister = namespaceInformer.Lister()
+		mustSync = append(mustSync, namespaceInformer.Informer().HasSynced)
 	}
 
 	// set all the references to the listers for used by the Sync function
@@ -173,9 +191,32 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 
 	// register handler functions
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	certificateRequestInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{DeleteFunc: c.releaseIssuerBudget})
 	issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleGenericIssuer})
+	// ReferenceGrants may permit references to Issuers in other namespaces.
+	var referenceGrantLister gwlisters.ReferenceGrantLister
+	if ctx.GatewaySolverEnabled {
+		referenceGrantInformer := ctx.GWShared.Gateway().V1beta1().ReferenceGrants()
+		mustSync = append(mustSync, referenceGrantInformer.Informer().HasSynced)
+		referenceGrantLister = referenceGrantInformer.Lister()
+
+		// requeue the CertificateRequests referencing an Issuer in the
+		// namespace of a ReferenceGrant, as it may permit the reference
+		if err := controllerpkg.AddCrossNamespaceIssuerIndex(certificateRequestInformer.Informer(), func(obj interface{}) (cmmeta.ObjectReference, bool) {
+			cr, ok := obj.(*v1.CertificateRequest)
+			if !ok {
+				return cmmeta.ObjectReference{}, false
+			}
+			return cr.Spec.IssuerRef, true
+		}); err != nil {
+			return nil, nil, fmt.Errorf("failed to add CertificateRequest indexer: %w", err)
+		}
+		referenceGrantInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: controllerpkg.HandleReferenceGrantFunc(c.log, c.queue, certificateRequestInformer.Informer().GetIndexer()),
+		})
+	}
 	// create an issuer helper for reading generic issuers
-	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister)
+	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister, referenceGrantLister)
 
 	// clock is used to set the FailureTime of failed CertificateRequests
 	c.clock = ctx.Clock
@@ -184,6 +225,7 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.reporter = util.NewReporter(c.clock, c.recorder)
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
+	c.issuerBudgets = ctx.IssuerBudgets
 
 	// Construct the issuer implementation with the built component context.
 	c.issuer = c.issuerConstructor(ctx)
@@ -194,6 +236,19 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	return c.queue, mustSync, nil
 }
 
+// releaseIssuerBudget frees the slot in its issuer's budget held by a
+// CertificateRequest which is deleted before reaching a terminal state.
+func (c *Controller) releaseIssuerBudget(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	cr, ok := obj.(*v1.CertificateRequest)
+	if !ok {
+		return
+	}
+	c.issuerBudgets.Release(string(cr.UID))
+}
+
 // ProcessItem is the worker function that will be called with a new key from
 // the workqueue. A key corresponds to a certificate request object.
 func (c *Controller) ProcessItem(ctx context.Context, key string) error {
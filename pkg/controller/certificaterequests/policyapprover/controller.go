@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	ControllerName = "certificaterequests-policy-approver"
+)
+
+// Controller is a CertificateRequest controller which approves or denies
+// CertificateRequests based on CertificateRequestPolicies. Requests which are
+// not selected by any policy are left untouched. This controller is not
+// enabled by default, and is intended to replace the
+// "certificaterequests-approver" controller, which approves every request.
+type Controller struct {
+	// logger to be used by this controller
+	log logr.Logger
+
+	certificateRequestLister cmlisters.CertificateRequestLister
+	policyLister             cmlisters.CertificateRequestPolicyLister
+	cmClient                 cmclient.Interface
+	namespaceLister          corelisters.NamespaceLister
+	fieldManager             string
+
+	recorder record.EventRecorder
+
+	queue workqueue.RateLimitingInterface
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(new(Controller)).Complete()
+	})
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	policyInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequestPolicies()
+	// the labels of Namespaces are available to the validations of policies
+	namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		policyInformer.Informer().HasSynced,
+		namespaceInformer.Informer().HasSynced,
+	}
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	// A policy change may decide requests which no policy matched before.
+	policyInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueAll})
+
+	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.policyLister = policyInformer.Lister()
+	c.cmClient = ctx.CMClient
+	c.namespaceLister = namespaceInformer.Lister()
+	c.fieldManager = ctx.FieldManager
+	c.recorder = ctx.Recorder
+
+	c.log.V(logf.DebugLevel).Info("certificate request policy approver controller registered")
+
+	return c.queue, mustSync, nil
+}
+
+// enqueueAll queues every CertificateRequest.
+func (c *Controller) enqueueAll(_ interface{}) {
+	crs, err := c.certificateRequestLister.List(labels.Everything())
+	if err != nil {
+		c.log.Error(err, "failed to list certificate requests")
+		return
+	}
+	for _, cr := range crs {
+		key, err := controllerpkg.KeyFunc(cr)
+		if err != nil {
+			c.log.Error(err, "failed to compute key for certificate request")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key")
+		return nil
+	}
+
+	cr, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info(fmt.Sprintf("certificate request in work queue no longer exists: %s", err))
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, cr))
+	return c.Sync(ctx, cr)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/cert-manager/cert-manager/internal/controller/approverpolicy"
+	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+// Sync evaluates the CertificateRequest against all CertificateRequestPolicies
+// and sets the "Approved" or "Denied" condition to True accordingly. If the
+// request has already been decided or is no longer pending, or no policy
+// selects it, it is left untouched.
+func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
+	log := logf.FromContext(ctx, "policy-approver")
+
+	switch {
+	case
+		apiutil.CertificateRequestIsApproved(cr),
+		apiutil.CertificateRequestIsDenied(cr),
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonFailed,
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonIssued:
+		return nil
+	}
+
+	req, err := approverpolicy.RequestFromCertificateRequest(cr)
+	if err != nil {
+		// The request is malformed and will be failed by its issuer's
+		// controller, so there is no point in retrying.
+		log.Error(err, "failed to build policy view of certificate request")
+		return nil
+	}
+
+	policies, err := c.policyLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	if req.Namespace != "" && approverpolicy.NeedNamespaceLabels(policies) {
+		ns, err := c.namespaceLister.Get(req.Namespace)
+		if err != nil {
+			return err
+		}
+		req.NamespaceLabels = ns.Labels
+	}
+
+	decision := approverpolicy.Evaluate(policies, req)
+	if !decision.Matched {
+		log.V(logf.DebugLevel).Info("no certificate request policy selects this request")
+		return nil
+	}
+
+	cr = cr.DeepCopy()
+	condition, eventType := cmapi.CertificateRequestConditionDenied, corev1.EventTypeWarning
+	if decision.Approved {
+		condition, eventType = cmapi.CertificateRequestConditionApproved, corev1.EventTypeNormal
+	}
+	apiutil.SetCertificateRequestCondition(cr, condition, cmmeta.ConditionTrue, approverpolicy.Reason, decision.Message)
+
+	if err := c.updateStatusOrApply(ctx, cr); err != nil {
+		return err
+	}
+	c.recorder.Event(cr, eventType, approverpolicy.Reason, decision.Message)
+
+	log.V(logf.DebugLevel).Info("decided certificate request", "approved", decision.Approved, "message", decision.Message)
+
+	return nil
+}
+
+func (c *Controller) updateStatusOrApply(ctx context.Context, cr *cmapi.CertificateRequest) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		return internalcertificaterequests.ApplyStatus(ctx, c.cmClient, c.fieldManager, cr)
+	} else {
+		_, err := c.cmClient.CertmanagerV1().CertificateRequests(cr.Namespace).UpdateStatus(ctx, cr, metav1.UpdateOptions{})
+		return err
+	}
+}
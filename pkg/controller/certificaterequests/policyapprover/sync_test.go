@@ -0,0 +1,217 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	// now time is the current time at the start of the test (the clock is fixed)
+	now := time.Now()
+	metaNow := metav1.NewTime(now)
+
+	csrPEM, _, err := gen.CSR(x509.RSA, gen.SetCSRDNSNames("app.team-a.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseRequest := gen.CertificateRequest("test",
+		gen.SetCertificateRequestNamespace("team-a"),
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca", Kind: cmapi.ClusterIssuerKind}),
+		gen.SetCertificateRequestUsername("system:serviceaccount:team-a:app"),
+	)
+
+	selector := cmapi.CertificateRequestPolicySelector{
+		IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Name: "ca", Kind: cmapi.ClusterIssuerKind},
+	}
+	allowTeamA := &cmapi.CertificateRequestPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: cmapi.CertificateRequestPolicySpec{
+			Selector: selector,
+			Allowed: &cmapi.CertificateRequestPolicyAllowed{
+				DNSNames: []string{"*.team-a.example.com"},
+				Usages:   cmapi.DefaultKeyUsages(),
+			},
+		},
+	}
+	allowTeamB := &cmapi.CertificateRequestPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-b"},
+		Spec: cmapi.CertificateRequestPolicySpec{
+			Selector: selector,
+			Allowed: &cmapi.CertificateRequestPolicyAllowed{
+				DNSNames: []string{"*.team-b.example.com"},
+				Usages:   cmapi.DefaultKeyUsages(),
+			},
+		},
+	}
+	otherIssuer := &cmapi.CertificateRequestPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+		Spec: cmapi.CertificateRequestPolicySpec{
+			Selector: cmapi.CertificateRequestPolicySelector{
+				IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Name: "vault"},
+			},
+		},
+	}
+
+	teamANamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}}
+	validateTeamB := &cmapi.CertificateRequestPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-b-namespaces"},
+		Spec: cmapi.CertificateRequestPolicySpec{
+			Selector: selector,
+			Allowed:  allowTeamA.Spec.Allowed,
+			Validations: []cmapi.CertificateRequestPolicyValidation{
+				{Expression: `namespaceLabels["team"] == "b"`, Message: "namespace must belong to team b"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request  *cmapi.CertificateRequest
+		policies []runtime.Object
+
+		// namespace, if set, is expected to be fetched to evaluate
+		// validations.
+		namespace *corev1.Namespace
+
+		// expectedEvent, if set, is an 'event string' that is expected to be fired.
+		expectedEvent string
+
+		// expectedCondition is the condition expected to be added to the
+		// CertificateRequest. If nil, no update is expected.
+		expectedCondition *cmapi.CertificateRequestCondition
+	}{
+		"do nothing if no policy selects the request": {
+			request:  baseRequest,
+			policies: []runtime.Object{otherIssuer},
+		},
+		"do nothing if CertificateRequest already has 'Denied' True condition": {
+			request: gen.CertificateRequestFrom(baseRequest,
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:   cmapi.CertificateRequestConditionDenied,
+					Status: cmmeta.ConditionTrue,
+				}),
+			),
+			policies: []runtime.Object{allowTeamA},
+		},
+		"approve if a matching policy allows the request": {
+			request:  baseRequest,
+			policies: []runtime.Object{allowTeamA, allowTeamB, otherIssuer},
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:               cmapi.CertificateRequestConditionApproved,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "policy.cert-manager.io",
+				Message:            `Approved by CertificateRequestPolicy "team-a"`,
+				LastTransitionTime: &metaNow,
+			},
+			expectedEvent: `Normal policy.cert-manager.io Approved by CertificateRequestPolicy "team-a"`,
+		},
+		"deny if no matching policy allows the request": {
+			request:  baseRequest,
+			policies: []runtime.Object{allowTeamB, otherIssuer},
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:               cmapi.CertificateRequestConditionDenied,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "policy.cert-manager.io",
+				Message:            `Denied by all matching policies: [CertificateRequestPolicy "team-b": spec.allowed.dnsNames: "app.team-a.example.com" is not allowed]`,
+				LastTransitionTime: &metaNow,
+			},
+			expectedEvent: `Warning policy.cert-manager.io Denied by all matching policies: [CertificateRequestPolicy "team-b": spec.allowed.dnsNames: "app.team-a.example.com" is not allowed]`,
+		},
+		"deny if a validation of the matching policy fails": {
+			request:   baseRequest,
+			policies:  []runtime.Object{validateTeamB},
+			namespace: teamANamespace,
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:               cmapi.CertificateRequestConditionDenied,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "policy.cert-manager.io",
+				Message:            `Denied by all matching policies: [CertificateRequestPolicy "team-b-namespaces": spec.validations[0]: namespace must belong to team b]`,
+				LastTransitionTime: &metaNow,
+			},
+			expectedEvent: `Warning policy.cert-manager.io Denied by all matching policies: [CertificateRequestPolicy "team-b-namespaces": spec.validations[0]: namespace must belong to team b]`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: append([]runtime.Object{test.request}, test.policies...),
+			}
+			if test.namespace != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.namespace)
+			}
+			builder.Init()
+
+			c := new(Controller)
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			if test.expectedCondition != nil {
+				expectedRequest := test.request.DeepCopy()
+				expectedRequest.Status.Conditions = append(expectedRequest.Status.Conditions, *test.expectedCondition)
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						test.request.Namespace,
+						expectedRequest,
+					)),
+				)
+			}
+			if test.expectedEvent != "" {
+				builder.ExpectedEvents = []string{test.expectedEvent}
+			}
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.ProcessItem(context.Background(), key); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllReactorsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	gwlisters "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
@@ -74,18 +75,26 @@ func init() {
 				func(ctx *controllerpkg.Context, log logr.Logger, queue workqueue.RateLimitingInterface) ([]cache.InformerSynced, error) {
 					secretInformer := ctx.KubeSharedInformerFactory.Secrets().Informer()
 					certificateRequestLister := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests().Lister()
+					mustSync := []cache.InformerSynced{
+						secretInformer.HasSynced,
+						ctx.SharedInformerFactory.Certmanager().V1().Issuers().Informer().HasSynced,
+						ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Informer().HasSynced,
+					}
+					var referenceGrantLister gwlisters.ReferenceGrantLister
+					if ctx.GatewaySolverEnabled {
+						referenceGrantInformer := ctx.GWShared.Gateway().V1beta1().ReferenceGrants()
+						mustSync = append(mustSync, referenceGrantInformer.Informer().HasSynced)
+						referenceGrantLister = referenceGrantInformer.Lister()
+					}
 					helper := issuer.NewHelper(
 						ctx.SharedInformerFactory.Certmanager().V1().Issuers().Lister(),
 						ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Lister(),
+						referenceGrantLister,
 					)
 					secretInformer.AddEventHandler(&controllerpkg.BlockingEventHandler{
 						WorkFunc: handleSecretReferenceWorkFunc(log, certificateRequestLister, helper, queue),
 					})
-					return []cache.InformerSynced{
-						secretInformer.HasSynced,
-						ctx.SharedInformerFactory.Certmanager().V1().Issuers().Informer().HasSynced,
-						ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Informer().HasSynced,
-					}, nil
+					return mustSync, nil
 				},
 			)).
 			Complete()
@@ -18,6 +18,7 @@ package certificaterequests
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -30,10 +31,14 @@ import (
 
 	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/controller/issuerbudget"
+	"github.com/cert-manager/cert-manager/internal/controller/issuers"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -53,6 +58,13 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	defer func() {
 		if saveErr := c.updateCertificateRequestStatusAndAnnotations(ctx, cr, crCopy); saveErr != nil {
 			err = utilerrors.NewAggregate([]error{saveErr, err})
+			return
+		}
+		// A request holds its slot in the issuer's budget until it reaches a
+		// terminal state, so it is only charged once however often it is
+		// synced whilst the issuer completes it.
+		if certificateRequestIsTerminal(crCopy) {
+			c.issuerBudgets.Release(string(cr.UID))
 		}
 	}()
 
@@ -95,6 +107,12 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	if errors.Is(err, issuer.ErrReferenceNotPermitted) {
+		c.reporter.Pending(crCopy, err, "IssuerReferenceNotPermitted",
+			"Referenced Issuer is in another namespace and no ReferenceGrant permits the reference")
+		return nil
+	}
+
 	if err != nil {
 		log.Error(err, "failed to get issuer")
 		return err
@@ -118,6 +136,19 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	// ClusterIssuers may restrict the namespaces that can use them. This is
+	// also enforced by the webhook, but the namespaceSelector or the labels
+	// of the namespace may have changed since the request was created.
+	allowed, err := issuers.NamespaceAllowed(c.namespaceLister, issuerObj, crCopy.Namespace)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		c.reporter.Failed(crCopy, fmt.Errorf("namespace %q does not match the namespaceSelector of ClusterIssuer %q", crCopy.Namespace, issuerObj.GetName()),
+			"NamespaceNotAllowed", "Referenced ClusterIssuer does not allow CertificateRequests in this namespace")
+		return nil
+	}
+
 	// check ready condition
 	if !apiutil.IssuerHasCondition(issuerObj, cmapi.IssuerCondition{
 		Type:   cmapi.IssuerConditionReady,
@@ -135,6 +166,24 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	// Continue the trace of the Certificate revision this request was
+	// created for, if any.
+	ctx, span := tracing.Start(tracing.ContextFromAnnotations(ctx, cr), "certificaterequests-issuer-"+c.issuerType, tracing.WithResource(cr))
+	defer func() { tracing.End(span, err) }()
+
+	// Wait until the issuer's rate limit and concurrency budget permit
+	// another signing request.
+	if err := c.issuerBudgets.Acquire(issuerObj, string(cr.UID)); err != nil {
+		var exhausted *issuerbudget.ExhaustedError
+		if !errors.As(err, &exhausted) {
+			return err
+		}
+		dbg.Info("issuer budget exhausted, waiting before signing", "retry_after", exhausted.RetryAfter)
+		c.reporter.Pending(crCopy, nil, "IssuerRateLimited", exhausted.Error())
+		c.queue.AddAfter(cr.Namespace+"/"+cr.Name, exhausted.RetryAfter)
+		return nil
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	// Attempt to call the Sign function on our issuer
@@ -168,6 +217,19 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	return nil
 }
 
+// certificateRequestIsTerminal returns true if the CertificateRequest will not
+// be processed further by its issuer.
+func certificateRequestIsTerminal(cr *cmapi.CertificateRequest) bool {
+	if apiutil.CertificateRequestIsDenied(cr) || apiutil.CertificateRequestHasInvalidRequest(cr) {
+		return true
+	}
+	switch apiutil.CertificateRequestReadyReason(cr) {
+	case cmapi.CertificateRequestReasonFailed, cmapi.CertificateRequestReasonIssued, cmapi.CertificateRequestReasonDenied:
+		return true
+	}
+	return false
+}
+
 func (c *Controller) updateCertificateRequestStatusAndAnnotations(ctx context.Context, old, new *cmapi.CertificateRequest) error {
 	log := logf.FromContext(ctx, "updateStatus")
 
@@ -27,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
@@ -151,6 +152,31 @@ func TestSync(t *testing.T) {
 		}),
 	)
 
+	restrictedClusterIssuer := gen.ClusterIssuer("test-cluster-issuer",
+		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+	restrictedClusterIssuer.Spec.NamespaceSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"team": "a"},
+	}
+	restrictedClusterIssuerCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Kind: cmapi.ClusterIssuerKind,
+			Name: restrictedClusterIssuer.Name,
+		}),
+	)
+
+	crossNamespaceCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Kind:      cmapi.IssuerKind,
+			Name:      baseIssuer.Name,
+			Namespace: "issuers",
+		}),
+	)
+
 	certRSAPEM := generateSelfSignedCert(t, baseCR, skRSA, fixedClockStart, fixedClockStart.Add(time.Hour*12))
 	certRSAPEMExpired := generateSelfSignedCert(t, baseCR, skRSA, fixedClockStart.Add(-time.Hour*13), fixedClockStart.Add(-time.Hour*12))
 
@@ -419,6 +445,33 @@ func TestSync(t *testing.T) {
 				ExpectedActions:    []testpkg.Action{},
 			},
 		},
+		"should report pending if a cross-namespace issuer reference is not permitted": {
+			certificateRequest: crossNamespaceCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{crossNamespaceCR,
+					gen.IssuerFrom(baseIssuer, gen.SetIssuerNamespace("issuers")),
+				},
+				ExpectedEvents: []string{
+					`Normal IssuerReferenceNotPermitted Referenced Issuer is in another namespace and no ReferenceGrant permits the reference: cross-namespace issuer reference is not permitted: Gateway API support must be enabled to use ReferenceGrants`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(crossNamespaceCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Pending",
+								Message:            `Referenced Issuer is in another namespace and no ReferenceGrant permits the reference: cross-namespace issuer reference is not permitted: Gateway API support must be enabled to use ReferenceGrants`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
 		"should report pending if issuer not found": {
 			certificateRequest: baseCR.DeepCopy(),
 			builder: &testpkg.Builder{
@@ -515,6 +568,44 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		"should fail if the namespace is not allowed by the namespaceSelector of the ClusterIssuer": {
+			certificateRequest: restrictedClusterIssuerCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: gen.DefaultTestNamespace, Labels: map[string]string{"team": "b"}}},
+				},
+				CertManagerObjects: []runtime.Object{restrictedClusterIssuer, restrictedClusterIssuerCR},
+				ExpectedEvents: []string{
+					`Warning NamespaceNotAllowed Referenced ClusterIssuer does not allow CertificateRequests in this namespace: namespace "default-unit-test-ns" does not match the namespaceSelector of ClusterIssuer "test-cluster-issuer"`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(restrictedClusterIssuerCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Failed",
+								Message:            `Referenced ClusterIssuer does not allow CertificateRequests in this namespace: namespace "default-unit-test-ns" does not match the namespaceSelector of ClusterIssuer "test-cluster-issuer"`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestFailureTime(nowMetaTime),
+						),
+					)),
+				},
+			},
+		},
+		"should return error to try again if the namespace is not found when checking the namespaceSelector of the ClusterIssuer": {
+			certificateRequest: restrictedClusterIssuerCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{restrictedClusterIssuer, restrictedClusterIssuerCR},
+				ExpectedEvents:     []string{},
+				ExpectedActions:    []testpkg.Action{},
+			},
+			expectedErr: true,
+		},
 		"exit nil and no action if the issuer type does not match ours (its not meant for us)": {
 			certificateRequest: baseCR.DeepCopy(),
 			builder: &testpkg.Builder{
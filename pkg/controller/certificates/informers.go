@@ -18,11 +18,15 @@ package certificates
 
 import (
 	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -67,3 +71,108 @@ func EnqueueCertificatesForResourceUsingPredicates(log logr.Logger, queue workqu
 		}
 	}
 }
+
+// IssuerRefIndex is the name of the index of Certificates by the Issuer or
+// ClusterIssuer referenced by their `spec.issuerRef`.
+const IssuerRefIndex = "certificates.issuerRef"
+
+// AddIssuerRefIndex adds the IssuerRefIndex to the given Certificate
+// informer. The informer is shared by several controllers, so the index is
+// only added if it is not present yet.
+func AddIssuerRefIndex(informer cache.SharedIndexInformer) error {
+	if _, ok := informer.GetIndexer().GetIndexers()[IssuerRefIndex]; ok {
+		return nil
+	}
+	return informer.AddIndexers(cache.Indexers{IssuerRefIndex: issuerRefIndexFunc})
+}
+
+// issuerRefIndexFunc returns the IssuerRefIndex key of the cert-manager.io
+// issuer referenced by a Certificate.
+func issuerRefIndexFunc(obj interface{}) ([]string, error) {
+	crt, ok := obj.(*cmapi.Certificate)
+	if !ok {
+		return nil, nil
+	}
+	ref := crt.Spec.IssuerRef
+	if ref.Group != "" && ref.Group != cmapi.SchemeGroupVersion.Group {
+		return nil, nil
+	}
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		return []string{issuerRefIndexKey(cmapi.IssuerKind, apiutil.IssuerNamespace(ref, crt.Namespace), ref.Name)}, nil
+	case cmapi.ClusterIssuerKind:
+		return []string{issuerRefIndexKey(cmapi.ClusterIssuerKind, "", ref.Name)}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// issuerRefIndexKey returns the IssuerRefIndex key of an issuer. The
+// namespace of a ClusterIssuer is empty.
+func issuerRefIndexKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// EnqueueCertificatesForIssuerRenewalWindows returns an event handler for
+// Issuers and ClusterIssuers which enqueues the Certificates referencing an
+// issuer when its default renewal windows change. The Certificates are found
+// using the IssuerRefIndex of the given Certificate indexer.
+func EnqueueCertificatesForIssuerRenewalWindows(log logr.Logger, queue workqueue.Interface, indexer cache.Indexer) cache.ResourceEventHandler {
+	enqueue := func(obj interface{}) {
+		var key string
+		switch iss := obj.(type) {
+		case *cmapi.Issuer:
+			key = issuerRefIndexKey(cmapi.IssuerKind, iss.Namespace, iss.Name)
+		case *cmapi.ClusterIssuer:
+			key = issuerRefIndexKey(cmapi.ClusterIssuerKind, "", iss.Name)
+		default:
+			log.V(logf.ErrorLevel).Info("Non-issuer type resource passed to EnqueueCertificatesForIssuerRenewalWindows")
+			return
+		}
+
+		certs, err := indexer.ByIndex(IssuerRefIndex, key)
+		if err != nil {
+			log.Error(err, "Failed listing Certificate resources")
+			return
+		}
+		for _, cert := range certs {
+			key, err := controllerpkg.KeyFunc(cert)
+			if err != nil {
+				log.Error(err, "Error determining 'key' for resource")
+				continue
+			}
+			queue.Add(key)
+		}
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if issuerRenewalWindows(obj) != nil {
+				enqueue(obj)
+			}
+		},
+		UpdateFunc: func(old, new interface{}) {
+			if !apiequality.Semantic.DeepEqual(issuerRenewalWindows(old), issuerRenewalWindows(new)) {
+				enqueue(new)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if issuerRenewalWindows(obj) != nil {
+				enqueue(obj)
+			}
+		},
+	}
+}
+
+// issuerRenewalWindows returns the default renewal windows of an Issuer or
+// ClusterIssuer.
+func issuerRenewalWindows(obj interface{}) *cmapi.RenewalWindows {
+	iss, ok := obj.(cmapi.GenericIssuer)
+	if !ok {
+		return nil
+	}
+	return iss.GetSpec().RenewalWindows
+}
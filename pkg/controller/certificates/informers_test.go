@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestEnqueueCertificatesForIssuerRenewalWindows(t *testing.T) {
+	windows := &cmapi.RenewalWindows{Windows: []cmapi.RenewalWindow{{Schedule: "0 9 * * 1-5"}}}
+	otherWindows := &cmapi.RenewalWindows{Windows: []cmapi.RenewalWindow{{Schedule: "0 22 * * *"}}}
+
+	issuer := gen.Issuer("issuer", gen.SetIssuerNamespace("ns"))
+	issuerWithWindows := gen.IssuerFrom(issuer, gen.SetIssuerRenewalWindows(windows))
+	clusterIssuerWithWindows := gen.ClusterIssuer("issuer", gen.SetIssuerRenewalWindows(windows))
+
+	certs := []*cmapi.Certificate{
+		gen.Certificate("issuer", gen.SetCertificateNamespace("ns"), gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer"})),
+		gen.Certificate("issuer-kind", gen.SetCertificateNamespace("ns"), gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer", Kind: cmapi.IssuerKind, Group: "cert-manager.io"})),
+		gen.Certificate("cross-namespace", gen.SetCertificateNamespace("app"), gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer", Namespace: "ns"})),
+		gen.Certificate("other-namespace", gen.SetCertificateNamespace("app"), gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer"})),
+		gen.Certificate("cluster-issuer", gen.SetCertificateNamespace("app"), gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer", Kind: cmapi.ClusterIssuerKind})),
+		gen.Certificate("external-issuer", gen.SetCertificateNamespace("ns"), gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "issuer", Kind: cmapi.IssuerKind, Group: "example.com"})),
+	}
+
+	tests := map[string]struct {
+		event        func(h cache.ResourceEventHandler)
+		expectedKeys []string
+	}{
+		"should enqueue the Certificates of an added Issuer with renewal windows": {
+			event:        func(h cache.ResourceEventHandler) { h.OnAdd(issuerWithWindows) },
+			expectedKeys: []string{"app/cross-namespace", "ns/issuer", "ns/issuer-kind"},
+		},
+		"should not enqueue anything for an added Issuer without renewal windows": {
+			event: func(h cache.ResourceEventHandler) { h.OnAdd(issuer) },
+		},
+		"should enqueue the Certificates of an Issuer whose renewal windows are set": {
+			event:        func(h cache.ResourceEventHandler) { h.OnUpdate(issuer, issuerWithWindows) },
+			expectedKeys: []string{"app/cross-namespace", "ns/issuer", "ns/issuer-kind"},
+		},
+		"should enqueue the Certificates of an Issuer whose renewal windows change": {
+			event: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(issuerWithWindows, gen.IssuerFrom(issuer, gen.SetIssuerRenewalWindows(otherWindows)))
+			},
+			expectedKeys: []string{"app/cross-namespace", "ns/issuer", "ns/issuer-kind"},
+		},
+		"should not enqueue anything for an Issuer update which doesn't change its renewal windows": {
+			event: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(issuerWithWindows, gen.IssuerFrom(issuerWithWindows, gen.SetIssuerACMEURL("https://acme.example.com")))
+			},
+		},
+		"should enqueue the Certificates of a deleted Issuer with renewal windows": {
+			event:        func(h cache.ResourceEventHandler) { h.OnDelete(cache.DeletedFinalStateUnknown{Obj: issuerWithWindows}) },
+			expectedKeys: []string{"app/cross-namespace", "ns/issuer", "ns/issuer-kind"},
+		},
+		"should enqueue the Certificates of a ClusterIssuer whose renewal windows are set": {
+			event:        func(h cache.ResourceEventHandler) { h.OnUpdate(gen.ClusterIssuer("issuer"), clusterIssuerWithWindows) },
+			expectedKeys: []string{"app/cluster-issuer"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{IssuerRefIndex: issuerRefIndexFunc})
+			for _, crt := range certs {
+				require.NoError(t, indexer.Add(crt))
+			}
+			queue := workqueue.New()
+			defer queue.ShutDown()
+
+			test.event(EnqueueCertificatesForIssuerRenewalWindows(logf.Log, queue, indexer))
+
+			var actualKeys []string
+			for queue.Len() > 0 {
+				key, _ := queue.Get()
+				actualKeys = append(actualKeys, key.(string))
+				queue.Done(key)
+			}
+			sort.Strings(actualKeys)
+			assert.Equal(t, test.expectedKeys, actualKeys)
+		})
+	}
+}
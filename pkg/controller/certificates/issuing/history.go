@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// historyLimit is the maximum number of entries kept in a Certificate's
+// status.history.
+const historyLimit = 10
+
+// recordHistory adds an entry for the certificate issued by the given
+// CertificateRequest to the start of the Certificate's status.history,
+// dropping the oldest entries once historyLimit is reached. The reason for
+// the issuance is taken from the Certificate's Issuing condition, so this
+// must be called before that condition is removed.
+func recordHistory(crt *cmapi.Certificate, revision int, req *cmapi.CertificateRequest) {
+	entry := cmapi.CertificateHistoryEntry{
+		Revision:  revision,
+		IssuerRef: req.Spec.IssuerRef,
+	}
+
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil {
+		entry.Reason = cond.Reason
+		entry.Message = cond.Message
+	}
+
+	// The certificate has already been validated against the private key by
+	// this point, so a decoding error is unexpected; the entry is still
+	// recorded without the details of the certificate.
+	if x509Cert, err := utilpki.DecodeX509CertificateBytes(req.Status.Certificate); err == nil {
+		notBefore := metav1.NewTime(x509Cert.NotBefore)
+		notAfter := metav1.NewTime(x509Cert.NotAfter)
+		fingerprint := sha256.Sum256(x509Cert.RawSubjectPublicKeyInfo)
+
+		entry.SerialNumber = hex.EncodeToString(x509Cert.SerialNumber.Bytes())
+		entry.NotBefore = &notBefore
+		entry.NotAfter = &notAfter
+		entry.PublicKeyFingerprint = hex.EncodeToString(fingerprint[:])
+	}
+
+	history := make([]cmapi.CertificateHistoryEntry, 0, len(crt.Status.History)+1)
+	history = append(history, entry)
+	for _, existing := range crt.Status.History {
+		// A revision is only issued once, but guard against duplicates in
+		// case a previous status update was only partially observed.
+		if existing.Revision == revision {
+			continue
+		}
+		history = append(history, existing)
+	}
+	if len(history) > historyLimit {
+		history = history[:historyLimit]
+	}
+	crt.Status.History = history
+}
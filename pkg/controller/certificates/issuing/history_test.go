@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestRecordHistory(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	notBefore := time.Date(2023, 3, 15, 9, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(90 * 24 * time.Hour)
+	certPEM := testcrypto.MustCreateCertWithNotBeforeAfter(t, pk,
+		gen.Certificate("test", gen.SetCertificateCommonName("example.com")), notBefore, notAfter)
+	x509Cert, err := utilpki.DecodeX509CertificateBytes(certPEM)
+	require.NoError(t, err)
+	fingerprint := sha256.Sum256(x509Cert.RawSubjectPublicKeyInfo)
+
+	issuerRef := cmmeta.ObjectReference{Name: "ca-issuer", Kind: cmapi.ClusterIssuerKind}
+	req := gen.CertificateRequest("test-3",
+		gen.SetCertificateRequestIssuer(issuerRef),
+		gen.SetCertificateRequestCertificate(certPEM),
+	)
+
+	oldEntries := func(revisions ...int) []cmapi.CertificateHistoryEntry {
+		var entries []cmapi.CertificateHistoryEntry
+		for _, r := range revisions {
+			entries = append(entries, cmapi.CertificateHistoryEntry{Revision: r})
+		}
+		return entries
+	}
+	expEntry := cmapi.CertificateHistoryEntry{
+		Revision:             12,
+		SerialNumber:         hex.EncodeToString(x509Cert.SerialNumber.Bytes()),
+		NotBefore:            &metav1.Time{Time: x509Cert.NotBefore},
+		NotAfter:             &metav1.Time{Time: x509Cert.NotAfter},
+		IssuerRef:            issuerRef,
+		PublicKeyFingerprint: hex.EncodeToString(fingerprint[:]),
+		Reason:               "Renewing",
+		Message:              "Renewing certificate as renewal was scheduled",
+	}
+
+	tests := map[string]struct {
+		history    []cmapi.CertificateHistoryEntry
+		expHistory []cmapi.CertificateHistoryEntry
+	}{
+		"first issuance creates the history": {
+			history:    nil,
+			expHistory: []cmapi.CertificateHistoryEntry{expEntry},
+		},
+		"new entries are added first": {
+			history:    oldEntries(11, 10),
+			expHistory: append([]cmapi.CertificateHistoryEntry{expEntry}, oldEntries(11, 10)...),
+		},
+		"oldest entries are dropped once the limit is reached": {
+			history:    oldEntries(11, 10, 9, 8, 7, 6, 5, 4, 3, 2),
+			expHistory: append([]cmapi.CertificateHistoryEntry{expEntry}, oldEntries(11, 10, 9, 8, 7, 6, 5, 4, 3)...),
+		},
+		"existing entries for the same revision are replaced": {
+			history:    oldEntries(12, 11),
+			expHistory: append([]cmapi.CertificateHistoryEntry{expEntry}, oldEntries(11)...),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.Certificate("test",
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:    cmapi.CertificateConditionIssuing,
+					Status:  cmmeta.ConditionTrue,
+					Reason:  "Renewing",
+					Message: "Renewing certificate as renewal was scheduled",
+				}),
+			)
+			crt.Status.History = test.history
+			recordHistory(crt, 12, req)
+			assert.Equal(t, test.expHistory, crt.Status.History)
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -93,11 +94,14 @@ func NewController(
 	ctx *controllerpkg.Context,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 
-	// create a queue used to queue up items to be processed
-	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
-
 	// obtain references to all the informers used by this controller
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+
+	// create a queue used to queue up items to be processed, handing out
+	// Certificates that are closest to needing renewal first
+	queue := controllerpkg.NewPriorityRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName,
+		certificates.RenewalDeadline(certificateInformer.Lister()))
+
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
 
@@ -379,7 +383,10 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 // issueCertificate will ensure the public key of the CSR matches the signed
 // certificate, and then store the certificate, CA and private key into the
 // Secret in the appropriate format type.
-func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer) error {
+func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer) (err error) {
+	ctx, span := tracing.Start(tracing.ContextForCertificateRevision(ctx, crt), ControllerName, tracing.WithResource(crt))
+	defer func() { tracing.End(span, err) }()
+
 	crt = crt.DeepCopy()
 	if crt.Spec.PrivateKey == nil {
 		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
@@ -399,6 +406,9 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		return err
 	}
 
+	// Record the issued certificate in status.history
+	recordHistory(crt, nextRevision, req)
+
 	//Set status.revision to revision of the CertificateRequest
 	crt.Status.Revision = &nextRevision
 
@@ -451,6 +461,7 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 			Status: cmapi.CertificateStatus{
 				Revision:        crt.Status.Revision,
 				LastFailureTime: crt.Status.LastFailureTime,
+				History:         crt.Status.History,
 				Conditions:      conditions,
 			},
 		})
@@ -18,6 +18,8 @@ package issuing
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -36,6 +38,7 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
@@ -77,6 +80,22 @@ func TestIssuingController(t *testing.T) {
 	exampleBundleAlt := testcrypto.MustCreateCryptoBundle(t, baseCert.DeepCopy(), fixedClock)
 	metaFixedClockStart := metav1.NewTime(fixedClockStart)
 
+	// exampleHistoryEntry is recorded in status.history when the ready
+	// CertificateRequest of exampleBundle is issued for revision 2.
+	exampleX509Cert, err := utilpki.DecodeX509CertificateBytes(exampleBundle.CertificateRequestReady.Status.Certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exampleFingerprint := sha256.Sum256(exampleX509Cert.RawSubjectPublicKeyInfo)
+	exampleHistoryEntry := cmapi.CertificateHistoryEntry{
+		Revision:             2,
+		SerialNumber:         hex.EncodeToString(exampleX509Cert.SerialNumber.Bytes()),
+		NotBefore:            &metav1.Time{Time: exampleX509Cert.NotBefore},
+		NotAfter:             &metav1.Time{Time: exampleX509Cert.NotAfter},
+		IssuerRef:            exampleBundle.CertificateRequestReady.Spec.IssuerRef,
+		PublicKeyFingerprint: hex.EncodeToString(exampleFingerprint[:]),
+	}
+
 	issuingCert := gen.CertificateFrom(baseCert.DeepCopy(),
 		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
 			Type:               cmapi.CertificateConditionIssuing,
@@ -499,6 +518,7 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateHistory(exampleHistoryEntry),
 						),
 					)),
 				},
@@ -553,6 +573,7 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateHistory(exampleHistoryEntry),
 						),
 					)),
 				},
@@ -606,6 +627,7 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateHistory(exampleHistoryEntry),
 						),
 					)),
 				},
@@ -660,6 +682,7 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateHistory(exampleHistoryEntry),
 						),
 					)),
 				},
@@ -937,6 +960,7 @@ func TestIssuingController(t *testing.T) {
 								cmapi.IssueTemporaryCertificateAnnotation: "true",
 							}),
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateHistory(exampleHistoryEntry),
 						),
 					)),
 				},
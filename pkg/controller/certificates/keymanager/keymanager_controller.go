@@ -36,6 +36,7 @@ import (
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -266,7 +267,10 @@ func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context
 	return c.setNextPrivateKeySecretName(ctx, crt, &nextPkSecret.Name)
 }
 
-func (c *controller) createAndSetNextPrivateKey(ctx context.Context, crt *cmapi.Certificate) error {
+func (c *controller) createAndSetNextPrivateKey(ctx context.Context, crt *cmapi.Certificate) (err error) {
+	ctx, span := tracing.Start(tracing.ContextForCertificateRevision(ctx, crt), ControllerName, tracing.WithResource(crt))
+	defer func() { tracing.End(span, err) }()
+
 	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
 	if err != nil {
 		return err
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+)
+
+// RenewalDeadline returns a DeadlineFunc that orders Certificate work queue
+// keys by how urgently the Certificate needs to be renewed, so that after a
+// restart or an outage Certificates close to expiry are processed before
+// those that are not.
+// The deadline of a Certificate is its status.renewalTime, falling back to
+// status.notAfter. Certificates that have never been issued, and keys that
+// cannot be found in the lister, are the most urgent.
+func RenewalDeadline(lister cmlisters.CertificateLister) controllerpkg.DeadlineFunc {
+	return func(item interface{}) time.Time {
+		key, ok := item.(string)
+		if !ok {
+			return time.Time{}
+		}
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return time.Time{}
+		}
+		crt, err := lister.Certificates(namespace).Get(name)
+		if err != nil {
+			return time.Time{}
+		}
+
+		switch {
+		case crt.Status.RenewalTime != nil:
+			return crt.Status.RenewalTime.Time
+		case crt.Status.NotAfter != nil:
+			return crt.Status.NotAfter.Time
+		default:
+			return time.Time{}
+		}
+	}
+}
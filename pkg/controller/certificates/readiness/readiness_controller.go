@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
@@ -51,6 +52,8 @@ const (
 	ControllerName = "certificates-readiness"
 	// ReadyReason is the 'Ready' reason of a Certificate.
 	ReadyReason = "Ready"
+	// SuspendedReason is the 'Suspended' reason of a Certificate.
+	SuspendedReason = "Suspended"
 )
 
 type controller struct {
@@ -65,6 +68,7 @@ type controller struct {
 	policyEvaluator policyEvaluatorFunc
 	// renewalTimeCalculator calculates renewal time of a certificate
 	renewalTimeCalculator pki.RenewalTimeFunc
+	clock                 clock.Clock
 
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
@@ -82,7 +86,7 @@ func NewController(
 	chain policies.Chain,
 	renewalTimeCalculator pki.RenewalTimeFunc,
 	policyEvaluator policyEvaluatorFunc,
-) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
 	// create a queue used to queue up items to be processed
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
 
@@ -112,20 +116,42 @@ func NewController(
 		certificateInformer.Informer().HasSynced,
 	}
 
+	// Issuers and ClusterIssuers may define default renewal windows for the
+	// Certificates that reference them.
+	gatherer := &policies.Gatherer{
+		CertificateRequestLister: certificateRequestInformer.Lister(),
+		SecretLister:             secretsInformer.Lister(),
+	}
+	if err := certificates.AddIssuerRefIndex(certificateInformer.Informer()); err != nil {
+		return nil, nil, nil, err
+	}
+	// only the Certificates of issuers whose renewal windows changed are enqueued
+	issuerHandler := certificates.EnqueueCertificatesForIssuerRenewalWindows(log, queue, certificateInformer.Informer().GetIndexer())
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	issuerInformer.Informer().AddEventHandler(issuerHandler)
+	gatherer.IssuerLister = issuerInformer.Lister()
+	mustSync = append(mustSync, issuerInformer.Informer().HasSynced)
+	// if we are running in non-namespaced mode (i.e. --namespace=""), we also
+	// register event handlers and obtain a lister for clusterissuers.
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerInformer.Informer().AddEventHandler(issuerHandler)
+		gatherer.ClusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
 	return &controller{
 		policyChain:              chain,
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
 		client:                   ctx.CMClient,
-		gatherer: &policies.Gatherer{
-			CertificateRequestLister: certificateRequestInformer.Lister(),
-			SecretLister:             secretsInformer.Lister(),
-		},
-		policyEvaluator:       policyEvaluator,
-		renewalTimeCalculator: renewalTimeCalculator,
-		fieldManager:          ctx.FieldManager,
-	}, queue, mustSync
+		gatherer:                 gatherer,
+		policyEvaluator:          policyEvaluator,
+		renewalTimeCalculator:    renewalTimeCalculator,
+		clock:                    ctx.Clock,
+		fieldManager:             ctx.FieldManager,
+	}, queue, mustSync, nil
 }
 
 // ProcessItem is a worker function that will be called when a new key
@@ -159,6 +185,12 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	oldCrt := crt
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, condition.Type, condition.Status, condition.Reason, condition.Message)
+	if crt.Spec.Suspend {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionSuspended, cmmeta.ConditionTrue, SuspendedReason,
+			"Renewal and reissuance of the Certificate has been suspended using spec.suspend")
+	} else {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionSuspended)
+	}
 
 	switch {
 	case input.Secret != nil && input.Secret.Data != nil:
@@ -175,6 +207,16 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		notAfter := metav1.NewTime(x509cert.NotAfter)
 		renewBeforeHint := crt.Spec.RenewBefore
 		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, renewBeforeHint)
+		if renewalTime != nil {
+			// Move the renewal time into the Certificate's renewal windows,
+			// if any are configured.
+			adjusted, err := pki.RenewalTimeInWindows(renewalTime.Time, x509cert.NotAfter, c.clock.Now(), input.RenewalWindows)
+			if err != nil {
+				log.Error(err, "failed to apply renewal windows, ignoring them")
+			} else {
+				renewalTime = &metav1.Time{Time: adjusted}
+			}
+		}
 
 		//update Certificate's Status
 		crt.Status.NotBefore = &notBefore
@@ -202,8 +244,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		var conditions []cmapi.CertificateCondition
-		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionReady); cond != nil {
-			conditions = []cmapi.CertificateCondition{*cond}
+		for _, condType := range []cmapi.CertificateConditionType{cmapi.CertificateConditionReady, cmapi.CertificateConditionSuspended} {
+			if cond := apiutil.GetCertificateCondition(crt, condType); cond != nil {
+				conditions = append(conditions, *cond)
+			}
 		}
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
@@ -249,12 +293,15 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	// construct a new named logger to be reused throughout the controller
 	log := logf.FromContext(ctx.RootContext, ControllerName)
 
-	ctrl, queue, mustSync := NewController(log,
+	ctrl, queue, mustSync, err := NewController(log,
 		ctx,
 		policies.NewReadinessPolicyChain(ctx.Clock),
 		pki.RenewalTime,
 		BuildReadyConditionFromChain,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
 	c.controller = ctrl
 
 	return queue, mustSync, nil
@@ -85,6 +85,9 @@ func TestProcessItem(t *testing.T) {
 		// Certificate's Ready condition to be applied with the update
 		condition cmapi.CertificateCondition
 
+		// other conditions expected to be applied with the update
+		extraConditions []cmapi.CertificateCondition
+
 		// whether secret should be loaded into the fake clientset
 		// if notAfter, notBefore and renewalTime are set, an X509 cert will also be built and
 		// added as tls.crt value to the secret data
@@ -235,6 +238,25 @@ func TestProcessItem(t *testing.T) {
 			secretShouldExist: true,
 			certShouldUpdate:  false,
 		},
+		"update status for a Certificate that is suspended": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             ReadyReason,
+				Message:            "ready message",
+				LastTransitionTime: &metaNow,
+			},
+			extraConditions: []cmapi.CertificateCondition{{
+				Type:               cmapi.CertificateConditionSuspended,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             SuspendedReason,
+				Message:            "Renewal and reissuance of the Certificate has been suspended using spec.suspend",
+				LastTransitionTime: &metaNow,
+			}},
+			cert:              gen.CertificateFrom(cert, gen.SetCertificateSuspend(true)),
+			secretShouldExist: true,
+			certShouldUpdate:  true,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -284,6 +306,9 @@ func TestProcessItem(t *testing.T) {
 			if test.certShouldUpdate {
 				c := gen.CertificateFrom(test.cert,
 					gen.SetCertificateStatusCondition(test.condition))
+				for _, cond := range test.extraConditions {
+					c = gen.CertificateFrom(c, gen.SetCertificateStatusCondition(cond))
+				}
 
 				// gen package functions don't accept pointers- we need to test setting these values to nil in some scenarios.
 				c.Status.NotAfter = test.notAfter
@@ -36,6 +36,7 @@ import (
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -86,12 +87,15 @@ func NewController(
 	log logr.Logger,
 	ctx *controllerpkg.Context,
 	shouldReissue policies.Func,
-) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
-	// create a queue used to queue up items to be processed
-	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
-
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
 	// obtain references to all the informers used by this controller
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+
+	// create a queue used to queue up items to be processed, handing out
+	// Certificates that are closest to needing renewal first
+	queue := controllerpkg.NewPriorityRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName,
+		certificates.RenewalDeadline(certificateInformer.Lister()))
+
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
 
@@ -116,6 +120,30 @@ func NewController(
 		certificateInformer.Informer().HasSynced,
 	}
 
+	// Issuers and ClusterIssuers may define default renewal windows for the
+	// Certificates that reference them.
+	gatherer := &policies.Gatherer{
+		CertificateRequestLister: certificateRequestInformer.Lister(),
+		SecretLister:             secretsInformer.Lister(),
+	}
+	if err := certificates.AddIssuerRefIndex(certificateInformer.Informer()); err != nil {
+		return nil, nil, nil, err
+	}
+	// only the Certificates of issuers whose renewal windows changed are enqueued
+	issuerHandler := certificates.EnqueueCertificatesForIssuerRenewalWindows(log, queue, certificateInformer.Informer().GetIndexer())
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	issuerInformer.Informer().AddEventHandler(issuerHandler)
+	gatherer.IssuerLister = issuerInformer.Lister()
+	mustSync = append(mustSync, issuerInformer.Informer().HasSynced)
+	// if we are running in non-namespaced mode (i.e. --namespace=""), we also
+	// register event handlers and obtain a lister for clusterissuers.
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerInformer.Informer().AddEventHandler(issuerHandler)
+		gatherer.ClusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
@@ -126,16 +154,13 @@ func NewController(
 		fieldManager:             ctx.FieldManager,
 
 		// The following are used for testing purposes.
-		clock:         ctx.Clock,
-		shouldReissue: shouldReissue,
-		dataForCertificate: (&policies.Gatherer{
-			CertificateRequestLister: certificateRequestInformer.Lister(),
-			SecretLister:             secretsInformer.Lister(),
-		}).DataForCertificate,
-	}, queue, mustSync
+		clock:              ctx.Clock,
+		shouldReissue:      shouldReissue,
+		dataForCertificate: gatherer.DataForCertificate,
+	}, queue, mustSync, nil
 }
 
-func (c *controller) ProcessItem(ctx context.Context, key string) error {
+func (c *controller) ProcessItem(ctx context.Context, key string) (err error) {
 	log := logf.FromContext(ctx).WithValues("key", key)
 	ctx = logf.NewContext(ctx, log)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
@@ -160,6 +185,12 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
+	if crt.Spec.Suspend {
+		// Do nothing if renewal of the Certificate has been suspended.
+		log.V(logf.DebugLevel).Info("certificate is suspended, not checking whether it must be re-issued")
+		return nil
+	}
+
 	input, err := c.dataForCertificate(ctx, crt)
 	if err != nil {
 		return err
@@ -176,9 +207,17 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	}
 
 	if crt.Status.RenewalTime != nil {
+		renewalTime := crt.Status.RenewalTime.Time
+		if crt.Status.NotAfter != nil {
+			// The readiness controller already moves status.renewalTime into
+			// the renewal windows, but the windows may have changed since.
+			if adjusted, err := pki.RenewalTimeInWindows(renewalTime, crt.Status.NotAfter.Time, c.clock.Now(), input.RenewalWindows); err == nil {
+				renewalTime = adjusted
+			}
+		}
 		// ensure a resync is scheduled in the future so that we re-check
 		// Certificate resources and trigger them near expiry time
-		c.scheduleRecheckOfCertificateIfRequired(log, key, crt.Status.RenewalTime.Time.Sub(c.clock.Now()))
+		c.scheduleRecheckOfCertificateIfRequired(log, key, renewalTime.Sub(c.clock.Now()))
 	}
 
 	reason, message, reissue := c.shouldReissue(input)
@@ -193,6 +232,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	// message.
 	log.V(logf.InfoLevel).Info("Certificate must be re-issued", "reason", reason, "message", message)
 
+	// Start the trace of the revision that is about to be issued.
+	ctx, span := tracing.Start(tracing.ContextForCertificateRevision(ctx, crt), ControllerName, tracing.WithResource(crt))
+	defer func() { tracing.End(span, err) }()
+
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue, reason, message)
 	if err := c.updateOrApplyStatus(ctx, crt); err != nil {
@@ -332,10 +375,13 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	// construct a new named logger to be reused throughout the controller
 	log := logf.FromContext(ctx.RootContext, ControllerName)
 
-	ctrl, queue, mustSync := NewController(log,
+	ctrl, queue, mustSync, err := NewController(log,
 		ctx,
 		policies.NewTriggerPolicyChain(ctx.Clock).Evaluate,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
 	c.controller = ctrl
 
 	return queue, mustSync, nil
@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 
+	"github.com/cert-manager/cert-manager/internal/controller/issuerbudget"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
@@ -84,6 +85,10 @@ type Controller struct {
 	signerConstructor SignerConstructor
 	signer            Signer
 
+	// issuerBudgets enforces the rate limit and concurrency budget of the
+	// issuer before calling the sign function
+	issuerBudgets *issuerbudget.Budgets
+
 	// the signer kind to react to when a certificate signing request is synced
 	signerType string
 
@@ -162,16 +167,18 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 
 	// register handler functions
 	csrInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	csrInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{DeleteFunc: c.releaseIssuerBudget})
 	issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleGenericIssuer})
 
 	// create an issuer helper for reading generic issuers
-	c.helper = issuer.NewHelper(issuerInformer.Lister(), clusterIssuerInformer.Lister())
+	c.helper = issuer.NewHelper(issuerInformer.Lister(), clusterIssuerInformer.Lister(), nil)
 
 	c.clock = ctx.Clock
 	// recorder records events about resources to the Kubernetes api
 	c.recorder = ctx.Recorder
 	c.certClient = kubeClient.CertificatesV1().CertificateSigningRequests()
 	c.fieldManager = ctx.FieldManager
+	c.issuerBudgets = ctx.IssuerBudgets
 
 	// Construct the signer implementation with the built component context.
 	c.signer = c.signerConstructor(ctx)
@@ -182,6 +189,19 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	return c.queue, mustSync, nil
 }
 
+// releaseIssuerBudget frees the slot in its issuer's budget held by a
+// CertificateSigningRequest which is deleted before reaching a terminal state.
+func (c *Controller) releaseIssuerBudget(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	csr, ok := obj.(*certificatesv1.CertificateSigningRequest)
+	if !ok {
+		return
+	}
+	c.issuerBudgets.Release(string(csr.UID))
+}
+
 func (c *Controller) ProcessItem(ctx context.Context, key string) error {
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	certificatesclient "k8s.io/client-go/kubernetes/typed/certificates/v1"
+	certificateslisters "k8s.io/client-go/listers/certificates/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	ControllerName = "certificatesigningrequests-policy-approver"
+)
+
+// Controller is a CertificateSigningRequest controller which approves or
+// denies CertificateSigningRequests for issuers based on
+// CertificateRequestPolicies. Requests which are not selected by any policy,
+// or whose signer name does not refer to an issuers or clusterissuers
+// resource, are left untouched. This controller is not enabled by default.
+type Controller struct {
+	// logger to be used by this controller
+	log logr.Logger
+
+	csrLister       certificateslisters.CertificateSigningRequestLister
+	policyLister    cmlisters.CertificateRequestPolicyLister
+	certClient      certificatesclient.CertificateSigningRequestInterface
+	namespaceLister corelisters.NamespaceLister
+
+	recorder record.EventRecorder
+
+	queue workqueue.RateLimitingInterface
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(new(Controller)).Complete()
+	})
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	csrInformer := ctx.KubeSharedInformerFactory.CertificateSigningRequests()
+	policyInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequestPolicies()
+	// the labels of Namespaces are available to the validations of policies
+	namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+	mustSync := []cache.InformerSynced{
+		csrInformer.Informer().HasSynced,
+		policyInformer.Informer().HasSynced,
+		namespaceInformer.Informer().HasSynced,
+	}
+	csrInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	// A policy change may decide requests which no policy matched before.
+	policyInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueAll})
+
+	c.csrLister = csrInformer.Lister()
+	c.policyLister = policyInformer.Lister()
+	c.certClient = ctx.Client.CertificatesV1().CertificateSigningRequests()
+	c.namespaceLister = namespaceInformer.Lister()
+	c.recorder = ctx.Recorder
+
+	c.log.V(logf.DebugLevel).Info("certificate signing request policy approver controller registered")
+
+	return c.queue, mustSync, nil
+}
+
+// enqueueAll queues every CertificateSigningRequest.
+func (c *Controller) enqueueAll(_ interface{}) {
+	csrs, err := c.csrLister.List(labels.Everything())
+	if err != nil {
+		c.log.Error(err, "failed to list certificate signing requests")
+		return
+	}
+	for _, csr := range csrs {
+		key, err := controllerpkg.KeyFunc(csr)
+		if err != nil {
+			c.log.Error(err, "failed to compute key for certificate signing request")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key")
+		return nil
+	}
+
+	csr, err := c.csrLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info(fmt.Sprintf("certificate signing request in work queue no longer exists: %s", err))
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, csr))
+	return c.Sync(ctx, csr)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/cert-manager/cert-manager/internal/controller/approverpolicy"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// Sync evaluates the CertificateSigningRequest against all
+// CertificateRequestPolicies and sets the "Approved" or "Denied" condition
+// accordingly. If the request has already been decided or signed, or no
+// policy selects it, it is left untouched.
+func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSigningRequest) error {
+	log := logf.FromContext(ctx, "policy-approver")
+
+	switch {
+	case
+		util.CertificateSigningRequestIsApproved(csr),
+		util.CertificateSigningRequestIsDenied(csr),
+		util.CertificateSigningRequestIsFailed(csr),
+		len(csr.Status.Certificate) > 0:
+		return nil
+	}
+
+	ref, ok := util.SignerIssuerRefFromSignerName(csr.Spec.SignerName)
+	if ok {
+		_, ok = util.IssuerKindFromType(ref.Type)
+	}
+	if !ok {
+		log.V(logf.DebugLevel).Info("certificate signing request signer name does not refer to an issuer")
+		return nil
+	}
+
+	req, err := approverpolicy.RequestFromCertificateSigningRequest(csr)
+	if err != nil {
+		// The request is malformed and will be failed by its signer, so there
+		// is no point in retrying.
+		log.Error(err, "failed to build policy view of certificate signing request")
+		return nil
+	}
+
+	policies, err := c.policyLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	if req.Namespace != "" && approverpolicy.NeedNamespaceLabels(policies) {
+		ns, err := c.namespaceLister.Get(req.Namespace)
+		if err != nil {
+			return err
+		}
+		req.NamespaceLabels = ns.Labels
+	}
+
+	decision := approverpolicy.Evaluate(policies, req)
+	if !decision.Matched {
+		log.V(logf.DebugLevel).Info("no certificate request policy selects this request")
+		return nil
+	}
+
+	csr = csr.DeepCopy()
+	condition, eventType := certificatesv1.CertificateDenied, corev1.EventTypeWarning
+	if decision.Approved {
+		condition, eventType = certificatesv1.CertificateApproved, corev1.EventTypeNormal
+	}
+	nowTime := metav1.NewTime(util.Clock.Now())
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:               condition,
+		Status:             corev1.ConditionTrue,
+		Reason:             approverpolicy.Reason,
+		Message:            decision.Message,
+		LastTransitionTime: nowTime,
+		LastUpdateTime:     nowTime,
+	})
+
+	if _, err := c.certClient.UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	c.recorder.Event(csr, eventType, approverpolicy.Reason, decision.Message)
+
+	log.V(logf.DebugLevel).Info("decided certificate signing request", "approved", decision.Approved, "message", decision.Message)
+
+	return nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"testing"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now()
+
+	csrPEM, _, err := gen.CSR(x509.ECDSA, gen.SetCSRDNSNames("app.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseCSR := gen.CertificateSigningRequest("test",
+		gen.SetCertificateSigningRequestRequest(csrPEM),
+		gen.SetCertificateSigningRequestSignerName("clusterissuers.cert-manager.io/ca"),
+		gen.SetCertificateSigningRequestUsername("alice"),
+	)
+
+	ecdsaOnly := cmapi.ECDSAKeyAlgorithm
+	rsaOnly := cmapi.RSAKeyAlgorithm
+	policyWithAlgorithm := func(algorithm *cmapi.PrivateKeyAlgorithm) *cmapi.CertificateRequestPolicy {
+		return &cmapi.CertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+			Spec: cmapi.CertificateRequestPolicySpec{
+				Selector: cmapi.CertificateRequestPolicySelector{
+					IssuerRef: &cmapi.CertificateRequestPolicyIssuerRefSelector{Kind: cmapi.ClusterIssuerKind},
+					Requester: &cmapi.CertificateRequestPolicyRequesterSelector{Usernames: []string{"alice"}},
+				},
+				Allowed: &cmapi.CertificateRequestPolicyAllowed{
+					DNSNames: []string{"*.example.com"},
+					Usages:   cmapi.DefaultKeyUsages(),
+				},
+				Constraints: &cmapi.CertificateRequestPolicyConstraints{
+					PrivateKey: &cmapi.CertificateRequestPolicyPrivateKeyConstraints{Algorithm: algorithm},
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		csr      *certificatesv1.CertificateSigningRequest
+		policies []runtime.Object
+
+		expectedEvent     string
+		expectedCondition *certificatesv1.CertificateSigningRequestCondition
+	}{
+		"do nothing if the signer is not an issuer": {
+			csr:      gen.CertificateSigningRequestFrom(baseCSR, gen.SetCertificateSigningRequestSignerName("kubernetes.io/kube-apiserver-client")),
+			policies: []runtime.Object{policyWithAlgorithm(nil)},
+		},
+		"do nothing if no policy selects the request": {
+			csr:      gen.CertificateSigningRequestFrom(baseCSR, gen.SetCertificateSigningRequestUsername("bob")),
+			policies: []runtime.Object{policyWithAlgorithm(nil)},
+		},
+		"do nothing if already approved": {
+			csr: gen.CertificateSigningRequestFrom(baseCSR, gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+				Type:   certificatesv1.CertificateApproved,
+				Status: "True",
+			})),
+			policies: []runtime.Object{policyWithAlgorithm(&rsaOnly)},
+		},
+		"approve if a matching policy allows the request": {
+			csr:      baseCSR,
+			policies: []runtime.Object{policyWithAlgorithm(&ecdsaOnly)},
+			expectedCondition: &certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateApproved,
+				Status:  "True",
+				Reason:  "policy.cert-manager.io",
+				Message: `Approved by CertificateRequestPolicy "alice"`,
+			},
+			expectedEvent: `Normal policy.cert-manager.io Approved by CertificateRequestPolicy "alice"`,
+		},
+		"deny if no matching policy allows the request": {
+			csr:      baseCSR,
+			policies: []runtime.Object{policyWithAlgorithm(&rsaOnly)},
+			expectedCondition: &certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  "True",
+				Reason:  "policy.cert-manager.io",
+				Message: `Denied by all matching policies: [CertificateRequestPolicy "alice": spec.constraints.privateKey.algorithm: key algorithm "ECDSA" is not allowed]`,
+			},
+			expectedEvent: `Warning policy.cert-manager.io Denied by all matching policies: [CertificateRequestPolicy "alice": spec.constraints.privateKey.algorithm: key algorithm "ECDSA" is not allowed]`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				KubeObjects:        []runtime.Object{test.csr},
+				CertManagerObjects: test.policies,
+			}
+			if test.expectedCondition != nil {
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewCustomMatch(coretesting.NewUpdateSubresourceAction(
+						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+						"approval",
+						"",
+						nil,
+					), func(exp, act coretesting.Action) error {
+						if act.GetVerb() != "update" || act.GetSubresource() != "approval" {
+							return fmt.Errorf("unexpected action %s %s/%s", act.GetVerb(), act.GetResource().Resource, act.GetSubresource())
+						}
+						csr := act.(coretesting.UpdateAction).GetObject().(*certificatesv1.CertificateSigningRequest)
+						cond := csr.Status.Conditions[len(csr.Status.Conditions)-1]
+						cond.LastUpdateTime, cond.LastTransitionTime = metav1.Time{}, metav1.Time{}
+						if cond != *test.expectedCondition {
+							return fmt.Errorf("unexpected condition, exp=%#+v got=%#+v", *test.expectedCondition, cond)
+						}
+						return nil
+					}),
+				)
+			}
+			if test.expectedEvent != "" {
+				builder.ExpectedEvents = []string{test.expectedEvent}
+			}
+			builder.Init()
+
+			c := new(Controller)
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			builder.Start()
+			defer builder.Stop()
+
+			if err := c.ProcessItem(context.Background(), test.csr.Name); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllReactorsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package certificatesigningrequests
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	authzv1 "k8s.io/api/authorization/v1"
@@ -26,6 +27,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/cert-manager/cert-manager/internal/controller/issuerbudget"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -45,6 +47,13 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 	// invalidation by future contributions.
 	csr = csr.DeepCopy()
 
+	// A request holds its slot in the issuer's budget until it reaches a
+	// terminal state, so it is only charged once however often it is synced
+	// whilst the issuer completes it.
+	if util.CertificateSigningRequestIsFailed(csr) || util.CertificateSigningRequestIsDenied(csr) || len(csr.Status.Certificate) > 0 {
+		c.issuerBudgets.Release(string(csr.UID))
+	}
+
 	ref, ok := util.SignerIssuerRefFromSignerName(csr.Spec.SignerName)
 	if !ok {
 		dbg.Info("certificate signing request has malformed signer name,", "signerName", csr.Spec.SignerName)
@@ -158,6 +167,19 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 		return nil
 	}
 
+	// Wait until the issuer's rate limit and concurrency budget permit
+	// another signing request.
+	if err := c.issuerBudgets.Acquire(issuerObj, string(csr.UID)); err != nil {
+		var exhausted *issuerbudget.ExhaustedError
+		if !errors.As(err, &exhausted) {
+			return err
+		}
+		dbg.Info("issuer budget exhausted, waiting before signing", "retry_after", exhausted.RetryAfter)
+		c.recorder.Event(csr, corev1.EventTypeNormal, "IssuerRateLimited", exhausted.Error())
+		c.queue.AddAfter(csr.Name, exhausted.RetryAfter)
+		return nil
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	return c.signer.Sign(ctx, csr, issuerObj)
@@ -70,6 +70,17 @@ func SignerIssuerRefFromSignerName(name string) (SignerIssuerRef, bool) {
 	}, true
 }
 
+// SignerNameFromIssuer returns the signer name that CertificateSigningRequests
+// use to reference the given Issuer or ClusterIssuer, i.e.
+// `issuers.cert-manager.io/<namespace>.<name>` or
+// `clusterissuers.cert-manager.io/<name>`.
+func SignerNameFromIssuer(issuer cmapi.GenericIssuer) string {
+	if _, ok := issuer.(*cmapi.ClusterIssuer); ok {
+		return "clusterissuers." + cmapi.SchemeGroupVersion.Group + "/" + issuer.GetObjectMeta().Name
+	}
+	return "issuers." + cmapi.SchemeGroupVersion.Group + "/" + issuer.GetObjectMeta().Namespace + "." + issuer.GetObjectMeta().Name
+}
+
 // IssuerKindFromType will return the cert-manager.io Issuer Kind from a
 // resource type name.
 func IssuerKindFromType(issuerType string) (string, bool) {
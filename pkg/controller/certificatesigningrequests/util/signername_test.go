@@ -19,6 +19,9 @@ package util
 import (
 	"reflect"
 	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func TestIssuerRefFromSignerName(t *testing.T) {
@@ -129,3 +132,33 @@ func TestIssuerRefFromSignerName(t *testing.T) {
 		})
 	}
 }
+
+func TestSignerNameFromIssuer(t *testing.T) {
+	tests := map[string]struct {
+		issuer        cmapi.GenericIssuer
+		expSignerName string
+	}{
+		"an Issuer should include its namespace": {
+			issuer:        gen.Issuer("my-issuer", gen.SetIssuerNamespace("my-namespace")),
+			expSignerName: "issuers.cert-manager.io/my-namespace.my-issuer",
+		},
+		"a ClusterIssuer should not include a namespace": {
+			issuer:        gen.ClusterIssuer("my-issuer"),
+			expSignerName: "clusterissuers.cert-manager.io/my-issuer",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			signerName := SignerNameFromIssuer(test.issuer)
+			if signerName != test.expSignerName {
+				t.Errorf("unexpected signer name, exp=%q got=%q", test.expSignerName, signerName)
+			}
+
+			ref, ok := SignerIssuerRefFromSignerName(signerName)
+			if !ok || ref.Name != "my-issuer" {
+				t.Errorf("signer name %q does not round-trip: %+v", signerName, ref)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertrustbundles
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the string used to refer to this controller
+	// when enabling or disabling it from command line flags.
+	ControllerName = "clustertrustbundles"
+)
+
+const (
+	// caSecretIndex indexes CA Issuers and ClusterIssuers by the name of
+	// their CA Secret, so that the issuers which use a Secret can be found
+	// without listing every issuer.
+	caSecretIndex = "clustertrustbundles.caSecretName"
+
+	// issuerIndex indexes CertificateRequests by the queue key of the issuer
+	// they reference, so that the requests signed by an issuer can be found
+	// without listing every CertificateRequest.
+	issuerIndex = "clustertrustbundles.issuerKey"
+)
+
+// clusterTrustBundlesGVR is the resource of ClusterTrustBundles. They are
+// not part of the Kubernetes API version cert-manager is built against, so
+// they are managed with a dynamic client.
+var clusterTrustBundlesGVR = schema.GroupVersionResource{
+	Group:    "certificates.k8s.io",
+	Version:  "v1alpha1",
+	Resource: "clustertrustbundles",
+}
+
+// bundleResyncPeriod is the resync period of the ClusterTrustBundle
+// informer, matching the one of the shared informers.
+const bundleResyncPeriod = 10 * time.Hour
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+// The clustertrustbundles controller publishes the CA certificates of CA,
+// Vault and SelfSigned Issuers and ClusterIssuers as signer-linked
+// ClusterTrustBundles, named after the signer name that
+// CertificateSigningRequests use to reference the issuer.
+//
+// The CA of a CA issuer is read from its Secret. The CA of a Vault issuer is
+// only known once it has signed a CertificateRequest, and a SelfSigned
+// issuer is only considered a CA once it has signed a CertificateRequest for
+// a CA certificate. Previous CAs are kept in the bundle until they expire, so
+// that certificates signed before a rotation continue to be trusted. The
+// bundle is deleted once its issuer is deleted or stops being a CA, Vault or
+// SelfSigned issuer.
+type controller struct {
+	issuerLister              cmlisters.IssuerLister
+	clusterIssuerLister       cmlisters.ClusterIssuerLister
+	issuerIndexer             cache.Indexer
+	clusterIssuerIndexer      cache.Indexer
+	certificateRequestIndexer cache.Indexer
+	secretLister              internalinformers.SecretLister
+	bundleLister              cache.GenericLister
+	client                    dynamic.Interface
+	clock                     clock.Clock
+
+	// namespace is the namespace the controller operates within, or empty
+	// if it operates in all namespaces
+	namespace                string
+	clusterResourceNamespace string
+
+	queue workqueue.RateLimitingInterface
+}
+
+func NewController(ctx *controllerpkg.Context) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	client, err := dynamic.NewForConfig(ctx.RESTConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+
+	// obtain references to all the informers used by this controller
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	secretInformer := ctx.KubeSharedInformerFactory.Secrets()
+	// ClusterTrustBundles are not served by the shared informer factories,
+	// so the controller runs its own informer for them.
+	bundleInformer := dynamicinformer.NewFilteredDynamicInformer(client, clusterTrustBundlesGVR, "", bundleResyncPeriod, cache.Indexers{}, nil)
+
+	if err := addIndexers(issuerInformer.Informer(), clusterIssuerInformer.Informer(), certificateRequestInformer.Informer()); err != nil {
+		return nil, nil, nil, err
+	}
+
+	c := &controller{
+		issuerLister:              issuerInformer.Lister(),
+		clusterIssuerLister:       clusterIssuerInformer.Lister(),
+		issuerIndexer:             issuerInformer.Informer().GetIndexer(),
+		clusterIssuerIndexer:      clusterIssuerInformer.Informer().GetIndexer(),
+		certificateRequestIndexer: certificateRequestInformer.Informer().GetIndexer(),
+		secretLister:              secretInformer.Lister(),
+		bundleLister:              bundleInformer.Lister(),
+		client:                    client,
+		clock:                     ctx.Clock,
+		namespace:                 ctx.Namespace,
+		clusterResourceNamespace:  ctx.IssuerOptions.ClusterResourceNamespace,
+		queue:                     queue,
+	}
+
+	issuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleCertificateRequest})
+	secretInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleSecret})
+	// changes to a bundle, and bundles of issuers which no longer exist, are
+	// reconciled by syncing the issuer of the bundle
+	bundleInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleBundle})
+	go bundleInformer.Informer().Run(ctx.RootContext.Done())
+
+	// build a list of InformerSynced functions that will be returned by the
+	// Register method.  the controller will only begin processing items once all
+	// of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		issuerInformer.Informer().HasSynced,
+		clusterIssuerInformer.Informer().HasSynced,
+		certificateRequestInformer.Informer().HasSynced,
+		secretInformer.Informer().HasSynced,
+		bundleInformer.Informer().HasSynced,
+	}
+
+	return c, queue, mustSync, nil
+}
+
+// addIndexers adds the indexes used to find the issuers which use a CA
+// Secret and the CertificateRequests signed by an issuer.
+func addIndexers(issuers, clusterIssuers, certificateRequests cache.SharedIndexInformer) error {
+	if err := issuers.AddIndexers(cache.Indexers{caSecretIndex: caSecretIndexFunc}); err != nil {
+		return fmt.Errorf("error adding issuer indexer: %w", err)
+	}
+	if err := clusterIssuers.AddIndexers(cache.Indexers{caSecretIndex: caSecretIndexFunc}); err != nil {
+		return fmt.Errorf("error adding clusterissuer indexer: %w", err)
+	}
+	if err := certificateRequests.AddIndexers(cache.Indexers{issuerIndex: issuerIndexFunc}); err != nil {
+		return fmt.Errorf("error adding certificaterequest indexer: %w", err)
+	}
+	return nil
+}
+
+// caSecretIndexFunc returns the name of the CA Secret of a CA issuer.
+func caSecretIndexFunc(obj interface{}) ([]string, error) {
+	iss, ok := obj.(cmapi.GenericIssuer)
+	if !ok || iss.GetSpec().CA == nil {
+		return nil, nil
+	}
+	return []string{iss.GetSpec().CA.SecretName}, nil
+}
+
+// issuerIndexFunc returns the queue key of the issuer referenced by a
+// CertificateRequest.
+func issuerIndexFunc(obj interface{}) ([]string, error) {
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok {
+		return nil, nil
+	}
+	key, ok := issuerKey(cr)
+	if !ok {
+		return nil, nil
+	}
+	return []string{key}, nil
+}
+
+// issuerKey returns the queue key of the Issuer (<namespace>/<name>) or
+// ClusterIssuer (<name>) referenced by a CertificateRequest. It returns false
+// if the request references an issuer of another group.
+func issuerKey(cr *cmapi.CertificateRequest) (string, bool) {
+	ref := cr.Spec.IssuerRef
+	if ref.Group != "" && ref.Group != cmapi.SchemeGroupVersion.Group {
+		return "", false
+	}
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		return apiutil.IssuerNamespace(ref, cr.Namespace) + "/" + ref.Name, true
+	case cmapi.ClusterIssuerKind:
+		return ref.Name, true
+	default:
+		return "", false
+	}
+}
+
+// handleCertificateRequest queues the issuer of a CertificateRequest, as it
+// may have become Ready with a new CA.
+func (c *controller) handleCertificateRequest(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok {
+		return
+	}
+	if key, ok := issuerKey(cr); ok {
+		c.queue.Add(key)
+	}
+}
+
+// handleSecret queues the CA issuers which use the Secret.
+func (c *controller) handleSecret(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	secret, ok := controllerpkg.ToSecret(obj)
+	if !ok {
+		return
+	}
+
+	issuers, err := c.issuerIndexer.ByIndex(caSecretIndex, secret.Name)
+	if err != nil {
+		logf.Log.Error(err, "failed to list issuers")
+		return
+	}
+	for _, obj := range issuers {
+		if iss, ok := obj.(*cmapi.Issuer); ok && iss.Namespace == secret.Namespace {
+			c.queue.Add(iss.Namespace + "/" + iss.Name)
+		}
+	}
+
+	if secret.Namespace != c.clusterResourceNamespace {
+		return
+	}
+	clusterIssuers, err := c.clusterIssuerIndexer.ByIndex(caSecretIndex, secret.Name)
+	if err != nil {
+		logf.Log.Error(err, "failed to list clusterissuers")
+		return
+	}
+	for _, obj := range clusterIssuers {
+		if iss, ok := obj.(*cmapi.ClusterIssuer); ok {
+			c.queue.Add(iss.Name)
+		}
+	}
+}
+
+// handleBundle queues the issuer of a ClusterTrustBundle published by this
+// controller.
+func (c *controller) handleBundle(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	bundle, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	key, ok := bundleIssuerKey(bundle)
+	if !ok {
+		return
+	}
+	// Issuers outside of the namespace the controller operates within are
+	// not watched, so their bundles must not be treated as orphaned.
+	if namespace, _, err := cache.SplitMetaNamespaceKey(key); err != nil || (c.namespace != "" && namespace != "" && namespace != c.namespace) {
+		return
+	}
+	c.queue.Add(key)
+}
+
+// bundleIssuerKey returns the queue key of the Issuer or ClusterIssuer whose
+// CAs are published in the ClusterTrustBundle. It returns false if the bundle
+// was not published by this controller.
+func bundleIssuerKey(bundle *unstructured.Unstructured) (string, bool) {
+	signerName, _, _ := unstructured.NestedString(bundle.Object, "spec", "signerName")
+	if signerName == "" || bundle.GetName() != bundleName(signerName) {
+		return "", false
+	}
+	ref, ok := util.SignerIssuerRefFromSignerName(signerName)
+	if !ok || ref.Group != cmapi.SchemeGroupVersion.Group {
+		return "", false
+	}
+	switch ref.Type {
+	case "issuers":
+		if ref.Namespace == "" {
+			return "", false
+		}
+		return ref.Namespace + "/" + ref.Name, true
+	case "clusterissuers":
+		return ref.Name, true
+	default:
+		return "", false
+	}
+}
+
+// ProcessItem syncs the ClusterTrustBundle of the Issuer (for keys of the
+// form <namespace>/<name>) or ClusterIssuer (for keys of the form <name>).
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	var issuer cmapi.GenericIssuer
+	if namespace == "" {
+		issuer, err = c.clusterIssuerLister.Get(name)
+		if apierrors.IsNotFound(err) {
+			return c.deleteBundle(ctx, &cmapi.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+	} else {
+		issuer, err = c.issuerLister.Issuers(namespace).Get(name)
+		if apierrors.IsNotFound(err) {
+			return c.deleteBundle(ctx, &cmapi.Issuer{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}})
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	requeueAfter, err := c.Sync(ctx, issuer)
+	if err != nil {
+		return err
+	}
+	if requeueAfter > 0 {
+		c.queue.AddAfter(key, requeueAfter)
+	}
+	return nil
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	ctrl, queue, mustSync, err := NewController(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertrustbundles
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// bundleName returns the name of the ClusterTrustBundle of the given signer.
+// Signer-linked ClusterTrustBundles must be named with the signer name, with
+// '/' replaced by ':', as a prefix.
+func bundleName(signerName string) string {
+	return strings.ReplaceAll(signerName, "/", ":") + ":ca"
+}
+
+// Sync ensures that the ClusterTrustBundle of the issuer contains its known
+// CAs, as well as previous CAs that have not yet expired. It returns the
+// duration after which the bundle has to be synced again to remove the first
+// CA to expire.
+func (c *controller) Sync(ctx context.Context, issuer cmapi.GenericIssuer) (time.Duration, error) {
+	log := logf.FromContext(ctx)
+
+	cas, publishes, err := c.knownCAs(ctx, issuer)
+	if err != nil {
+		return 0, err
+	}
+
+	signerName := util.SignerNameFromIssuer(issuer)
+	name := bundleName(signerName)
+	existing, err := c.getBundle(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if !publishes {
+		// The issuer is no longer a CA, Vault or SelfSigned issuer, so its
+		// previous CAs are no longer trusted.
+		if existing == nil {
+			return 0, nil
+		}
+		log.V(logf.InfoLevel).Info("deleting ClusterTrustBundle as the issuer does not publish CAs", "name", name)
+		return 0, c.deleteBundle(ctx, issuer)
+	}
+
+	if existing != nil {
+		trustBundle, _, _ := unstructured.NestedString(existing.Object, "spec", "trustBundle")
+		// Previous CAs are kept, so that certificates they signed are
+		// trusted until the CAs expire.
+		if previous, err := pki.DecodeX509CertificateChainBytes([]byte(trustBundle)); err == nil {
+			cas = append(cas, previous...)
+		}
+	}
+
+	now := c.clock.Now()
+	cas = unexpired(dedupe(cas), now)
+	if len(cas) == 0 {
+		if existing == nil {
+			return 0, nil
+		}
+		log.V(logf.InfoLevel).Info("deleting ClusterTrustBundle as the issuer has no known CA", "name", name)
+		return 0, c.deleteBundle(ctx, issuer)
+	}
+
+	trustBundle, err := encodeBundle(cas)
+	if err != nil {
+		return 0, err
+	}
+	requeueAfter := firstToExpire(cas).NotAfter.Sub(now)
+
+	if existing == nil {
+		bundle := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": clusterTrustBundlesGVR.GroupVersion().String(),
+			"kind":       "ClusterTrustBundle",
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+			"spec": map[string]interface{}{
+				"signerName":  signerName,
+				"trustBundle": trustBundle,
+			},
+		}}
+		if _, err := c.client.Resource(clusterTrustBundlesGVR).Create(ctx, bundle, metav1.CreateOptions{}); err != nil {
+			return 0, err
+		}
+		log.V(logf.InfoLevel).Info("created ClusterTrustBundle", "name", name)
+		return requeueAfter, nil
+	}
+
+	if current, _, _ := unstructured.NestedString(existing.Object, "spec", "trustBundle"); current == trustBundle {
+		return requeueAfter, nil
+	}
+	existing = existing.DeepCopy()
+	if err := unstructured.SetNestedField(existing.Object, trustBundle, "spec", "trustBundle"); err != nil {
+		return 0, err
+	}
+	if _, err := c.client.Resource(clusterTrustBundlesGVR).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return 0, err
+	}
+	log.V(logf.InfoLevel).Info("updated ClusterTrustBundle", "name", name)
+	return requeueAfter, nil
+}
+
+// getBundle returns the ClusterTrustBundle with the given name from the
+// informer cache, or nil if it does not exist.
+func (c *controller) getBundle(name string) (*unstructured.Unstructured, error) {
+	obj, err := c.bundleLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	bundle, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T for ClusterTrustBundle %q", obj, name)
+	}
+	return bundle, nil
+}
+
+// deleteBundle deletes the ClusterTrustBundle of the issuer, if it exists.
+func (c *controller) deleteBundle(ctx context.Context, issuer cmapi.GenericIssuer) error {
+	err := c.client.Resource(clusterTrustBundlesGVR).Delete(ctx, bundleName(util.SignerNameFromIssuer(issuer)), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// knownCAs returns the CA certificates currently used by the issuer. It
+// returns false if the issuer is not of a type whose CAs are published.
+func (c *controller) knownCAs(ctx context.Context, issuer cmapi.GenericIssuer) ([]*x509.Certificate, bool, error) {
+	log := logf.FromContext(ctx)
+	spec := issuer.GetSpec()
+
+	switch {
+	case spec.CA != nil:
+		namespace := issuer.GetObjectMeta().Namespace
+		if namespace == "" {
+			namespace = c.clusterResourceNamespace
+		}
+		certs, err := kube.SecretTLSCertChain(ctx, c.secretLister, namespace, spec.CA.SecretName)
+		if apierrors.IsNotFound(err) {
+			return nil, true, nil
+		}
+		if err != nil {
+			log.V(logf.InfoLevel).Info("failed to read CA from secret", "error", err.Error())
+			return nil, true, nil
+		}
+		bundle, err := pki.ParseSingleCertificateChain(certs)
+		if err != nil {
+			log.V(logf.InfoLevel).Info("failed to parse CA certificate chain", "error", err.Error())
+			return nil, true, nil
+		}
+		// A chain consisting of a single certificate has no separate CA.
+		caPEM := bundle.CAPEM
+		if len(caPEM) == 0 {
+			caPEM = bundle.ChainPEM
+		}
+		cas, err := pki.DecodeX509CertificateChainBytes(caPEM)
+		return cas, true, err
+
+	case spec.Vault != nil:
+		cas, err := c.issuedCAs(issuer, false)
+		return cas, true, err
+
+	case spec.SelfSigned != nil:
+		cas, err := c.issuedCAs(issuer, true)
+		return cas, true, err
+
+	default:
+		return nil, false, nil
+	}
+}
+
+// issuedCAs returns the CAs of the Ready CertificateRequests signed by the
+// issuer. If onlyIsCA is true, only requests for CA certificates are
+// considered.
+func (c *controller) issuedCAs(issuer cmapi.GenericIssuer, onlyIsCA bool) ([]*x509.Certificate, error) {
+	key, err := cache.MetaNamespaceKeyFunc(issuer)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := c.certificateRequestIndexer.ByIndex(issuerIndex, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var cas []*x509.Certificate
+	for _, obj := range objs {
+		cr, ok := obj.(*cmapi.CertificateRequest)
+		if !ok {
+			continue
+		}
+		if onlyIsCA && !cr.Spec.IsCA {
+			continue
+		}
+		if len(cr.Status.CA) == 0 || !apiutil.CertificateRequestHasCondition(cr, cmapi.CertificateRequestCondition{
+			Type:   cmapi.CertificateRequestConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}) {
+			continue
+		}
+		certs, err := pki.DecodeX509CertificateChainBytes(cr.Status.CA)
+		if err != nil {
+			continue
+		}
+		cas = append(cas, certs...)
+	}
+	return cas, nil
+}
+
+// dedupe removes duplicate certificates, keeping the first occurrence.
+func dedupe(certs []*x509.Certificate) []*x509.Certificate {
+	var out []*x509.Certificate
+	for _, cert := range certs {
+		duplicate := false
+		for _, seen := range out {
+			if seen.Equal(cert) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			out = append(out, cert)
+		}
+	}
+	return out
+}
+
+// unexpired removes the certificates which have expired at the given time.
+func unexpired(certs []*x509.Certificate, now time.Time) []*x509.Certificate {
+	var out []*x509.Certificate
+	for _, cert := range certs {
+		if now.Before(cert.NotAfter) {
+			out = append(out, cert)
+		}
+	}
+	return out
+}
+
+// firstToExpire returns the certificate which expires first.
+func firstToExpire(certs []*x509.Certificate) *x509.Certificate {
+	first := certs[0]
+	for _, cert := range certs[1:] {
+		if cert.NotAfter.Before(first.NotAfter) {
+			first = cert
+		}
+	}
+	return first
+}
+
+// encodeBundle PEM encodes the certificates, most recently issued first, so
+// that the bundle only changes when the set of certificates changes.
+func encodeBundle(certs []*x509.Certificate) (string, error) {
+	sorted := append([]*x509.Certificate(nil), certs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].NotBefore.Equal(sorted[j].NotBefore) {
+			return sorted[i].NotBefore.After(sorted[j].NotBefore)
+		}
+		return bytes.Compare(sorted[i].Raw, sorted[j].Raw) < 0
+	})
+
+	var buf bytes.Buffer
+	for _, cert := range sorted {
+		certPEM, err := pki.EncodeX509(cert)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode certificate: %w", err)
+		}
+		buf.Write(certPEM)
+	}
+	return buf.String(), nil
+}
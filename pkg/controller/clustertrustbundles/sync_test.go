@@ -0,0 +1,344 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertrustbundles
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSync(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	mustCreateCA := func(cn string, notBefore, notAfter time.Time) []byte {
+		crt := gen.Certificate(cn, gen.SetCertificateCommonName(cn), gen.SetCertificateIsCA(true))
+		return testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, crt, notBefore, notAfter)
+	}
+	currentCA := mustCreateCA("current", now.Add(-time.Hour), now.Add(24*time.Hour))
+	previousCA := mustCreateCA("previous", now.Add(-2*time.Hour), now.Add(12*time.Hour))
+	expiredCA := mustCreateCA("expired", now.Add(-3*time.Hour), now.Add(-time.Hour))
+
+	caIssuer := gen.Issuer("ca", gen.SetIssuerNamespace("ns"), gen.SetIssuerCASecretName("ca-key-pair"))
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "ca-key-pair"},
+		Data:       map[string][]byte{corev1.TLSCertKey: currentCA, corev1.TLSPrivateKeyKey: pk},
+	}
+	vaultIssuer := gen.ClusterIssuer("vault", gen.SetIssuerVault(cmapi.VaultIssuer{}))
+	selfSignedIssuer := gen.Issuer("selfsigned", gen.SetIssuerNamespace("ns"), gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}))
+	acmeIssuer := gen.Issuer("acme", gen.SetIssuerNamespace("ns"), gen.SetIssuerACMEURL("https://acme.example.com"))
+
+	readyCR := func(name string, ref cmmeta.ObjectReference, ca []byte, mods ...gen.CertificateRequestModifier) *cmapi.CertificateRequest {
+		return gen.CertificateRequest(name, append([]gen.CertificateRequestModifier{
+			gen.SetCertificateRequestNamespace("app"),
+			gen.SetCertificateRequestIssuer(ref),
+			gen.SetCertificateRequestCA(ca),
+			gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:   cmapi.CertificateRequestConditionReady,
+				Status: cmmeta.ConditionTrue,
+			}),
+		}, mods...)...)
+	}
+
+	bundle := func(name, signerName string, cas ...[]byte) *unstructured.Unstructured {
+		var trustBundle []byte
+		for _, ca := range cas {
+			trustBundle = append(trustBundle, ca...)
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "certificates.k8s.io/v1alpha1",
+			"kind":       "ClusterTrustBundle",
+			"metadata":   map[string]interface{}{"name": name},
+			"spec": map[string]interface{}{
+				"signerName":  signerName,
+				"trustBundle": string(trustBundle),
+			},
+		}}
+	}
+
+	tests := map[string]struct {
+		issuer             cmapi.GenericIssuer
+		kubeObjects        []runtime.Object
+		certManagerObjects []runtime.Object
+		existing           []runtime.Object
+
+		expectedName       string
+		expectedSignerName string
+		expectedCAs        [][]byte
+	}{
+		"should publish the CA of a CA Issuer": {
+			issuer:             caIssuer,
+			kubeObjects:        []runtime.Object{caSecret},
+			certManagerObjects: []runtime.Object{caIssuer},
+			expectedName:       "issuers.cert-manager.io:ns.ca:ca",
+			expectedSignerName: "issuers.cert-manager.io/ns.ca",
+			expectedCAs:        [][]byte{currentCA},
+		},
+		"should keep previous CAs until they expire": {
+			issuer:             caIssuer,
+			kubeObjects:        []runtime.Object{caSecret},
+			certManagerObjects: []runtime.Object{caIssuer},
+			existing:           []runtime.Object{bundle("issuers.cert-manager.io:ns.ca:ca", "issuers.cert-manager.io/ns.ca", previousCA, expiredCA)},
+			expectedName:       "issuers.cert-manager.io:ns.ca:ca",
+			expectedSignerName: "issuers.cert-manager.io/ns.ca",
+			expectedCAs:        [][]byte{currentCA, previousCA},
+		},
+		"should publish the CAs returned by a Vault ClusterIssuer": {
+			issuer: vaultIssuer,
+			certManagerObjects: []runtime.Object{vaultIssuer,
+				readyCR("a", cmmeta.ObjectReference{Name: "vault", Kind: cmapi.ClusterIssuerKind}, currentCA),
+				readyCR("b", cmmeta.ObjectReference{Name: "vault", Kind: cmapi.ClusterIssuerKind}, currentCA),
+				readyCR("c", cmmeta.ObjectReference{Name: "vault", Kind: cmapi.IssuerKind}, previousCA),
+			},
+			expectedName:       "clusterissuers.cert-manager.io:vault:ca",
+			expectedSignerName: "clusterissuers.cert-manager.io/vault",
+			expectedCAs:        [][]byte{currentCA},
+		},
+		"should only publish CA certificates signed by a SelfSigned Issuer": {
+			issuer: selfSignedIssuer,
+			certManagerObjects: []runtime.Object{selfSignedIssuer,
+				readyCR("root", cmmeta.ObjectReference{Name: "selfsigned", Namespace: "ns"}, currentCA, gen.SetCertificateRequestIsCA(true)),
+				readyCR("leaf", cmmeta.ObjectReference{Name: "selfsigned", Namespace: "ns"}, previousCA),
+			},
+			expectedName:       "issuers.cert-manager.io:ns.selfsigned:ca",
+			expectedSignerName: "issuers.cert-manager.io/ns.selfsigned",
+			expectedCAs:        [][]byte{currentCA},
+		},
+		"should not publish a bundle for a SelfSigned Issuer which hasn't signed a CA": {
+			issuer: selfSignedIssuer,
+			certManagerObjects: []runtime.Object{selfSignedIssuer,
+				readyCR("leaf", cmmeta.ObjectReference{Name: "selfsigned", Namespace: "ns"}, previousCA),
+			},
+			expectedName: "issuers.cert-manager.io:ns.selfsigned:ca",
+		},
+		"should delete the bundle once all CAs have expired": {
+			issuer:             caIssuer,
+			certManagerObjects: []runtime.Object{caIssuer},
+			existing:           []runtime.Object{bundle("issuers.cert-manager.io:ns.ca:ca", "issuers.cert-manager.io/ns.ca", expiredCA)},
+			expectedName:       "issuers.cert-manager.io:ns.ca:ca",
+		},
+		"should delete the bundle of an issuer which no longer publishes CAs": {
+			issuer:             acmeIssuer,
+			certManagerObjects: []runtime.Object{acmeIssuer},
+			existing:           []runtime.Object{bundle("issuers.cert-manager.io:ns.acme:ca", "issuers.cert-manager.io/ns.acme", previousCA)},
+			expectedName:       "issuers.cert-manager.io:ns.acme:ca",
+		},
+		"should not publish a bundle for an ACME Issuer": {
+			issuer:             acmeIssuer,
+			certManagerObjects: []runtime.Object{acmeIssuer},
+			expectedName:       "issuers.cert-manager.io:ns.acme:ca",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				KubeObjects:        test.kubeObjects,
+				CertManagerObjects: test.certManagerObjects,
+			}
+			builder.Init()
+			defer builder.Stop()
+
+			client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{clusterTrustBundlesGVR: "ClusterTrustBundleList"},
+				test.existing...)
+			issuerInformer := builder.SharedInformerFactory.Certmanager().V1().Issuers()
+			clusterIssuerInformer := builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+			certificateRequestInformer := builder.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+			require.NoError(t, addIndexers(issuerInformer.Informer(), clusterIssuerInformer.Informer(), certificateRequestInformer.Informer()))
+			bundleIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, obj := range test.existing {
+				require.NoError(t, bundleIndexer.Add(obj))
+			}
+			c := &controller{
+				issuerLister:              issuerInformer.Lister(),
+				clusterIssuerLister:       clusterIssuerInformer.Lister(),
+				issuerIndexer:             issuerInformer.Informer().GetIndexer(),
+				clusterIssuerIndexer:      clusterIssuerInformer.Informer().GetIndexer(),
+				certificateRequestIndexer: certificateRequestInformer.Informer().GetIndexer(),
+				secretLister:              builder.KubeSharedInformerFactory.Secrets().Lister(),
+				bundleLister:              cache.NewGenericLister(bundleIndexer, clusterTrustBundlesGVR.GroupResource()),
+				client:                    client,
+				clock:                     builder.Clock,
+			}
+			builder.Start()
+
+			_, err := c.Sync(context.Background(), test.issuer)
+			require.NoError(t, err)
+
+			actual, err := client.Resource(clusterTrustBundlesGVR).Get(context.Background(), test.expectedName, metav1.GetOptions{})
+			if len(test.expectedCAs) == 0 {
+				assert.True(t, apierrors.IsNotFound(err), "expected no ClusterTrustBundle, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+
+			signerName, _, _ := unstructured.NestedString(actual.Object, "spec", "signerName")
+			assert.Equal(t, test.expectedSignerName, signerName)
+
+			trustBundle, _, _ := unstructured.NestedString(actual.Object, "spec", "trustBundle")
+			actualCAs, err := pki.DecodeX509CertificateChainBytes([]byte(trustBundle))
+			require.NoError(t, err)
+			require.Len(t, actualCAs, len(test.expectedCAs))
+			for i, expected := range test.expectedCAs {
+				expectedCert, err := pki.DecodeX509CertificateBytes(expected)
+				require.NoError(t, err)
+				assert.True(t, expectedCert.Equal(actualCAs[i]), "unexpected certificate %d in bundle: %s", i, actualCAs[i].Subject)
+			}
+		})
+	}
+}
+
+func TestHandleSecret(t *testing.T) {
+	caIssuer := gen.Issuer("ca", gen.SetIssuerNamespace("ns"), gen.SetIssuerCASecretName("ca-key-pair"))
+	otherNamespaceIssuer := gen.Issuer("ca", gen.SetIssuerNamespace("other"), gen.SetIssuerCASecretName("ca-key-pair"))
+	otherSecretIssuer := gen.Issuer("other-ca", gen.SetIssuerNamespace("ns"), gen.SetIssuerCASecretName("other-key-pair"))
+	caClusterIssuer := gen.ClusterIssuer("ca", gen.SetIssuerCASecretName("ca-key-pair"))
+
+	tests := map[string]struct {
+		secretNamespace string
+		expectedKeys    []string
+	}{
+		"should queue the CA Issuers using the Secret in its namespace": {
+			secretNamespace: "ns",
+			expectedKeys:    []string{"ns/ca"},
+		},
+		"should queue the CA ClusterIssuers using the Secret in the cluster resource namespace": {
+			secretNamespace: "cert-manager",
+			expectedKeys:    []string{"ca"},
+		},
+		"should not queue issuers using a Secret of the same name in another namespace": {
+			secretNamespace: "unused",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{caIssuer, otherNamespaceIssuer, otherSecretIssuer, caClusterIssuer},
+			}
+			builder.Init()
+			defer builder.Stop()
+
+			issuerInformer := builder.SharedInformerFactory.Certmanager().V1().Issuers()
+			clusterIssuerInformer := builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+			certificateRequestInformer := builder.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+			require.NoError(t, addIndexers(issuerInformer.Informer(), clusterIssuerInformer.Informer(), certificateRequestInformer.Informer()))
+			c := &controller{
+				issuerIndexer:            issuerInformer.Informer().GetIndexer(),
+				clusterIssuerIndexer:     clusterIssuerInformer.Informer().GetIndexer(),
+				clusterResourceNamespace: "cert-manager",
+				queue:                    workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+			}
+			defer c.queue.ShutDown()
+			builder.Start()
+
+			c.handleSecret(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: test.secretNamespace, Name: "ca-key-pair"}})
+
+			var actualKeys []string
+			for c.queue.Len() > 0 {
+				key, _ := c.queue.Get()
+				actualKeys = append(actualKeys, key.(string))
+				c.queue.Done(key)
+			}
+			assert.Equal(t, test.expectedKeys, actualKeys)
+		})
+	}
+}
+
+func TestHandleBundle(t *testing.T) {
+	bundle := func(name, signerName string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "certificates.k8s.io/v1alpha1",
+			"kind":       "ClusterTrustBundle",
+			"metadata":   map[string]interface{}{"name": name},
+			"spec":       map[string]interface{}{"signerName": signerName},
+		}}
+	}
+
+	tests := map[string]struct {
+		namespace    string
+		bundle       interface{}
+		expectedKeys []string
+	}{
+		"should queue the Issuer of a bundle": {
+			bundle:       bundle("issuers.cert-manager.io:ns.ca:ca", "issuers.cert-manager.io/ns.ca"),
+			expectedKeys: []string{"ns/ca"},
+		},
+		"should queue the ClusterIssuer of a bundle": {
+			bundle:       bundle("clusterissuers.cert-manager.io:ca:ca", "clusterissuers.cert-manager.io/ca"),
+			expectedKeys: []string{"ca"},
+		},
+		"should queue the issuer of a deleted bundle": {
+			bundle:       cache.DeletedFinalStateUnknown{Obj: bundle("clusterissuers.cert-manager.io:ca:ca", "clusterissuers.cert-manager.io/ca")},
+			expectedKeys: []string{"ca"},
+		},
+		"should not queue anything for a bundle not published by the controller": {
+			bundle: bundle("issuers.cert-manager.io:ns.ca:other", "issuers.cert-manager.io/ns.ca"),
+		},
+		"should not queue anything for a bundle of another signer": {
+			bundle: bundle("example.com:signer:ca", "example.com/signer"),
+		},
+		"should not queue an Issuer outside of the namespace the controller operates within": {
+			namespace: "other",
+			bundle:    bundle("issuers.cert-manager.io:ns.ca:ca", "issuers.cert-manager.io/ns.ca"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &controller{
+				namespace: test.namespace,
+				queue:     workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+			}
+			defer c.queue.ShutDown()
+
+			c.handleBundle(test.bundle)
+
+			var actualKeys []string
+			for c.queue.Len() > 0 {
+				key, _ := c.queue.Get()
+				actualKeys = append(actualKeys, key.(string))
+				c.queue.Done(key)
+			}
+			assert.Equal(t, test.expectedKeys, actualKeys)
+		})
+	}
+}
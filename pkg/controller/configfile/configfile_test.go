@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configfile
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFSLoader_Load(t *testing.T) {
+	const expectedFilename = "/path/to/config/file"
+	const kubeConfigPath = "path/to/kubeconfig/file"
+
+	loader, err := NewFSLoader(newFakeFS(func(filename string) ([]byte, error) {
+		if filename != expectedFilename {
+			t.Fatalf("unexpected filename %q passed to ReadFile", filename)
+			return nil, fmt.Errorf("unexpected filename %q", filename)
+		}
+		return []byte(fmt.Sprintf(`apiVersion: controller.config.cert-manager.io/v1alpha1
+kind: ControllerConfiguration
+kubeConfig: %s
+workers:
+  default: 10
+  controllers:
+    issuers: 2
+acmeDNS01Config:
+  recursiveNameservers:
+  - 1.1.1.1:53`, kubeConfigPath)), nil
+	}), expectedFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := loader.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the config loader will force paths to be 'absolute' if they are provided as relative.
+	absKubeConfigPath := "/path/to/config/path/to/kubeconfig/file"
+	if cfg.KubeConfig != absKubeConfigPath {
+		t.Errorf("expected kubeConfig to be set to %q but got %q", absKubeConfigPath, cfg.KubeConfig)
+	}
+
+	if *cfg.Workers.Default != 10 || cfg.Workers.Controllers["issuers"] != 2 {
+		t.Errorf("unexpected workers configuration %+v", cfg.Workers)
+	}
+	if len(cfg.ACMEDNS01Config.RecursiveNameservers) != 1 || cfg.ACMEDNS01Config.RecursiveNameservers[0] != "1.1.1.1:53" {
+		t.Errorf("unexpected recursive nameservers %v", cfg.ACMEDNS01Config.RecursiveNameservers)
+	}
+
+	// fields not set in the file are defaulted
+	if *cfg.MaxConcurrentChallenges != 60 {
+		t.Errorf("expected maxConcurrentChallenges to be defaulted to 60 but got %d", *cfg.MaxConcurrentChallenges)
+	}
+}
+
+func TestFSLoader_LoadStrict(t *testing.T) {
+	loader, err := NewFSLoader(newFakeFS(func(filename string) ([]byte, error) {
+		return []byte(`apiVersion: controller.config.cert-manager.io/v1alpha1
+kind: ControllerConfiguration
+dns01RecursiveNameservers: 1.1.1.1:53`), nil
+	}), "/path/to/config/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := loader.Load(); err == nil {
+		t.Errorf("expected an error when loading a config file with unknown fields")
+	}
+}
+
+func newFakeFS(readFileFunc func(string) ([]byte, error)) Filesystem {
+	return fakeFS{readFileFunc: readFileFunc}
+}
+
+type fakeFS struct {
+	readFileFunc func(string) ([]byte, error)
+}
+
+func (f fakeFS) ReadFile(filename string) ([]byte, error) {
+	return f.readFileFunc(filename)
+}
@@ -46,6 +46,7 @@ import (
 	gwinformers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/controller/issuerbudget"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -109,11 +110,20 @@ type Context struct {
 	// factory with a http-01 resource label filter selector
 	HTTP01ResourceMetadataInformersFactory metadatainformer.SharedInformerFactory
 
+	// UnmanagedCertificateInformerFactory is a metadata only informers
+	// factory for Secrets and ConfigMaps containing certificates that are
+	// not managed by cert-manager
+	UnmanagedCertificateInformerFactory internalinformers.UnmanagedCertificateInformerFactory
+
 	// GWShared can be used to obtain SharedIndexInformer instances for
 	// gateway.networking.k8s.io types
 	GWShared             gwinformers.SharedInformerFactory
 	GatewaySolverEnabled bool
 
+	// IssuerBudgets enforces the rate limits and concurrency budgets of
+	// issuers. It is shared by all controllers that sign requests.
+	IssuerBudgets *issuerbudget.Budgets
+
 	ContextOptions
 }
 
@@ -149,6 +159,7 @@ type ContextOptions struct {
 	IngressShimOptions
 	CertificateOptions
 	SchedulerOptions
+	ShardingOptions
 }
 
 type IssuerOptions struct {
@@ -231,6 +242,13 @@ type SchedulerOptions struct {
 	MaxConcurrentChallenges int
 }
 
+type ShardingOptions struct {
+	// Sharder, if set, restricts controllers built using the Builder to only
+	// reconcile the keys assigned to this replica, allowing several replicas
+	// to run the controllers concurrently.
+	Sharder Sharder
+}
+
 // ContextFactory is used for constructing new Contexts who's clients have been
 // configured with a User Agent built from the component name.
 type ContextFactory struct {
@@ -312,6 +330,8 @@ func NewContextFactory(ctx context.Context, opts ContextOptions) (*ContextFactor
 			GWShared:                               gwSharedInformerFactory,
 			GatewaySolverEnabled:                   clients.gatewayAvailable,
 			HTTP01ResourceMetadataInformersFactory: http01ResourceMetadataInformerFactory,
+			UnmanagedCertificateInformerFactory:    internalinformers.NewUnmanagedCertificateInformerFactory(clients.metadataOnlyClient, resyncPeriod, opts.Namespace),
+			IssuerBudgets:                          issuerbudget.New(opts.Clock, opts.Metrics),
 			ContextOptions:                         opts,
 		},
 	}, nil
@@ -351,6 +371,12 @@ func (c *ContextFactory) Build(component ...string) (*Context, error) {
 	return &ctx, nil
 }
 
+// SetSharder configures all Contexts subsequently built by the factory to
+// only reconcile the keys assigned to this replica by the given Sharder.
+func (c *ContextFactory) SetSharder(sharder Sharder) {
+	c.ctx.Sharder = sharder
+}
+
 // contextClients is a helper struct containing API clients.
 type contextClients struct {
 	kubeClient         kubernetes.Interface
@@ -0,0 +1,279 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expirymonitor
+
+import (
+	"context"
+	"crypto/x509"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the string used to refer to this controller
+	// when enabling or disabling it from command line flags.
+	ControllerName = "expiry-monitor"
+
+	kindSecret    = "Secret"
+	kindConfigMap = "ConfigMap"
+)
+
+// metricsRecorder records the metrics of the certificates found by the
+// controller. It is implemented by *metrics.Metrics.
+type metricsRecorder interface {
+	UpdateUnmanagedCertificate(kind, namespace, name, key string, cert *x509.Certificate)
+	RemoveUnmanagedCertificates(kind, namespace, name string)
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+// The expiry monitor exports the expiry, issuer and key of certificates that
+// are not managed by cert-manager, so that they can be alerted on in the
+// same way as the certificates of Certificate resources. It watches Secrets
+// of type kubernetes.io/tls which do not belong to a Certificate, and
+// ConfigMaps with the cert-manager.io/monitor-expiry=true label.
+//
+// Only the metadata of these resources is cached. Their data is retrieved
+// from the kube apiserver when they change, and is not kept in memory.
+type controller struct {
+	secretLister    cache.GenericLister
+	configMapLister cache.GenericLister
+	client          kubernetes.Interface
+	metrics         metricsRecorder
+
+	queue workqueue.RateLimitingInterface
+}
+
+func NewController(ctx *controllerpkg.Context) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	secretInformer := ctx.UnmanagedCertificateInformerFactory.TLSSecrets()
+	configMapInformer := ctx.UnmanagedCertificateInformerFactory.ConfigMaps()
+
+	c := &controller{
+		secretLister:    secretInformer.Lister(),
+		configMapLister: configMapInformer.Lister(),
+		client:          ctx.Client,
+		metrics:         ctx.Metrics,
+		queue:           queue,
+	}
+
+	secretInformer.Informer().AddEventHandler(c.eventHandlerFor(kindSecret))
+	configMapInformer.Informer().AddEventHandler(c.eventHandlerFor(kindConfigMap))
+
+	// build a list of InformerSynced functions that will be returned by the
+	// Register method.  the controller will only begin processing items once all
+	// of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretInformer.Informer().HasSynced,
+		configMapInformer.Informer().HasSynced,
+	}
+
+	return c, queue, mustSync
+}
+
+// eventHandlerFor returns an event handler which queues keys of the form
+// <kind>/<namespace>/<name> for resources of the given kind.
+func (c *controller) eventHandlerFor(kind string) cache.ResourceEventHandler {
+	enqueue := func(obj interface{}) {
+		key, err := controllerpkg.KeyFunc(obj)
+		if err != nil {
+			logf.Log.Error(err, "failed to compute key for object")
+			return
+		}
+		c.queue.Add(kind + "/" + key)
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Periodic resyncs do not change the data, which would
+			// otherwise be retrieved again for every resource.
+			oldMeta, errOld := meta.Accessor(oldObj)
+			newMeta, errNew := meta.Accessor(newObj)
+			if errOld == nil && errNew == nil && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+			enqueue(newObj)
+		},
+		DeleteFunc: enqueue,
+	}
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	kind, key, _ := strings.Cut(key, "/")
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	var data map[string][]byte
+	switch kind {
+	case kindSecret:
+		data, err = c.secretData(ctx, namespace, name)
+	case kindConfigMap:
+		data, err = c.configMapData(ctx, namespace, name)
+	default:
+		log.Error(nil, "invalid resource kind passed to ProcessItem", "kind", kind)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Remove the metrics of keys which may have been removed or no longer
+	// hold a certificate.
+	c.metrics.RemoveUnmanagedCertificates(kind, namespace, name)
+
+	dataKeys := make([]string, 0, len(data))
+	for dataKey := range data {
+		dataKeys = append(dataKeys, dataKey)
+	}
+	sort.Strings(dataKeys)
+
+	for _, dataKey := range dataKeys {
+		certs, err := pki.DecodeX509CertificateChainBytes(data[dataKey])
+		if err != nil {
+			log.V(logf.DebugLevel).Info("no certificates found", "dataKey", dataKey, "error", err.Error())
+			continue
+		}
+		c.metrics.UpdateUnmanagedCertificate(kind, namespace, name, dataKey, firstToExpire(certs))
+	}
+
+	return nil
+}
+
+// secretData returns the certificate chain of the given Secret, if it
+// exists, is of type kubernetes.io/tls and is not managed by cert-manager.
+func (c *controller) secretData(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	obj, err := c.secretLister.ByNamespace(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Avoid retrieving Secrets which are known to be managed by
+	// cert-manager from their cached metadata.
+	if objMeta, err := meta.Accessor(obj); err == nil && isManaged(objMeta) {
+		return nil, nil
+	}
+
+	secret, err := c.client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if secret.Type != corev1.SecretTypeTLS || isManaged(secret) {
+		return nil, nil
+	}
+
+	return map[string][]byte{corev1.TLSCertKey: secret.Data[corev1.TLSCertKey]}, nil
+}
+
+// configMapData returns the data of the given ConfigMap, if it exists and is
+// labelled to be monitored.
+func (c *controller) configMapData(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	_, err := c.configMapLister.ByNamespace(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	configMap, err := c.client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if configMap.Labels[cmapi.MonitorExpiryLabelKey] != "true" {
+		return nil, nil
+	}
+
+	data := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+	for key, value := range configMap.Data {
+		data[key] = []byte(value)
+	}
+	for key, value := range configMap.BinaryData {
+		data[key] = value
+	}
+	return data, nil
+}
+
+// isManaged returns true if the object was created by cert-manager for a
+// Certificate.
+func isManaged(obj metav1.Object) bool {
+	if obj.GetLabels()[cmapi.PartOfCertManagerControllerLabelKey] == "true" {
+		return true
+	}
+	_, ok := obj.GetAnnotations()[cmapi.CertificateNameKey]
+	return ok
+}
+
+// firstToExpire returns the certificate which expires first, as that is the
+// first one that has to be replaced.
+func firstToExpire(certs []*x509.Certificate) *x509.Certificate {
+	first := certs[0]
+	for _, cert := range certs[1:] {
+		if cert.NotAfter.Before(first.NotAfter) {
+			first = cert
+		}
+	}
+	return first
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	ctrl, queue, mustSync := NewController(ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expirymonitor
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+// fakeMetrics records the notAfter of the certificates exported by the
+// controller, keyed by <kind>/<namespace>/<name>/<key>.
+type fakeMetrics struct {
+	certificates map[string]time.Time
+}
+
+func (f *fakeMetrics) UpdateUnmanagedCertificate(kind, namespace, name, key string, cert *x509.Certificate) {
+	f.certificates[kind+"/"+namespace+"/"+name+"/"+key] = cert.NotAfter
+}
+
+func (f *fakeMetrics) RemoveUnmanagedCertificates(kind, namespace, name string) {
+	prefix := kind + "/" + namespace + "/" + name + "/"
+	for key := range f.certificates {
+		if strings.HasPrefix(key, prefix) {
+			delete(f.certificates, key)
+		}
+	}
+}
+
+// partialMetadata returns the metadata of obj, as returned by the metadata
+// client.
+func partialMetadata(kind string, obj metav1.ObjectMeta) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: kind},
+		ObjectMeta: obj,
+	}
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	crt := gen.Certificate("test", gen.SetCertificateCommonName("example.com"))
+	mustCreateCert := func(notAfter time.Time) []byte {
+		return testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, crt, now.Add(-time.Hour), notAfter)
+	}
+	soon, later := now.Add(time.Hour), now.Add(24*time.Hour)
+
+	tlsSecret := func(name string, mod func(*corev1.Secret)) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+			Type:       corev1.SecretTypeTLS,
+			Data:       map[string][]byte{corev1.TLSCertKey: mustCreateCert(later), corev1.TLSPrivateKeyKey: pk},
+		}
+		if mod != nil {
+			mod(s)
+		}
+		return s
+	}
+
+	tests := map[string]struct {
+		secrets    []*corev1.Secret
+		configMaps []*corev1.ConfigMap
+		key        string
+		existing   map[string]time.Time
+		expected   map[string]time.Time
+	}{
+		"should export the certificate of an unmanaged TLS Secret": {
+			secrets:  []*corev1.Secret{tlsSecret("web", nil)},
+			key:      "Secret/ns/web",
+			expected: map[string]time.Time{"Secret/ns/web/tls.crt": later},
+		},
+		"should export the certificate which expires first": {
+			secrets: []*corev1.Secret{tlsSecret("web", func(s *corev1.Secret) {
+				s.Data[corev1.TLSCertKey] = bytes.Join([][]byte{mustCreateCert(later), mustCreateCert(soon)}, nil)
+			})},
+			key:      "Secret/ns/web",
+			expected: map[string]time.Time{"Secret/ns/web/tls.crt": soon},
+		},
+		"should not export Secrets issued for a Certificate": {
+			secrets: []*corev1.Secret{tlsSecret("web", func(s *corev1.Secret) {
+				s.Annotations = map[string]string{cmapi.CertificateNameKey: "web"}
+			})},
+			key:      "Secret/ns/web",
+			existing: map[string]time.Time{"Secret/ns/web/tls.crt": later},
+			expected: map[string]time.Time{},
+		},
+		"should not export Secrets labelled as part of cert-manager": {
+			secrets: []*corev1.Secret{tlsSecret("web", func(s *corev1.Secret) {
+				s.Labels = map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}
+			})},
+			key:      "Secret/ns/web",
+			expected: map[string]time.Time{},
+		},
+		"should not export Secrets which are not of type kubernetes.io/tls": {
+			secrets: []*corev1.Secret{tlsSecret("web", func(s *corev1.Secret) {
+				s.Type = corev1.SecretTypeOpaque
+			})},
+			key:      "Secret/ns/web",
+			expected: map[string]time.Time{},
+		},
+		"should remove the metrics of deleted Secrets": {
+			key:      "Secret/ns/web",
+			existing: map[string]time.Time{"Secret/ns/web/tls.crt": later, "Secret/ns/other/tls.crt": later},
+			expected: map[string]time.Time{"Secret/ns/other/tls.crt": later},
+		},
+		"should export the certificates of every key of a monitored ConfigMap": {
+			configMaps: []*corev1.ConfigMap{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bundle", Labels: map[string]string{cmapi.MonitorExpiryLabelKey: "true"}},
+				Data: map[string]string{
+					"ca.crt":     string(mustCreateCert(later)),
+					"bundle.pem": string(bytes.Join([][]byte{mustCreateCert(later), mustCreateCert(soon)}, nil)),
+					"config":     "not a certificate",
+				},
+				BinaryData: map[string][]byte{"binary.pem": mustCreateCert(soon)},
+			}},
+			key: "ConfigMap/ns/bundle",
+			expected: map[string]time.Time{
+				"ConfigMap/ns/bundle/ca.crt":     later,
+				"ConfigMap/ns/bundle/bundle.pem": soon,
+				"ConfigMap/ns/bundle/binary.pem": soon,
+			},
+		},
+		"should not export ConfigMaps which are not labelled to be monitored": {
+			configMaps: []*corev1.ConfigMap{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bundle"},
+				Data:       map[string]string{"ca.crt": string(mustCreateCert(later))},
+			}},
+			key:      "ConfigMap/ns/bundle",
+			existing: map[string]time.Time{"ConfigMap/ns/bundle/ca.crt": later},
+			expected: map[string]time.Time{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{T: t}
+			for _, s := range test.secrets {
+				builder.KubeObjects = append(builder.KubeObjects, s)
+				builder.PartialMetadataObjects = append(builder.PartialMetadataObjects, partialMetadata("Secret", s.ObjectMeta))
+			}
+			for _, cm := range test.configMaps {
+				builder.KubeObjects = append(builder.KubeObjects, cm)
+				builder.PartialMetadataObjects = append(builder.PartialMetadataObjects, partialMetadata("ConfigMap", cm.ObjectMeta))
+			}
+			builder.Init()
+
+			c, _, _ := NewController(builder.Context)
+			metrics := &fakeMetrics{certificates: make(map[string]time.Time)}
+			for key, notAfter := range test.existing {
+				metrics.certificates[key] = notAfter
+			}
+			c.metrics = metrics
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := c.ProcessItem(context.Background(), test.key)
+			assert.NoError(t, err)
+
+			assert.Len(t, metrics.certificates, len(test.expected))
+			for key, notAfter := range test.expected {
+				assert.True(t, notAfter.Equal(metrics.certificates[key]), "unexpected notAfter for %s: %s", key, metrics.certificates[key])
+			}
+		})
+	}
+}
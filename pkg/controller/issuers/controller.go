@@ -152,6 +152,10 @@ func init() {
 	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
 		return controllerpkg.NewBuilder(ctx, ControllerName).
 			For(&controller{}).
+			// every replica sets up the issuers, as the controllers of
+			// the resources using them read the clients registered
+			// during setup, such as ACME accounts
+			Unsharded().
 			Complete()
 	})
 }
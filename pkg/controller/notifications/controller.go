@@ -0,0 +1,523 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the string used to refer to this controller
+	// when enabling or disabling it from command line flags.
+	ControllerName = "notifications"
+
+	// deliveryWorkers is the number of deliveries made concurrently.
+	deliveryWorkers = 10
+
+	// deliveryQueueSize is the number of deliveries that may wait for a
+	// delivery worker before ProcessItem blocks.
+	deliveryQueueSize = 100
+
+	// issuerKeyPrefix and clusterIssuerKeyPrefix prefix the work queue keys
+	// of Issuers and ClusterIssuers. They can't collide with the keys of
+	// Certificates, as namespace names can't contain upper case letters or
+	// colons.
+	issuerKeyPrefix        = "Issuer:"
+	clusterIssuerKeyPrefix = "ClusterIssuer:"
+)
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+// The notifications controller sends CloudEvents to the sinks of matching
+// NotificationPolicies. Issued, Failed and IssuerNotReady events are detected
+// by comparing the old and new versions of Certificates and issuers in the
+// informer event handlers, and held until the Certificate or issuer is
+// processed from the work queue, so that each event is only sent by the
+// replica that owns its key when controllers are sharded. Processing a
+// Certificate also sends a NearingExpiry event once per certificate and
+// policy, which is recorded in an annotation on the Certificate so that it
+// isn't sent again after a restart or by another replica. Events are delivered by a fixed number of delivery workers, so that
+// slow sinks don't block the work queue.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	policyLister      cmlisters.NotificationPolicyLister
+	namespaceLister   corelisters.NamespaceLister
+	sharder           controllerpkg.Sharder
+	client            cmclient.Interface
+	fieldManager      string
+
+	clock  clock.Clock
+	sender *sender
+	queue  workqueue.RateLimitingInterface
+
+	// deliveryQueue holds the deliveries waiting for a delivery worker.
+	deliveryQueue chan delivery
+	// deliveries tracks deliveries that have been queued but not finished.
+	deliveries sync.WaitGroup
+
+	// pendingLock guards pending.
+	pendingLock sync.Mutex
+	// pending maps a work queue key to the events detected by the event
+	// handlers that have not been sent yet.
+	pending map[string][]*event
+
+	// notifiedLock guards notified.
+	notifiedLock sync.Mutex
+	// notified maps a policy name and Certificate key to the notAfter of the
+	// certificate that a NearingExpiry event was last sent for. It covers the
+	// time until the informer observes the annotation recording the event.
+	notified map[string]time.Time
+}
+
+// delivery is the delivery of an event to a single sink.
+type delivery struct {
+	log    logr.Logger
+	policy *cmapi.NotificationPolicy
+	sink   cmapi.NotificationSink
+	event  *cloudEvent
+}
+
+func NewController(ctx *controllerpkg.Context) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	policyInformer := ctx.SharedInformerFactory.Certmanager().V1().NotificationPolicies()
+	namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+
+	c := &controller{
+		certificateLister: certificateInformer.Lister(),
+		policyLister:      policyInformer.Lister(),
+		namespaceLister:   namespaceInformer.Lister(),
+		sharder:           ctx.Sharder,
+		client:            ctx.CMClient,
+		fieldManager:      ctx.FieldManager,
+		clock:             ctx.Clock,
+		sender:            &sender{clock: ctx.Clock, metrics: ctx.Metrics},
+		queue:             queue,
+		deliveryQueue:     make(chan delivery, deliveryQueueSize),
+		pending:           make(map[string][]*event),
+		notified:          make(map[string]time.Time),
+	}
+	for i := 0; i < deliveryWorkers; i++ {
+		go c.runDeliveryWorker(ctx.RootContext)
+	}
+
+	certificateInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: c.certificateUpdated,
+		DeleteFunc: c.enqueue,
+	})
+	issuerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.issuerUpdated,
+	})
+	// Policy changes may change when, or whether, NearingExpiry events are
+	// sent, so all Certificates are reconsidered.
+	policyInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { c.enqueueAllCertificates() },
+		UpdateFunc: func(interface{}, interface{}) { c.enqueueAllCertificates() },
+		DeleteFunc: func(interface{}) { c.enqueueAllCertificates() },
+	})
+
+	// build a list of InformerSynced functions that will be returned by the
+	// Register method.  the controller will only begin processing items once all
+	// of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+		policyInformer.Informer().HasSynced,
+		namespaceInformer.Informer().HasSynced,
+	}
+
+	// We only watch ClusterIssuers if we are not running in namespaced mode
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: c.issuerUpdated,
+		})
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	return c, queue, mustSync
+}
+
+func (c *controller) enqueue(obj interface{}) {
+	key, err := controllerpkg.KeyFunc(obj)
+	if err != nil {
+		logf.Log.Error(err, "failed to compute key for object")
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *controller) enqueueAllCertificates() {
+	crts, err := c.certificateLister.List(labels.Everything())
+	if err != nil {
+		logf.Log.Error(err, "failed to list Certificates")
+		return
+	}
+	for _, crt := range crts {
+		c.enqueue(crt)
+	}
+}
+
+func (c *controller) certificateUpdated(oldObj, newObj interface{}) {
+	old, okOld := oldObj.(*cmapi.Certificate)
+	new, okNew := newObj.(*cmapi.Certificate)
+	if !okOld || !okNew {
+		return
+	}
+	key, err := controllerpkg.KeyFunc(new)
+	if err != nil {
+		logf.Log.Error(err, "failed to compute key for object")
+		return
+	}
+	c.addPending(key, eventsForCertificateUpdate(old, new)...)
+	c.queue.Add(key)
+}
+
+func (c *controller) issuerUpdated(oldObj, newObj interface{}) {
+	old, okOld := oldObj.(cmapi.GenericIssuer)
+	new, okNew := newObj.(cmapi.GenericIssuer)
+	if !okOld || !okNew {
+		return
+	}
+	if issuerBecameNotReady(old, new) {
+		key := issuerKey(new)
+		c.addPending(key, issuerNotReadyEvent(new))
+		c.queue.Add(key)
+	}
+}
+
+// issuerKey returns the work queue key of an Issuer or ClusterIssuer.
+func issuerKey(iss cmapi.GenericIssuer) string {
+	if iss.GetObjectMeta().Namespace == "" {
+		return clusterIssuerKeyPrefix + iss.GetObjectMeta().Name
+	}
+	return issuerKeyPrefix + iss.GetObjectMeta().Namespace + "/" + iss.GetObjectMeta().Name
+}
+
+// addPending records events to be sent when the key is next processed.
+// Events are only recorded by the replica that owns the key, as the other
+// replicas never process it.
+func (c *controller) addPending(key string, events ...*event) {
+	if len(events) == 0 || (c.sharder != nil && !c.sharder.Owns(key)) {
+		return
+	}
+	c.pendingLock.Lock()
+	defer c.pendingLock.Unlock()
+	c.pending[key] = append(c.pending[key], events...)
+}
+
+// takePending returns and forgets the events recorded for the key.
+func (c *controller) takePending(key string) []*event {
+	c.pendingLock.Lock()
+	defer c.pendingLock.Unlock()
+	events := c.pending[key]
+	delete(c.pending, key)
+	return events
+}
+
+// notify sends the event to the sinks of every NotificationPolicy that
+// matches it.
+func (c *controller) notify(ctx context.Context, ev *event) {
+	log := logf.FromContext(ctx).WithValues("type", ev.eventType, "kind", ev.data.Kind, "namespace", ev.data.Namespace, "name", ev.data.Name)
+	ctx = logf.NewContext(ctx, log)
+
+	policies, err := c.policyLister.List(labels.Everything())
+	if err != nil {
+		log.Error(err, "failed to list NotificationPolicies")
+		return
+	}
+
+	var matching []*cmapi.NotificationPolicy
+	for _, policy := range policies {
+		ok, err := policyMatches(policy, ev, c.namespaceLabels)
+		if err != nil {
+			log.Error(err, "failed to evaluate NotificationPolicy", "policy", policy.Name)
+			continue
+		}
+		if ok {
+			matching = append(matching, policy)
+		}
+	}
+
+	c.send(ctx, matching, ev)
+}
+
+// send queues the delivery of the event to all sinks of the given policies.
+// It blocks while the delivery queue is full.
+func (c *controller) send(ctx context.Context, policies []*cmapi.NotificationPolicy, ev *event) {
+	if len(policies) == 0 {
+		return
+	}
+	log := logf.FromContext(ctx)
+	ce := ev.toCloudEvent(c.clock.Now())
+	for _, policy := range policies {
+		for _, sink := range policy.Spec.Sinks {
+			c.deliveries.Add(1)
+			select {
+			case c.deliveryQueue <- delivery{log: log, policy: policy, sink: sink, event: ce}:
+			case <-ctx.Done():
+				c.deliveries.Done()
+				return
+			}
+		}
+	}
+}
+
+// runDeliveryWorker delivers queued events until the context is cancelled.
+// Deliveries are retried with backoff, so they are made outside of the work
+// queue to not block it.
+func (c *controller) runDeliveryWorker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-c.deliveryQueue:
+			c.sender.send(logf.NewContext(ctx, d.log), d.policy, d.sink, d.event)
+			c.deliveries.Done()
+		}
+	}
+}
+
+// namespaceLabels returns the labels of the namespace.
+func (c *controller) namespaceLabels(namespace string) (labels.Set, error) {
+	ns, err := c.namespaceLister.Get(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %q: %w", namespace, err)
+	}
+	return ns.Labels, nil
+}
+
+// ProcessItem sends the events detected by the event handlers for the
+// Certificate or issuer. For Certificates, it also sends a NearingExpiry
+// event to every matching policy whose threshold has been reached and that
+// has not been notified about the current certificate yet. If a threshold has
+// not been reached, the Certificate is requeued for when it will be.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	for _, ev := range c.takePending(key) {
+		c.notify(ctx, ev)
+	}
+	if strings.HasPrefix(key, issuerKeyPrefix) || strings.HasPrefix(key, clusterIssuerKeyPrefix) {
+		return nil
+	}
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		c.forgetCertificate(key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if crt.Status.NotAfter == nil {
+		return nil
+	}
+
+	policies, err := c.policyLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	now := c.clock.Now()
+	notAfter := crt.Status.NotAfter.Time
+	persisted := nearingExpiryNotified(crt)
+	ev := certificateEvent(cmapi.NotificationEventNearingExpiry, crt, "NearingExpiry",
+		fmt.Sprintf("The certificate expires at %s", notAfter.UTC().Format(time.RFC3339)))
+
+	var matching []*cmapi.NotificationPolicy
+	var requeueAfter time.Duration
+	for _, policy := range policies {
+		if !policyHasEventType(policy, cmapi.NotificationEventNearingExpiry) {
+			continue
+		}
+
+		notifyAt := notAfter.Add(-nearingExpiryThreshold(policy))
+		if now.Before(notifyAt) {
+			if d := notifyAt.Sub(now); requeueAfter == 0 || d < requeueAfter {
+				requeueAfter = d
+			}
+			continue
+		}
+
+		ok, err := policyMatches(policy, ev, c.namespaceLabels)
+		if err != nil {
+			return err
+		}
+		if !ok || persisted[policy.Name].Equal(notAfter) || c.wasNotified(policy.Name, key, notAfter) {
+			continue
+		}
+		matching = append(matching, policy)
+	}
+
+	if requeueAfter > 0 {
+		c.queue.AddAfter(key, requeueAfter)
+	}
+
+	if len(matching) == 0 {
+		return nil
+	}
+	// The events are recorded before they are sent, so that a failure to
+	// record them is retried without sending them twice.
+	if err := c.recordNotified(ctx, crt, persisted, matching); err != nil {
+		return err
+	}
+	for _, policy := range matching {
+		c.markNotified(policy.Name, key, notAfter)
+	}
+
+	c.send(ctx, matching, ev)
+
+	return nil
+}
+
+// nearingExpiryNotified returns the notAfter of the certificate that each
+// policy has been sent a NearingExpiry event for, as recorded in the
+// annotation of the Certificate. An invalid annotation is ignored.
+func nearingExpiryNotified(crt *cmapi.Certificate) map[string]time.Time {
+	notified := make(map[string]time.Time)
+	value, ok := crt.Annotations[cmapi.NearingExpiryNotifiedAnnotationKey]
+	if !ok {
+		return notified
+	}
+	if err := json.Unmarshal([]byte(value), &notified); err != nil {
+		return make(map[string]time.Time)
+	}
+	return notified
+}
+
+// recordNotified records in the annotation of the Certificate that the
+// policies have been sent a NearingExpiry event for the current certificate.
+// Records of previous certificates are dropped.
+func (c *controller) recordNotified(ctx context.Context, crt *cmapi.Certificate, persisted map[string]time.Time, policies []*cmapi.NotificationPolicy) error {
+	notAfter := crt.Status.NotAfter.Time
+	notified := make(map[string]time.Time)
+	for name, t := range persisted {
+		if t.Equal(notAfter) {
+			notified[name] = t
+		}
+	}
+	for _, policy := range policies {
+		notified[policy.Name] = notAfter
+	}
+
+	value, err := json.Marshal(notified)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				cmapi.NearingExpiryNotifiedAnnotationKey: string(value),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Patch(
+		ctx, crt.Name, apitypes.MergePatchType, patch,
+		metav1.PatchOptions{FieldManager: c.fieldManager},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record NearingExpiry notifications on Certificate: %w", err)
+	}
+	return nil
+}
+
+// wasNotified returns true if a NearingExpiry event has been sent to the
+// policy for the certificate expiring at notAfter.
+func (c *controller) wasNotified(policyName, key string, notAfter time.Time) bool {
+	c.notifiedLock.Lock()
+	defer c.notifiedLock.Unlock()
+	last, ok := c.notified[policyName+"/"+key]
+	return ok && last.Equal(notAfter)
+}
+
+// markNotified records that a NearingExpiry event has been sent to the policy
+// for the certificate expiring at notAfter.
+func (c *controller) markNotified(policyName, key string, notAfter time.Time) {
+	c.notifiedLock.Lock()
+	defer c.notifiedLock.Unlock()
+	c.notified[policyName+"/"+key] = notAfter
+}
+
+// forgetCertificate removes the NearingExpiry records of a deleted
+// Certificate.
+func (c *controller) forgetCertificate(key string) {
+	c.notifiedLock.Lock()
+	defer c.notifiedLock.Unlock()
+	suffix := "/" + key
+	for k := range c.notified {
+		if strings.HasSuffix(k, suffix) {
+			delete(c.notified, k)
+		}
+	}
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	ctrl, queue, mustSync := NewController(ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedNow   = time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	caIssuer   = cmmeta.ObjectReference{Name: "ca-issuer", Kind: cmapi.IssuerKind}
+	acmeIssuer = cmmeta.ObjectReference{Name: "acme", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"}
+)
+
+func namespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func notificationPolicy(name string, sinkURL string, spec cmapi.NotificationPolicySpec) *cmapi.NotificationPolicy {
+	spec.Sinks = []cmapi.NotificationSink{{Name: "receiver", URL: sinkURL}}
+	return &cmapi.NotificationPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+}
+
+// setup starts a controller for the given policies, each of which sends to
+// its own receiver.
+func setup(t *testing.T, kubeObjects, cmObjects []runtime.Object, policies map[string]cmapi.NotificationPolicySpec) (*controller, map[string]*receiver, func()) {
+	receivers := make(map[string]*receiver)
+	var servers []*httptest.Server
+	for name, spec := range policies {
+		r := &receiver{}
+		server := httptest.NewServer(r)
+		servers = append(servers, server)
+		receivers[name] = r
+		cmObjects = append(cmObjects, notificationPolicy(name, server.URL, spec))
+	}
+
+	builder := &testpkg.Builder{
+		T:                  t,
+		Clock:              fakeclock.NewFakeClock(fixedNow),
+		KubeObjects:        kubeObjects,
+		CertManagerObjects: cmObjects,
+	}
+	builder.Init()
+
+	w := &controllerWrapper{}
+	if _, _, err := w.Register(builder.Context); err != nil {
+		t.Fatal(err)
+	}
+	builder.Start()
+
+	return w.controller, receivers, func() {
+		builder.Stop()
+		for _, s := range servers {
+			s.Close()
+		}
+	}
+}
+
+// processQueue processes every key in the work queue, as the controller's
+// workers would, and waits for the resulting deliveries.
+func processQueue(t *testing.T, c *controller) {
+	t.Helper()
+	for c.queue.Len() > 0 {
+		key, _ := c.queue.Get()
+		if err := c.ProcessItem(context.Background(), key.(string)); err != nil {
+			t.Fatalf("unexpected error processing %q: %v", key, err)
+		}
+		c.queue.Done(key)
+	}
+	c.deliveries.Wait()
+}
+
+// fakeSharder owns the keys in owned.
+type fakeSharder struct {
+	owned map[string]bool
+}
+
+func (f *fakeSharder) Owns(key string) bool   { return f.owned[key] }
+func (f *fakeSharder) AddEventHandler(func()) {}
+
+func eventTypes(r *receiver) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var types []string
+	for _, ev := range r.events {
+		types = append(types, ev.Type)
+	}
+	return types
+}
+
+func assertEventTypes(t *testing.T, receivers map[string]*receiver, exp map[string][]string) {
+	t.Helper()
+	for name, r := range receivers {
+		got := eventTypes(r)
+		if len(got) != len(exp[name]) {
+			t.Errorf("policy %q: expected events %v, got %v", name, exp[name], got)
+			continue
+		}
+		for i := range got {
+			if got[i] != exp[name][i] {
+				t.Errorf("policy %q: expected events %v, got %v", name, exp[name], got)
+				break
+			}
+		}
+	}
+}
+
+func TestProcessItemNearingExpiry(t *testing.T) {
+	kubeObjects := []runtime.Object{
+		namespace("team-a", map[string]string{"team": "a"}),
+		namespace("team-b", map[string]string{"team": "b"}),
+	}
+	expiringSoon := gen.Certificate("expiring-soon",
+		gen.SetCertificateNamespace("team-a"),
+		gen.SetCertificateIssuer(caIssuer),
+		gen.SetCertificateNotAfter(metav1.NewTime(fixedNow.Add(12*time.Hour))),
+	)
+	expiringLater := gen.Certificate("expiring-later",
+		gen.SetCertificateNamespace("team-a"),
+		gen.SetCertificateIssuer(caIssuer),
+		gen.SetCertificateNotAfter(metav1.NewTime(fixedNow.Add(30*24*time.Hour))),
+	)
+	oneDay := &metav1.Duration{Duration: 24 * time.Hour}
+
+	c, receivers, stop := setup(t, kubeObjects, []runtime.Object{expiringSoon, expiringLater}, map[string]cmapi.NotificationPolicySpec{
+		"all": {
+			Events:                 []cmapi.NotificationEventType{cmapi.NotificationEventNearingExpiry},
+			NearingExpiryThreshold: oneDay,
+		},
+		"team-b": {
+			Events:                 []cmapi.NotificationEventType{cmapi.NotificationEventNearingExpiry},
+			NearingExpiryThreshold: oneDay,
+			NamespaceSelector:      &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+		},
+		"acme-only": {
+			Events:                 []cmapi.NotificationEventType{cmapi.NotificationEventNearingExpiry},
+			NearingExpiryThreshold: oneDay,
+			IssuerRefs:             []cmmeta.ObjectReference{acmeIssuer},
+		},
+		"default-threshold": {
+			Events: []cmapi.NotificationEventType{cmapi.NotificationEventNearingExpiry},
+		},
+		"issued-only": {
+			Events: []cmapi.NotificationEventType{cmapi.NotificationEventIssued},
+		},
+	})
+	defer stop()
+
+	for _, key := range []string{"team-a/expiring-soon", "team-a/expiring-later"} {
+		// Processing the same Certificate twice must only send one event.
+		for i := 0; i < 2; i++ {
+			if err := c.ProcessItem(context.Background(), key); err != nil {
+				t.Fatalf("unexpected error processing %q: %v", key, err)
+			}
+			c.deliveries.Wait()
+		}
+	}
+
+	assertEventTypes(t, receivers, map[string][]string{
+		"all":               {"io.cert-manager.certificate.nearingexpiry"},
+		"default-threshold": {"io.cert-manager.certificate.nearingexpiry"},
+	})
+
+	r := receivers["all"]
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	got := r.events[0]
+	if got.Source != "/apis/cert-manager.io/v1/namespaces/team-a/certificates/expiring-soon" {
+		t.Errorf("unexpected event source %q", got.Source)
+	}
+	if got.Data.NotAfter == nil || !got.Data.NotAfter.Time.Equal(fixedNow.Add(12*time.Hour)) {
+		t.Errorf("unexpected notAfter in event data: %v", got.Data.NotAfter)
+	}
+}
+
+func TestProcessItemNearingExpiryRecorded(t *testing.T) {
+	kubeObjects := []runtime.Object{
+		namespace("team-a", nil),
+	}
+	notAfter := fixedNow.Add(12 * time.Hour)
+	recorded := func(notified string) *cmapi.Certificate {
+		return gen.Certificate("expiring-soon",
+			gen.SetCertificateNamespace("team-a"),
+			gen.SetCertificateNotAfter(metav1.NewTime(notAfter)),
+			gen.AddCertificateAnnotations(map[string]string{cmapi.NearingExpiryNotifiedAnnotationKey: notified}),
+		)
+	}
+	spec := cmapi.NotificationPolicySpec{
+		Events: []cmapi.NotificationEventType{cmapi.NotificationEventNearingExpiry},
+	}
+
+	tests := map[string]struct {
+		crt         *cmapi.Certificate
+		expEvents   map[string][]string
+		expRecorded []string
+	}{
+		"should not send events already recorded for the current certificate": {
+			crt: recorded(`{"a":"` + notAfter.Format(time.RFC3339) + `"}`),
+			expEvents: map[string][]string{
+				"b": {"io.cert-manager.certificate.nearingexpiry"},
+			},
+			expRecorded: []string{"a", "b"},
+		},
+		"should send events recorded for a previous certificate": {
+			crt: recorded(`{"a":"` + fixedNow.Add(-time.Hour).Format(time.RFC3339) + `","deleted":"` + fixedNow.Add(-time.Hour).Format(time.RFC3339) + `"}`),
+			expEvents: map[string][]string{
+				"a": {"io.cert-manager.certificate.nearingexpiry"},
+				"b": {"io.cert-manager.certificate.nearingexpiry"},
+			},
+			expRecorded: []string{"a", "b"},
+		},
+		"should ignore an invalid annotation": {
+			crt: recorded("invalid"),
+			expEvents: map[string][]string{
+				"a": {"io.cert-manager.certificate.nearingexpiry"},
+				"b": {"io.cert-manager.certificate.nearingexpiry"},
+			},
+			expRecorded: []string{"a", "b"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, receivers, stop := setup(t, kubeObjects, []runtime.Object{test.crt}, map[string]cmapi.NotificationPolicySpec{
+				"a": spec,
+				"b": spec,
+			})
+			defer stop()
+
+			if err := c.ProcessItem(context.Background(), "team-a/expiring-soon"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			c.deliveries.Wait()
+			assertEventTypes(t, receivers, test.expEvents)
+
+			crt, err := c.client.CertmanagerV1().Certificates("team-a").Get(context.Background(), "expiring-soon", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			notified := nearingExpiryNotified(crt)
+			if len(notified) != len(test.expRecorded) {
+				t.Errorf("expected recorded policies %v, got %v", test.expRecorded, notified)
+			}
+			for _, name := range test.expRecorded {
+				if !notified[name].Equal(notAfter) {
+					t.Errorf("expected policy %q to be recorded for %s, got %v", name, notAfter, notified)
+				}
+			}
+		})
+	}
+}
+
+func TestEventHandlers(t *testing.T) {
+	kubeObjects := []runtime.Object{
+		namespace("team-a", map[string]string{"team": "a"}),
+	}
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("team-a"),
+		gen.SetCertificateIssuer(caIssuer),
+		gen.SetCertificateRevision(1),
+	)
+	issuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("team-a"),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionTrue}),
+	)
+
+	c, receivers, stop := setup(t, kubeObjects, nil, map[string]cmapi.NotificationPolicySpec{
+		"all": {
+			Events: []cmapi.NotificationEventType{
+				cmapi.NotificationEventIssued,
+				cmapi.NotificationEventFailed,
+				cmapi.NotificationEventIssuerNotReady,
+			},
+			IssuerRefs: []cmmeta.ObjectReference{{Name: "ca-issuer"}},
+		},
+		"failed-team-b": {
+			Events:            []cmapi.NotificationEventType{cmapi.NotificationEventFailed},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+		},
+		"other-issuer": {
+			Events:     []cmapi.NotificationEventType{cmapi.NotificationEventIssued},
+			IssuerRefs: []cmmeta.ObjectReference{acmeIssuer},
+		},
+	})
+	defer stop()
+
+	// A new revision is an Issued event.
+	issued := gen.CertificateFrom(crt, gen.SetCertificateRevision(2))
+	c.certificateUpdated(crt, issued)
+	processQueue(t, c)
+
+	// A new lastFailureTime is a Failed event.
+	failed := gen.CertificateFrom(issued,
+		gen.SetCertificateLastFailureTime(metav1.NewTime(fixedNow)),
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionFalse, Reason: "Failed", Message: "The CA is unavailable"}),
+	)
+	c.certificateUpdated(issued, failed)
+	processQueue(t, c)
+
+	// Unrelated updates do not send events.
+	c.certificateUpdated(failed, failed.DeepCopy())
+	processQueue(t, c)
+
+	// The issuer stopping being Ready is an IssuerNotReady event, but
+	// staying not Ready is not.
+	notReady := issuer.DeepCopy()
+	notReady.Status.Conditions = []cmapi.IssuerCondition{{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionFalse, Reason: "Error"}}
+	c.issuerUpdated(issuer, notReady)
+	processQueue(t, c)
+	c.issuerUpdated(notReady, notReady.DeepCopy())
+	processQueue(t, c)
+
+	assertEventTypes(t, receivers, map[string][]string{
+		"all": {
+			"io.cert-manager.certificate.issued",
+			"io.cert-manager.certificate.failed",
+			"io.cert-manager.issuer.notready",
+		},
+	})
+
+	r := receivers["all"]
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if got := r.events[1].Data; got.Reason != "Failed" || got.Message != "The CA is unavailable" {
+		t.Errorf("unexpected Failed event data: %+v", got)
+	}
+}
+
+func TestEventHandlersSharded(t *testing.T) {
+	kubeObjects := []runtime.Object{
+		namespace("team-a", map[string]string{"team": "a"}),
+	}
+	owned := gen.Certificate("owned", gen.SetCertificateNamespace("team-a"), gen.SetCertificateRevision(1))
+	other := gen.Certificate("other", gen.SetCertificateNamespace("team-a"), gen.SetCertificateRevision(1))
+	issuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("team-a"),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionTrue}),
+	)
+	notReady := issuer.DeepCopy()
+	notReady.Status.Conditions = []cmapi.IssuerCondition{{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionFalse, Reason: "Error"}}
+
+	c, receivers, stop := setup(t, kubeObjects, nil, map[string]cmapi.NotificationPolicySpec{
+		"all": {
+			Events: []cmapi.NotificationEventType{
+				cmapi.NotificationEventIssued,
+				cmapi.NotificationEventIssuerNotReady,
+			},
+		},
+	})
+	defer stop()
+	c.sharder = &fakeSharder{owned: map[string]bool{"team-a/owned": true}}
+
+	// Only events for keys owned by this replica are sent.
+	c.certificateUpdated(owned, gen.CertificateFrom(owned, gen.SetCertificateRevision(2)))
+	c.certificateUpdated(other, gen.CertificateFrom(other, gen.SetCertificateRevision(2)))
+	c.issuerUpdated(issuer, notReady)
+	processQueue(t, c)
+
+	assertEventTypes(t, receivers, map[string][]string{
+		"all": {"io.cert-manager.certificate.issued"},
+	})
+	if len(c.pending) != 0 {
+		t.Errorf("expected no pending events, got %v", c.pending)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+const (
+	// cloudEventsSpecVersion is the version of the CloudEvents specification
+	// that events are encoded with.
+	cloudEventsSpecVersion = "1.0"
+
+	// cloudEventsContentType is the content type used for events sent in
+	// structured content mode.
+	cloudEventsContentType = "application/cloudevents+json"
+)
+
+// cloudEventTypes maps event types to the CloudEvents `type` attribute.
+var cloudEventTypes = map[cmapi.NotificationEventType]string{
+	cmapi.NotificationEventIssued:         "io.cert-manager.certificate.issued",
+	cmapi.NotificationEventFailed:         "io.cert-manager.certificate.failed",
+	cmapi.NotificationEventNearingExpiry:  "io.cert-manager.certificate.nearingexpiry",
+	cmapi.NotificationEventIssuerNotReady: "io.cert-manager.issuer.notready",
+}
+
+// cloudEvent is a CloudEvent in the JSON event format, as sent to sinks in
+// structured content mode.
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            eventData `json:"data"`
+}
+
+// eventData is the payload of every event sent by this controller.
+type eventData struct {
+	// Kind, Namespace and Name identify the resource the event is about.
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// IssuerRef is the issuer that the Certificate references. It is unset
+	// for issuer events.
+	IssuerRef *cmmeta.ObjectReference `json:"issuerRef,omitempty"`
+
+	// Revision and NotAfter are copied from the Certificate status.
+	Revision *int         `json:"revision,omitempty"`
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// Reason and Message explain why the event was sent.
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// event is an occurrence that may be sent to the sinks of every
+// NotificationPolicy that matches it.
+type event struct {
+	eventType cmapi.NotificationEventType
+
+	// namespace is the namespace of the resource, or empty for
+	// ClusterIssuers.
+	namespace string
+
+	// issuerRef is the issuer the event is about or, for Certificate
+	// events, the issuer referenced by the Certificate.
+	issuerRef cmmeta.ObjectReference
+
+	data eventData
+}
+
+// toCloudEvent builds the CloudEvent that is sent for the event.
+func (e *event) toCloudEvent(now time.Time) *cloudEvent {
+	resource := strings.ToLower(e.data.Kind) + "s"
+	source := fmt.Sprintf("/apis/%s/%s/%s/%s", cmapi.SchemeGroupVersion.Group, cmapi.SchemeGroupVersion.Version, resource, e.data.Name)
+	if e.namespace != "" {
+		source = fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s/%s", cmapi.SchemeGroupVersion.Group, cmapi.SchemeGroupVersion.Version, e.namespace, resource, e.data.Name)
+	}
+	return &cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              string(uuid.NewUUID()),
+		Source:          source,
+		Type:            cloudEventTypes[e.eventType],
+		Subject:         e.data.Name,
+		Time:            now.UTC(),
+		DataContentType: "application/json",
+		Data:            e.data,
+	}
+}
+
+// certificateEvent builds an event of the given type for a Certificate.
+func certificateEvent(eventType cmapi.NotificationEventType, crt *cmapi.Certificate, reason, message string) *event {
+	return &event{
+		eventType: eventType,
+		namespace: crt.Namespace,
+		issuerRef: crt.Spec.IssuerRef,
+		data: eventData{
+			Kind:      cmapi.CertificateKind,
+			Namespace: crt.Namespace,
+			Name:      crt.Name,
+			IssuerRef: crt.Spec.IssuerRef.DeepCopy(),
+			Revision:  crt.Status.Revision,
+			NotAfter:  crt.Status.NotAfter,
+			Reason:    reason,
+			Message:   message,
+		},
+	}
+}
+
+// issuerNotReadyEvent builds an IssuerNotReady event for an Issuer or
+// ClusterIssuer.
+func issuerNotReadyEvent(iss cmapi.GenericIssuer) *event {
+	kind := cmapi.IssuerKind
+	if _, ok := iss.(*cmapi.ClusterIssuer); ok {
+		kind = cmapi.ClusterIssuerKind
+	}
+	var reason, message string
+	for _, cond := range iss.GetStatus().Conditions {
+		if cond.Type == cmapi.IssuerConditionReady {
+			reason, message = cond.Reason, cond.Message
+		}
+	}
+	return &event{
+		eventType: cmapi.NotificationEventIssuerNotReady,
+		namespace: iss.GetNamespace(),
+		issuerRef: cmmeta.ObjectReference{Name: iss.GetName(), Kind: kind, Group: cmapi.SchemeGroupVersion.Group},
+		data: eventData{
+			Kind:      kind,
+			Namespace: iss.GetNamespace(),
+			Name:      iss.GetName(),
+			Reason:    reason,
+			Message:   message,
+		},
+	}
+}
+
+// eventsForCertificateUpdate returns the events caused by a Certificate
+// changing from old to new. A new revision means a certificate was issued, and
+// a new lastFailureTime means an issuance attempt failed.
+func eventsForCertificateUpdate(old, new *cmapi.Certificate) []*event {
+	var events []*event
+
+	if new.Status.Revision != nil && (old.Status.Revision == nil || *new.Status.Revision > *old.Status.Revision) {
+		events = append(events, certificateEvent(cmapi.NotificationEventIssued, new, "Issued", "The certificate has been successfully issued"))
+	}
+
+	if new.Status.LastFailureTime != nil && (old.Status.LastFailureTime == nil || !new.Status.LastFailureTime.Equal(old.Status.LastFailureTime)) {
+		reason, message := "Failed", "The certificate failed to be issued"
+		if cond := apiutil.GetCertificateCondition(new, cmapi.CertificateConditionIssuing); cond != nil && cond.Status == cmmeta.ConditionFalse {
+			reason, message = cond.Reason, cond.Message
+		}
+		events = append(events, certificateEvent(cmapi.NotificationEventFailed, new, reason, message))
+	}
+
+	return events
+}
+
+// issuerBecameNotReady returns true if the issuer changed from being Ready,
+// or not having a Ready condition, to not being Ready.
+func issuerBecameNotReady(old, new cmapi.GenericIssuer) bool {
+	return !apiutil.IssuerHasCondition(old, cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionFalse}) &&
+		apiutil.IssuerHasCondition(new, cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionFalse})
+}
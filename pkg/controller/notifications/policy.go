@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// defaultNearingExpiryThreshold is used if a NotificationPolicy does not set
+// nearingExpiryThreshold.
+const defaultNearingExpiryThreshold = 14 * 24 * time.Hour
+
+// namespaceLabelsFunc returns the labels of the named namespace.
+type namespaceLabelsFunc func(namespace string) (labels.Set, error)
+
+// policyMatches returns true if the policy selects the event.
+// namespaceLabels is only called if the policy has a namespace selector and
+// the event is about a namespaced resource.
+func policyMatches(policy *cmapi.NotificationPolicy, ev *event, namespaceLabels namespaceLabelsFunc) (bool, error) {
+	if !policyHasEventType(policy, ev.eventType) {
+		return false, nil
+	}
+
+	if len(policy.Spec.IssuerRefs) > 0 {
+		found := false
+		for _, ref := range policy.Spec.IssuerRefs {
+			if issuerRefsEqual(ref, ev.issuerRef) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if policy.Spec.NamespaceSelector != nil && ev.namespace != "" {
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespaceSelector: %w", err)
+		}
+		nsLabels, err := namespaceLabels(ev.namespace)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(nsLabels) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// policyHasEventType returns true if the policy sends events of the given
+// type.
+func policyHasEventType(policy *cmapi.NotificationPolicy, eventType cmapi.NotificationEventType) bool {
+	for _, e := range policy.Spec.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// nearingExpiryThreshold returns how long before expiry the policy sends a
+// NearingExpiry event.
+func nearingExpiryThreshold(policy *cmapi.NotificationPolicy) time.Duration {
+	if policy.Spec.NearingExpiryThreshold != nil {
+		return policy.Spec.NearingExpiryThreshold.Duration
+	}
+	return defaultNearingExpiryThreshold
+}
+
+// issuerRefsEqual compares two issuer references, treating an empty kind as
+// Issuer and an empty group as cert-manager.io.
+func issuerRefsEqual(a, b cmmeta.ObjectReference) bool {
+	return a.Name == b.Name &&
+		defaultString(a.Kind, cmapi.IssuerKind) == defaultString(b.Kind, cmapi.IssuerKind) &&
+		defaultString(a.Group, cmapi.SchemeGroupVersion.Group) == defaultString(b.Group, cmapi.SchemeGroupVersion.Group)
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
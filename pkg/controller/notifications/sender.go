@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"k8s.io/utils/clock"
+	"k8s.io/utils/lru"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+const (
+	// defaultMaxAttempts is the number of delivery attempts made if a
+	// NotificationPolicy does not configure retries.
+	defaultMaxAttempts = 5
+
+	// defaultBackoff is the delay before the first retry if a
+	// NotificationPolicy does not configure retries.
+	defaultBackoff = time.Second
+
+	// maxBackoff caps the delay between two delivery attempts.
+	maxBackoff = 5 * time.Minute
+
+	// requestTimeout is the timeout of a single delivery attempt.
+	requestTimeout = 10 * time.Second
+
+	// clientCacheSize is the maximum number of HTTP clients, one per distinct
+	// sink CA bundle, which are kept in memory.
+	clientCacheSize = 256
+)
+
+// clients caches the HTTP client of each sink CA bundle, so that connections
+// to a sink are reused across deliveries instead of a new transport being
+// built for every event. Clients are safe for concurrent use, so they are
+// shared between sinks with the same CA bundle.
+var clients = lru.NewWithEvictionFunc(clientCacheSize, func(_ lru.Key, value interface{}) {
+	if c, ok := value.(cachedClient); ok && c.client != nil {
+		c.client.CloseIdleConnections()
+	}
+})
+
+// cachedClient is the result of building the HTTP client of a CA bundle.
+type cachedClient struct {
+	client *http.Client
+	err    error
+}
+
+// errPermanent is returned by deliver if the sink rejected the event in a way
+// that retrying will not fix.
+var errPermanent = errors.New("permanent delivery failure")
+
+// sender delivers CloudEvents to the sinks of NotificationPolicies, retrying
+// failed deliveries with exponential backoff.
+type sender struct {
+	clock   clock.Clock
+	metrics *metrics.Metrics
+}
+
+// send delivers the event to the sink, retrying according to the retry
+// policy. Events that cannot be delivered are counted as dead letters.
+// send blocks until the event has been delivered, all attempts have failed
+// or the context is cancelled, and returns whether the event was delivered.
+func (s *sender) send(ctx context.Context, policy *cmapi.NotificationPolicy, sink cmapi.NotificationSink, ev *cloudEvent) bool {
+	log := logf.FromContext(ctx).WithValues("policy", policy.Name, "sink", sink.Name, "type", ev.Type, "id", ev.ID)
+
+	maxAttempts, backoff := retryPolicy(policy.Spec.Retry)
+
+	client, err := clientFor(sink.CABundle)
+	if err != nil {
+		log.Error(err, "failed to build HTTP client for sink, dropping event")
+		s.deadLetter(policy, sink, ev)
+		return false
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = deliver(ctx, client, sink.URL, ev)
+		if err == nil {
+			log.V(logf.DebugLevel).Info("delivered event", "attempt", attempt)
+			return true
+		}
+		if errors.Is(err, errPermanent) || attempt >= maxAttempts {
+			log.Error(err, "failed to deliver event, dropping it", "attempts", attempt)
+			s.deadLetter(policy, sink, ev)
+			return false
+		}
+
+		log.V(logf.DebugLevel).Info("failed to deliver event, retrying", "attempt", attempt, "backoff", backoff, "error", err.Error())
+		select {
+		case <-ctx.Done():
+			log.Error(ctx.Err(), "stopped retrying event delivery", "attempts", attempt)
+			s.deadLetter(policy, sink, ev)
+			return false
+		case <-s.clock.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func (s *sender) deadLetter(policy *cmapi.NotificationPolicy, sink cmapi.NotificationSink, ev *cloudEvent) {
+	if s.metrics != nil {
+		s.metrics.IncrementNotificationDeadLetterCount(policy.Name, sink.Name, ev.Type)
+	}
+}
+
+// retryPolicy returns the maximum number of attempts and the initial backoff
+// configured by the retry policy, falling back to the defaults.
+func retryPolicy(retry *cmapi.NotificationRetryPolicy) (int, time.Duration) {
+	maxAttempts, backoff := defaultMaxAttempts, defaultBackoff
+	if retry == nil {
+		return maxAttempts, backoff
+	}
+	if retry.MaxAttempts != nil {
+		maxAttempts = int(*retry.MaxAttempts)
+	}
+	if retry.Backoff != nil {
+		backoff = retry.Backoff.Duration
+	}
+	return maxAttempts, backoff
+}
+
+// clientFor returns the HTTP client of the CA bundle from the cache,
+// building and caching it if it isn't there yet.
+func clientFor(caBundle []byte) (*http.Client, error) {
+	key := string(caBundle)
+	if c, ok := clients.Get(key); ok {
+		c := c.(cachedClient)
+		return c.client, c.err
+	}
+	client, err := httpClient(caBundle)
+	clients.Add(key, cachedClient{client: client, err: err})
+	return client, err
+}
+
+// httpClient returns an HTTP client that trusts the given PEM encoded CA
+// bundle, or the system trust store if the bundle is empty.
+func httpClient(caBundle []byte) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caBundle) > 0 {
+		pool := x509.NewCertPool()
+		if ok := pool.AppendCertsFromPEM(caBundle); !ok {
+			return nil, errors.New("caBundle does not contain any valid certificates")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &http.Client{Transport: transport, Timeout: requestTimeout}, nil
+}
+
+// deliver sends a single event to the URL in structured content mode.
+// Network errors, 5xx responses and the 408 and 429 status codes are
+// retryable, any other non-2xx response is wrapped with errPermanent.
+func deliver(ctx context.Context, client *http.Client, url string, ev *cloudEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("%w: failed to encode event: %v", errPermanent, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: failed to build request: %v", errPermanent, err)
+	}
+	req.Header.Set("Content-Type", cloudEventsContentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusRequestTimeout, resp.StatusCode == http.StatusTooManyRequests:
+		return fmt.Errorf("sink responded with status %d", resp.StatusCode)
+	default:
+		return fmt.Errorf("%w: sink responded with status %d", errPermanent, resp.StatusCode)
+	}
+}
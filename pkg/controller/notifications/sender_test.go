@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// receiver is a local CloudEvents sink that responds with the given status
+// codes in order, and with 200 once they have been used up.
+type receiver struct {
+	lock     sync.Mutex
+	statuses []int
+	events   []cloudEvent
+	attempts int
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.attempts++
+
+	if ct := req.Header.Get("Content-Type"); ct != cloudEventsContentType {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+	if len(r.statuses) > 0 {
+		status := r.statuses[0]
+		r.statuses = r.statuses[1:]
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+	}
+	var ev cloudEvent
+	if err := json.NewDecoder(req.Body).Decode(&ev); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.events = append(r.events, ev)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func TestSend(t *testing.T) {
+	ev := &cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              "test-id",
+		Source:          "/apis/cert-manager.io/v1/namespaces/default/certificates/test",
+		Type:            "io.cert-manager.certificate.failed",
+		Time:            time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		DataContentType: "application/json",
+		Data:            eventData{Kind: cmapi.CertificateKind, Namespace: "default", Name: "test", Reason: "Failed"},
+	}
+	fastRetry := &cmapi.NotificationRetryPolicy{
+		MaxAttempts: pointer.Int32(3),
+		Backoff:     &metav1.Duration{Duration: time.Millisecond},
+	}
+
+	tests := map[string]struct {
+		statuses     []int
+		retry        *cmapi.NotificationRetryPolicy
+		tls          bool
+		expDelivered bool
+		expAttempts  int
+	}{
+		"event is delivered on the first attempt": {
+			retry:        fastRetry,
+			expDelivered: true,
+			expAttempts:  1,
+		},
+		"event is delivered to a TLS sink that is trusted using caBundle": {
+			retry:        fastRetry,
+			tls:          true,
+			expDelivered: true,
+			expAttempts:  1,
+		},
+		"retryable failures are retried until the event is delivered": {
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+			retry:        fastRetry,
+			expDelivered: true,
+			expAttempts:  3,
+		},
+		"event is dropped after maxAttempts": {
+			statuses:     []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError},
+			retry:        fastRetry,
+			expDelivered: false,
+			expAttempts:  3,
+		},
+		"permanent failures are not retried": {
+			statuses:     []int{http.StatusForbidden},
+			retry:        fastRetry,
+			expDelivered: false,
+			expAttempts:  1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &receiver{statuses: test.statuses}
+			var server *httptest.Server
+			sink := cmapi.NotificationSink{Name: "test"}
+			if test.tls {
+				server = httptest.NewTLSServer(r)
+				sink.CABundle = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+			} else {
+				server = httptest.NewServer(r)
+			}
+			defer server.Close()
+			sink.URL = server.URL
+
+			policy := &cmapi.NotificationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy"},
+				Spec:       cmapi.NotificationPolicySpec{Retry: test.retry},
+			}
+
+			s := &sender{clock: clock.RealClock{}}
+			delivered := s.send(context.Background(), policy, sink, ev)
+			if delivered != test.expDelivered {
+				t.Errorf("expected delivered=%t, got %t", test.expDelivered, delivered)
+			}
+
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			if r.attempts != test.expAttempts {
+				t.Errorf("expected %d attempts, got %d", test.expAttempts, r.attempts)
+			}
+			if !test.expDelivered {
+				return
+			}
+			if len(r.events) != 1 {
+				t.Fatalf("expected the receiver to get 1 event, got %d", len(r.events))
+			}
+			got := r.events[0]
+			if got.ID != ev.ID || got.Type != ev.Type || got.Source != ev.Source || got.SpecVersion != "1.0" || got.Data != ev.Data {
+				t.Errorf("unexpected event received, exp=%+v got=%+v", ev, got)
+			}
+		})
+	}
+}
+
+func TestClientFor(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	client, err := clientFor(caBundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := clientFor(append([]byte(nil), caBundle...)); again != client {
+		t.Errorf("expected the client of a CA bundle to be reused")
+	}
+	if system, _ := clientFor(nil); system == client {
+		t.Errorf("expected CA bundles to have separate clients")
+	}
+	if _, err := clientFor([]byte("invalid")); err == nil {
+		t.Errorf("expected an error for an invalid CA bundle")
+	}
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+)
+
+// unfinishedWorkUpdatePeriod is how often the metrics of the items being
+// processed are updated, matching workqueue.Type.
+const unfinishedWorkUpdatePeriod = 500 * time.Millisecond
+
+var (
+	workqueueMetricsProviderOnce sync.Once
+	// workqueueMetricsProvider is the provider priority queues report their
+	// metrics to. The provider set by workqueue.SetProvider cannot be read
+	// back, so both are set by SetWorkqueueMetricsProvider.
+	workqueueMetricsProvider workqueue.MetricsProvider
+)
+
+// SetWorkqueueMetricsProvider sets the provider used to report the metrics of
+// every work queue, including priority queues. As with
+// workqueue.SetProvider, only the first call has any effect, and only queues
+// created after it report metrics.
+func SetWorkqueueMetricsProvider(mp workqueue.MetricsProvider) {
+	workqueue.SetProvider(mp)
+	workqueueMetricsProviderOnce.Do(func() { workqueueMetricsProvider = mp })
+}
+
+// DeadlineFunc returns the time by which the given work queue item should be
+// processed. Items with earlier deadlines are processed first, and the zero
+// time is the most urgent.
+type DeadlineFunc func(item interface{}) time.Time
+
+// NewPriorityRateLimitingQueue returns a rate limiting work queue that hands
+// out items in order of urgency, as reported by deadline, rather than in the
+// order in which they were added. Items with equal deadlines are handed out in
+// the order in which they were added.
+// Delayed and rate limited items behave exactly as they do for a queue built
+// with workqueue.NewNamedRateLimitingQueue, and are ordered by their deadline
+// once their delay has elapsed. As with the standard queue, an item is never
+// handed to more than one worker at a time, and the same metrics are reported
+// under the given name.
+func NewPriorityRateLimitingQueue(rateLimiter workqueue.RateLimiter, name string, deadline DeadlineFunc) workqueue.RateLimitingInterface {
+	q := newPriorityQueue(deadline)
+	if name != "" && workqueueMetricsProvider != nil {
+		q.metrics = newPriorityQueueMetrics(workqueueMetricsProvider, name, clock.RealClock{})
+		go q.updateUnfinishedWorkLoop()
+	}
+	return workqueue.NewRateLimitingQueueWithDelayingInterface(
+		workqueue.NewDelayingQueueWithCustomQueue(q, name),
+		rateLimiter,
+	)
+}
+
+// priorityQueue implements workqueue.Interface using a heap ordered by the
+// deadline of each item. It follows the semantics of workqueue.Type: an item
+// that is added whilst it is being processed is only queued again once Done
+// is called for it.
+type priorityQueue struct {
+	deadline DeadlineFunc
+
+	cond *sync.Cond
+
+	// queue is the heap of items that are waiting to be processed.
+	queue priorityHeap
+	// queued indexes the entries in queue by item.
+	queued map[interface{}]*priorityEntry
+	// dirty contains all items that need to be processed, including items
+	// that are currently being processed and will be re-queued by Done.
+	dirty map[interface{}]struct{}
+	// processing contains the items that have been handed out by Get and not
+	// yet marked as Done.
+	processing map[interface{}]struct{}
+
+	// seq orders items that have equal deadlines by when they were queued.
+	seq uint64
+
+	shuttingDown bool
+	drain        bool
+
+	// metrics is nil if the queue does not report metrics.
+	metrics *priorityQueueMetrics
+}
+
+var _ workqueue.Interface = &priorityQueue{}
+
+func newPriorityQueue(deadline DeadlineFunc) *priorityQueue {
+	return &priorityQueue{
+		deadline:   deadline,
+		cond:       sync.NewCond(&sync.Mutex{}),
+		queued:     make(map[interface{}]*priorityEntry),
+		dirty:      make(map[interface{}]struct{}),
+		processing: make(map[interface{}]struct{}),
+	}
+}
+
+// Add marks item as needing processing. If the item is already queued its
+// deadline is re-evaluated, so that changes in urgency are respected.
+func (q *priorityQueue) Add(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	if entry, ok := q.queued[item]; ok {
+		entry.deadline = q.deadline(item)
+		heap.Fix(&q.queue, entry.index)
+		return
+	}
+	if _, ok := q.dirty[item]; ok {
+		return
+	}
+
+	q.metrics.add(item)
+	q.dirty[item] = struct{}{}
+	if _, ok := q.processing[item]; ok {
+		return
+	}
+
+	q.push(item)
+	q.cond.Signal()
+}
+
+// Len returns the number of items waiting to be processed.
+func (q *priorityQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.queue.Len()
+}
+
+// Get blocks until it can return the most urgent item to be processed. If
+// shutdown is true the caller should end their goroutine. Done must be called
+// with the item once it has been processed.
+func (q *priorityQueue) Get() (item interface{}, shutdown bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for q.queue.Len() == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if q.queue.Len() == 0 {
+		// We must be shutting down.
+		return nil, true
+	}
+
+	entry := heap.Pop(&q.queue).(*priorityEntry)
+	delete(q.queued, entry.item)
+	q.metrics.get(entry.item)
+	q.processing[entry.item] = struct{}{}
+	delete(q.dirty, entry.item)
+
+	return entry.item, false
+}
+
+// Done marks item as done processing, and re-queues it if it was added again
+// whilst it was being processed.
+func (q *priorityQueue) Done(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	q.metrics.done(item)
+	delete(q.processing, item)
+	if _, ok := q.dirty[item]; ok {
+		q.push(item)
+		q.cond.Signal()
+	} else if len(q.processing) == 0 {
+		q.cond.Signal()
+	}
+}
+
+// ShutDown causes the queue to ignore all new items and immediately instructs
+// workers to exit.
+func (q *priorityQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = false
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain causes the queue to ignore all new items, and returns once
+// all items currently being processed have been marked as Done.
+func (q *priorityQueue) ShutDownWithDrain() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = true
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) != 0 && q.drain {
+		q.cond.Wait()
+	}
+}
+
+func (q *priorityQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.shuttingDown
+}
+
+// updateUnfinishedWorkLoop periodically updates the metrics of the items
+// being processed until the queue is shut down.
+func (q *priorityQueue) updateUnfinishedWorkLoop() {
+	t := q.metrics.clock.NewTicker(unfinishedWorkUpdatePeriod)
+	defer t.Stop()
+	for range t.C() {
+		q.cond.L.Lock()
+		if q.shuttingDown {
+			q.cond.L.Unlock()
+			return
+		}
+		q.metrics.updateUnfinishedWork()
+		q.cond.L.Unlock()
+	}
+}
+
+// push must be called whilst holding the lock.
+func (q *priorityQueue) push(item interface{}) {
+	entry := &priorityEntry{item: item, deadline: q.deadline(item), seq: q.seq}
+	q.seq++
+	q.queued[item] = entry
+	heap.Push(&q.queue, entry)
+}
+
+// priorityQueueMetrics reports the metrics that workqueue.Type reports. Its
+// methods may be called on a nil *priorityQueueMetrics, and must be called
+// whilst holding the lock of the queue.
+type priorityQueueMetrics struct {
+	clock clock.WithTicker
+
+	depth                   workqueue.GaugeMetric
+	adds                    workqueue.CounterMetric
+	latency                 workqueue.HistogramMetric
+	workDuration            workqueue.HistogramMetric
+	unfinishedWorkSeconds   workqueue.SettableGaugeMetric
+	longestRunningProcessor workqueue.SettableGaugeMetric
+
+	addTimes             map[interface{}]time.Time
+	processingStartTimes map[interface{}]time.Time
+}
+
+func newPriorityQueueMetrics(mp workqueue.MetricsProvider, name string, c clock.WithTicker) *priorityQueueMetrics {
+	return &priorityQueueMetrics{
+		clock:                   c,
+		depth:                   mp.NewDepthMetric(name),
+		adds:                    mp.NewAddsMetric(name),
+		latency:                 mp.NewLatencyMetric(name),
+		workDuration:            mp.NewWorkDurationMetric(name),
+		unfinishedWorkSeconds:   mp.NewUnfinishedWorkSecondsMetric(name),
+		longestRunningProcessor: mp.NewLongestRunningProcessorSecondsMetric(name),
+		addTimes:                make(map[interface{}]time.Time),
+		processingStartTimes:    make(map[interface{}]time.Time),
+	}
+}
+
+func (m *priorityQueueMetrics) add(item interface{}) {
+	if m == nil {
+		return
+	}
+	m.adds.Inc()
+	m.depth.Inc()
+	if _, ok := m.addTimes[item]; !ok {
+		m.addTimes[item] = m.clock.Now()
+	}
+}
+
+func (m *priorityQueueMetrics) get(item interface{}) {
+	if m == nil {
+		return
+	}
+	m.depth.Dec()
+	m.processingStartTimes[item] = m.clock.Now()
+	if start, ok := m.addTimes[item]; ok {
+		m.latency.Observe(m.clock.Since(start).Seconds())
+		delete(m.addTimes, item)
+	}
+}
+
+func (m *priorityQueueMetrics) done(item interface{}) {
+	if m == nil {
+		return
+	}
+	if start, ok := m.processingStartTimes[item]; ok {
+		m.workDuration.Observe(m.clock.Since(start).Seconds())
+		delete(m.processingStartTimes, item)
+	}
+}
+
+func (m *priorityQueueMetrics) updateUnfinishedWork() {
+	var total, oldest float64
+	now := m.clock.Now()
+	for _, start := range m.processingStartTimes {
+		age := now.Sub(start).Seconds()
+		total += age
+		if age > oldest {
+			oldest = age
+		}
+	}
+	m.unfinishedWorkSeconds.Set(total)
+	m.longestRunningProcessor.Set(oldest)
+}
+
+type priorityEntry struct {
+	item     interface{}
+	deadline time.Time
+	seq      uint64
+	index    int
+}
+
+// priorityHeap implements heap.Interface, ordering entries by deadline and
+// then by the order in which they were queued.
+type priorityHeap []*priorityEntry
+
+func (h priorityHeap) Len() int { return len(h) }
+
+func (h priorityHeap) Less(i, j int) bool {
+	if !h[i].deadline.Equal(h[j].deadline) {
+		return h[i].deadline.Before(h[j].deadline)
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h priorityHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *priorityHeap) Push(x interface{}) {
+	entry := x.(*priorityEntry)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *priorityHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return entry
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	fakeclock "k8s.io/utils/clock/testing"
+)
+
+func TestPriorityQueueOrdering(t *testing.T) {
+	now := time.Now()
+	deadlines := map[string]time.Time{
+		"ns/sixty-days": now.Add(60 * 24 * time.Hour),
+		"ns/two-hours":  now.Add(2 * time.Hour),
+		"ns/new":        {},
+		"ns/one-day-a":  now.Add(24 * time.Hour),
+		"ns/one-day-b":  now.Add(24 * time.Hour),
+	}
+	q := NewPriorityRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "", func(item interface{}) time.Time {
+		return deadlines[item.(string)]
+	})
+	defer q.ShutDown()
+
+	for _, key := range []string{"ns/sixty-days", "ns/one-day-a", "ns/two-hours", "ns/one-day-b", "ns/new"} {
+		q.Add(key)
+	}
+	// adding an already queued item doesn't queue it twice
+	q.Add("ns/two-hours")
+	if q.Len() != 5 {
+		t.Fatalf("expected 5 queued items, got %d", q.Len())
+	}
+
+	expected := []string{"ns/new", "ns/two-hours", "ns/one-day-a", "ns/one-day-b", "ns/sixty-days"}
+	for _, want := range expected {
+		item, shutdown := q.Get()
+		if shutdown {
+			t.Fatal("unexpected shutdown")
+		}
+		if item != want {
+			t.Errorf("expected %q, got %q", want, item)
+		}
+		q.Done(item)
+	}
+}
+
+func TestPriorityQueueReevaluatesDeadlineOnAdd(t *testing.T) {
+	now := time.Now()
+	deadlines := map[string]time.Time{
+		"ns/a": now.Add(time.Hour),
+		"ns/b": now.Add(2 * time.Hour),
+	}
+	q := NewPriorityRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "", func(item interface{}) time.Time {
+		return deadlines[item.(string)]
+	})
+	defer q.ShutDown()
+
+	q.Add("ns/a")
+	q.Add("ns/b")
+	deadlines["ns/b"] = now
+	q.Add("ns/b")
+
+	if item, _ := q.Get(); item != "ns/b" {
+		t.Errorf("expected ns/b to be handed out first after becoming more urgent, got %q", item)
+	}
+}
+
+func TestPriorityQueueProcessing(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "", func(interface{}) time.Time {
+		return time.Time{}
+	})
+	defer q.ShutDown()
+
+	q.Add("ns/a")
+	item, _ := q.Get()
+
+	// adding an item whilst it is being processed only queues it once Done
+	// is called
+	q.Add("ns/a")
+	if q.Len() != 0 {
+		t.Fatalf("expected no queued items whilst processing, got %d", q.Len())
+	}
+	q.Done(item)
+	if q.Len() != 1 {
+		t.Fatalf("expected the item to be queued again after Done, got %d", q.Len())
+	}
+
+	item, _ = q.Get()
+	q.AddRateLimited(item)
+	q.Done(item)
+	if got := q.NumRequeues(item); got != 1 {
+		t.Errorf("expected 1 requeue, got %d", got)
+	}
+	q.Forget(item)
+	if got := q.NumRequeues(item); got != 0 {
+		t.Errorf("expected requeues to be reset by Forget, got %d", got)
+	}
+}
+
+func TestPriorityQueueShutDown(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "", func(interface{}) time.Time {
+		return time.Time{}
+	})
+
+	q.Add("ns/a")
+	item, _ := q.Get()
+
+	drained := make(chan struct{})
+	go func() {
+		q.ShutDownWithDrain()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		t.Fatal("expected ShutDownWithDrain to wait for items being processed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	q.Done(item)
+	select {
+	case <-drained:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected ShutDownWithDrain to return once items are done")
+	}
+
+	q.Add("ns/b")
+	if _, shutdown := q.Get(); !shutdown {
+		t.Error("expected Get to report shutdown")
+	}
+}
+
+type fakeMetric struct {
+	value        float64
+	observations []float64
+}
+
+func (m *fakeMetric) Inc()              { m.value++ }
+func (m *fakeMetric) Dec()              { m.value-- }
+func (m *fakeMetric) Set(v float64)     { m.value = v }
+func (m *fakeMetric) Observe(v float64) { m.observations = append(m.observations, v) }
+
+type fakeMetricsProvider struct {
+	depth, adds, latency, workDuration, unfinished, longest, retries fakeMetric
+}
+
+func (p *fakeMetricsProvider) NewDepthMetric(string) workqueue.GaugeMetric       { return &p.depth }
+func (p *fakeMetricsProvider) NewAddsMetric(string) workqueue.CounterMetric      { return &p.adds }
+func (p *fakeMetricsProvider) NewLatencyMetric(string) workqueue.HistogramMetric { return &p.latency }
+func (p *fakeMetricsProvider) NewWorkDurationMetric(string) workqueue.HistogramMetric {
+	return &p.workDuration
+}
+func (p *fakeMetricsProvider) NewUnfinishedWorkSecondsMetric(string) workqueue.SettableGaugeMetric {
+	return &p.unfinished
+}
+func (p *fakeMetricsProvider) NewLongestRunningProcessorSecondsMetric(string) workqueue.SettableGaugeMetric {
+	return &p.longest
+}
+func (p *fakeMetricsProvider) NewRetriesMetric(string) workqueue.CounterMetric { return &p.retries }
+
+func TestPriorityQueueMetrics(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	mp := &fakeMetricsProvider{}
+	q := newPriorityQueue(func(interface{}) time.Time { return time.Time{} })
+	q.metrics = newPriorityQueueMetrics(mp, "test", clock)
+	defer q.ShutDown()
+
+	q.Add("ns/a")
+	q.Add("ns/b")
+	// adding an already queued item isn't counted
+	q.Add("ns/a")
+	if mp.adds.value != 2 || mp.depth.value != 2 {
+		t.Fatalf("expected 2 adds and a depth of 2, got %v adds and a depth of %v", mp.adds.value, mp.depth.value)
+	}
+
+	clock.Step(time.Second)
+	item, _ := q.Get()
+	if mp.depth.value != 1 {
+		t.Errorf("expected a depth of 1, got %v", mp.depth.value)
+	}
+	if len(mp.latency.observations) != 1 || mp.latency.observations[0] != 1 {
+		t.Errorf("expected a latency of 1s to be observed, got %v", mp.latency.observations)
+	}
+
+	clock.Step(2 * time.Second)
+	q.cond.L.Lock()
+	q.metrics.updateUnfinishedWork()
+	q.cond.L.Unlock()
+	if mp.unfinished.value != 2 || mp.longest.value != 2 {
+		t.Errorf("expected 2s of unfinished work, got %v and a longest running processor of %v", mp.unfinished.value, mp.longest.value)
+	}
+
+	q.Done(item)
+	if len(mp.workDuration.observations) != 1 || mp.workDuration.observations[0] != 2 {
+		t.Errorf("expected a work duration of 2s to be observed, got %v", mp.workDuration.observations)
+	}
+}
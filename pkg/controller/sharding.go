@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Sharder decides which replica reconciles each key when controllers are
+// sharded horizontally across several active replicas.
+type Sharder interface {
+	// Owns returns true if the given key should be reconciled by this replica.
+	Owns(key string) bool
+
+	// AddEventHandler registers a function that is called whenever the
+	// assignment of keys to replicas changes.
+	AddEventHandler(func())
+}
+
+// shardFilter wraps the sync function of a controller so that only keys owned
+// by this replica are processed. Keys skipped because they belong to another
+// replica are remembered, and re-queued if they are assigned to this replica
+// after the shards are rebalanced.
+type shardFilter struct {
+	sharder  Sharder
+	queue    workqueue.Interface
+	syncFunc func(ctx context.Context, key string) error
+
+	lock    sync.Mutex
+	skipped sets.String
+}
+
+func newShardFilter(sharder Sharder, queue workqueue.Interface, syncFunc func(ctx context.Context, key string) error) *shardFilter {
+	f := &shardFilter{
+		sharder:  sharder,
+		queue:    queue,
+		syncFunc: syncFunc,
+		skipped:  sets.NewString(),
+	}
+	sharder.AddEventHandler(f.rebalance)
+	return f
+}
+
+func (f *shardFilter) ProcessItem(ctx context.Context, key string) error {
+	owned := f.sharder.Owns(key)
+
+	f.lock.Lock()
+	if owned {
+		f.skipped.Delete(key)
+	} else {
+		f.skipped.Insert(key)
+	}
+	f.lock.Unlock()
+
+	if !owned {
+		return nil
+	}
+	return f.syncFunc(ctx, key)
+}
+
+// rebalance re-queues all previously skipped keys that are now owned by this
+// replica.
+func (f *shardFilter) rebalance() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for key := range f.skipped {
+		if f.sharder.Owns(key) {
+			f.skipped.Delete(key)
+			f.queue.Add(key)
+		}
+	}
+}
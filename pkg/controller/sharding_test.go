@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+type fakeSharder struct {
+	owned   map[string]bool
+	handler func()
+}
+
+func (f *fakeSharder) Owns(key string) bool           { return f.owned[key] }
+func (f *fakeSharder) AddEventHandler(handler func()) { f.handler = handler }
+
+func TestShardFilter(t *testing.T) {
+	sharder := &fakeSharder{owned: map[string]bool{"ns/owned": true}}
+	queue := workqueue.New()
+	defer queue.ShutDown()
+
+	var synced []string
+	filter := newShardFilter(sharder, queue, func(_ context.Context, key string) error {
+		synced = append(synced, key)
+		return nil
+	})
+
+	for _, key := range []string{"ns/owned", "ns/foreign"} {
+		if err := filter.ProcessItem(context.Background(), key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(synced) != 1 || synced[0] != "ns/owned" {
+		t.Errorf("expected only the owned key to be synced, got %v", synced)
+	}
+
+	// rebalancing without changes doesn't re-queue anything
+	sharder.handler()
+	if queue.Len() != 0 {
+		t.Errorf("expected no keys to be queued, got %d", queue.Len())
+	}
+
+	// the foreign key is re-queued once it is assigned to this replica
+	sharder.owned["ns/foreign"] = true
+	sharder.handler()
+	if queue.Len() != 1 {
+		t.Fatalf("expected 1 key to be queued, got %d", queue.Len())
+	}
+	if key, _ := queue.Get(); key != "ns/foreign" {
+		t.Errorf("expected foreign key to be queued, got %v", key)
+	}
+
+	// it is only re-queued once
+	sharder.handler()
+	if queue.Len() != 0 {
+		t.Errorf("expected no keys to be queued, got %d", queue.Len())
+	}
+}
@@ -40,6 +40,7 @@ import (
 	gwfake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
 	gwinformers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
 
+	"github.com/cert-manager/cert-manager/internal/controller/issuerbudget"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
@@ -152,6 +153,7 @@ func (b *Builder) Init() {
 	b.SharedInformerFactory = informers.NewSharedInformerFactory(b.CMClient, informerResyncPeriod)
 	b.GWShared = gwinformers.NewSharedInformerFactory(b.GWClient, informerResyncPeriod)
 	b.HTTP01ResourceMetadataInformersFactory = metadatainformer.NewFilteredSharedInformerFactory(b.MetadataClient, informerResyncPeriod, "", func(listOptions *metav1.ListOptions) {})
+	b.UnmanagedCertificateInformerFactory = internalinformers.NewUnmanagedCertificateInformerFactory(b.MetadataClient, informerResyncPeriod, "")
 	b.stopCh = make(chan struct{})
 	b.Metrics = metrics.New(logs.Log, clock.RealClock{})
 
@@ -164,6 +166,7 @@ func (b *Builder) Init() {
 	// Fix the clock used in apiutil so that calls to set status conditions
 	// can be predictably tested
 	apiutil.Clock = b.Context.Clock
+	b.IssuerBudgets = issuerbudget.New(b.Context.Clock, b.Metrics)
 }
 
 // InitWithRESTConfig() will call builder.Init(), then assign an initialised
@@ -323,6 +326,7 @@ func (b *Builder) Start() {
 	b.SharedInformerFactory.Start(b.stopCh)
 	b.GWShared.Start(b.stopCh)
 	b.HTTP01ResourceMetadataInformersFactory.Start(b.stopCh)
+	b.UnmanagedCertificateInformerFactory.Start(b.stopCh)
 
 	// wait for caches to sync
 	b.Sync()
@@ -341,6 +345,9 @@ func (b *Builder) Sync() {
 	if err := mustAllSyncGVR(b.HTTP01ResourceMetadataInformersFactory.WaitForCacheSync(b.stopCh)); err != nil {
 		panic("Error waiting for MetadataInformerFactory to sync:" + err.Error())
 	}
+	if err := mustAllSyncString(b.UnmanagedCertificateInformerFactory.WaitForCacheSync(b.stopCh)); err != nil {
+		panic("Error waiting for UnmanagedCertificateInformerFactory to sync:" + err.Error())
+	}
 	if b.additionalSyncFuncs != nil {
 		cache.WaitForCacheSync(b.stopCh, b.additionalSyncFuncs...)
 	}
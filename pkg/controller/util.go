@@ -30,6 +30,8 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -40,6 +42,63 @@ var (
 	KeyFunc = cache.DeletionHandlingMetaNamespaceKeyFunc
 )
 
+// CrossNamespaceIssuerIndex is the name of the index of resources by the
+// namespace of the Issuer referenced by their issuerRef, for references to
+// Issuers in other namespaces than the resource.
+const CrossNamespaceIssuerIndex = "issuerRef.crossNamespace"
+
+// AddCrossNamespaceIssuerIndex adds the CrossNamespaceIssuerIndex to the
+// informer of a resource whose issuerRef is returned by issuerRef. The
+// informer may be shared by several controllers, so the index is only added
+// if it is not present yet.
+func AddCrossNamespaceIssuerIndex(informer cache.SharedIndexInformer, issuerRef func(obj interface{}) (cmmeta.ObjectReference, bool)) error {
+	if _, ok := informer.GetIndexer().GetIndexers()[CrossNamespaceIssuerIndex]; ok {
+		return nil
+	}
+	return informer.AddIndexers(cache.Indexers{CrossNamespaceIssuerIndex: func(obj interface{}) ([]string, error) {
+		meta, ok := obj.(metav1.Object)
+		if !ok {
+			return nil, nil
+		}
+		ref, ok := issuerRef(obj)
+		if !ok || ref.Namespace == "" || ref.Namespace == meta.GetNamespace() {
+			return nil, nil
+		}
+		if (ref.Group != "" && ref.Group != cmapi.SchemeGroupVersion.Group) || (ref.Kind != "" && ref.Kind != cmapi.IssuerKind) {
+			return nil, nil
+		}
+		return []string{ref.Namespace}, nil
+	}})
+}
+
+// HandleReferenceGrantFunc returns a function that accepts a ReferenceGrant
+// and adds the resources referencing an Issuer in its namespace from another
+// namespace to the workqueue, as the grant may permit or stop permitting the
+// reference. The resources are found using the CrossNamespaceIssuerIndex of
+// the given indexer.
+func HandleReferenceGrantFunc(log logr.Logger, queue workqueue.Interface, indexer cache.Indexer) func(obj interface{}) {
+	return func(obj interface{}) {
+		grant, ok := obj.(metav1.Object)
+		if !ok {
+			log.Error(nil, "object is not a ReferenceGrant")
+			return
+		}
+		objs, err := indexer.ByIndex(CrossNamespaceIssuerIndex, grant.GetNamespace())
+		if err != nil {
+			log.Error(err, "error listing resources referencing an Issuer in the namespace of the ReferenceGrant")
+			return
+		}
+		for _, obj := range objs {
+			key, err := KeyFunc(obj)
+			if err != nil {
+				runtime.HandleError(err)
+				continue
+			}
+			queue.Add(key)
+		}
+	}
+}
+
 // DefaultItemBasedRateLimiter returns a new rate limiter with base delay of 5
 // seconds, max delay of 5 minutes.
 func DefaultItemBasedRateLimiter() workqueue.RateLimiter {
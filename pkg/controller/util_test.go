@@ -18,7 +18,16 @@ package controller
 
 import (
 	"reflect"
+	"sort"
 	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
 func TestBuildAnnotationsToCopy(t *testing.T) {
@@ -63,3 +72,58 @@ func TestBuildAnnotationsToCopy(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleReferenceGrantFunc(t *testing.T) {
+	cr := func(namespace, name string, ref cmmeta.ObjectReference) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       cmapi.CertificateRequestSpec{IssuerRef: ref},
+		}
+	}
+
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &cmapi.CertificateRequest{}, 0, cache.Indexers{})
+	issuerRef := func(obj interface{}) (cmmeta.ObjectReference, bool) {
+		cr, ok := obj.(*cmapi.CertificateRequest)
+		if !ok {
+			return cmmeta.ObjectReference{}, false
+		}
+		return cr.Spec.IssuerRef, true
+	}
+	if err := AddCrossNamespaceIssuerIndex(informer, issuerRef); err != nil {
+		t.Fatal(err)
+	}
+	// adding the index again is a no-op, as the informer may be shared
+	if err := AddCrossNamespaceIssuerIndex(informer, issuerRef); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, obj := range []*cmapi.CertificateRequest{
+		cr("app", "cross-namespace", cmmeta.ObjectReference{Name: "ca", Namespace: "issuers"}),
+		cr("app", "cross-namespace-kind", cmmeta.ObjectReference{Name: "ca", Namespace: "issuers", Kind: cmapi.IssuerKind, Group: "cert-manager.io"}),
+		cr("app", "same-namespace", cmmeta.ObjectReference{Name: "ca"}),
+		cr("issuers", "explicit-same-namespace", cmmeta.ObjectReference{Name: "ca", Namespace: "issuers"}),
+		cr("app", "cluster-issuer", cmmeta.ObjectReference{Name: "ca", Namespace: "issuers", Kind: cmapi.ClusterIssuerKind}),
+		cr("app", "external-issuer", cmmeta.ObjectReference{Name: "ca", Namespace: "issuers", Group: "example.com"}),
+		cr("app", "other-namespace", cmmeta.ObjectReference{Name: "ca", Namespace: "other"}),
+	} {
+		if err := informer.GetIndexer().Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	queue := workqueue.New()
+	defer queue.ShutDown()
+	grant := &metav1.ObjectMeta{Namespace: "issuers", Name: "grant"}
+	HandleReferenceGrantFunc(logf.Log, queue, informer.GetIndexer())(grant)
+
+	var keys []string
+	for queue.Len() > 0 {
+		key, _ := queue.Get()
+		keys = append(keys, key.(string))
+		queue.Done(key)
+	}
+	sort.Strings(keys)
+	if exp := []string{"app/cross-namespace", "app/cross-namespace-kind"}; !reflect.DeepEqual(keys, exp) {
+		t.Errorf("expected keys %v, got %v", exp, keys)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// defaultTXTBatchWindow is how long changes to TXT records within a zone are
+// collected before being applied in a single provider call.
+const defaultTXTBatchWindow = 2 * time.Second
+
+// batchSolver is implemented by solvers that can apply several TXT record
+// changes within a single zone using one call to the provider's API.
+// Solvers implementing it have concurrent Present and CleanUp calls for the
+// same zone coalesced into batches, avoiding racing read/modify/write cycles
+// on shared record sets and reducing the number of API calls made.
+type batchSolver interface {
+	solver
+	ApplyTXTChanges(zone string, changes []util.TXTRecordChange) error
+}
+
+// txtBatcher collects TXT record changes submitted within a short window and
+// applies them per zone using a batchSolver. Batches for the same zone are
+// applied one after the other, even if they use different provider
+// configurations, so that a solver which reads and rewrites a record set
+// never races with itself.
+type txtBatcher struct {
+	clock  clock.WithDelayedExecution
+	window time.Duration
+
+	lock    sync.Mutex
+	pending map[string]*txtBatch
+	// applying is the last batch for each zone which has stopped accepting
+	// changes and has not been applied yet.
+	applying map[string]*txtBatch
+}
+
+// txtBatch is a set of changes to be applied to a zone together.
+type txtBatch struct {
+	zone    string
+	solver  batchSolver
+	changes []util.TXTRecordChange
+
+	// done is closed once the batch has been applied, after which err holds
+	// the result of applying it.
+	done chan struct{}
+	err  error
+}
+
+func newTXTBatcher(clock clock.WithDelayedExecution, window time.Duration) *txtBatcher {
+	return &txtBatcher{
+		clock:    clock,
+		window:   window,
+		pending:  make(map[string]*txtBatch),
+		applying: make(map[string]*txtBatch),
+	}
+}
+
+// submit adds change to the pending batch identified by key, starting a new
+// batch if there is none, and blocks until the batch has been applied.
+// key must identify both the provider configuration and the zone, as changes
+// in a batch are applied using the solver of the first change submitted.
+// If applying the batch fails, every change in it receives the error.
+func (b *txtBatcher) submit(key, zone string, slv batchSolver, change util.TXTRecordChange) error {
+	b.lock.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &txtBatch{
+			zone:   zone,
+			solver: slv,
+			done:   make(chan struct{}),
+		}
+		b.pending[key] = batch
+		b.clock.AfterFunc(b.window, func() { b.flush(key, batch) })
+	}
+	batch.changes = append(batch.changes, change)
+	b.lock.Unlock()
+
+	<-batch.done
+	return batch.err
+}
+
+// flush stops the batch from accepting new changes and applies it once the
+// previous batch for the same zone has been applied.
+func (b *txtBatcher) flush(key string, batch *txtBatch) {
+	b.lock.Lock()
+	if b.pending[key] == batch {
+		delete(b.pending, key)
+	}
+	previous := b.applying[batch.zone]
+	b.applying[batch.zone] = batch
+	b.lock.Unlock()
+
+	if previous != nil {
+		<-previous.done
+	}
+	batch.err = batch.solver.ApplyTXTChanges(batch.zone, batch.changes)
+
+	b.lock.Lock()
+	if b.applying[batch.zone] == batch {
+		delete(b.applying, batch.zone)
+	}
+	b.lock.Unlock()
+	close(batch.done)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/utils/clock"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+type fakeBatchSolver struct {
+	lock    sync.Mutex
+	batches [][]util.TXTRecordChange
+	err     error
+}
+
+func (f *fakeBatchSolver) Present(domain, fqdn, value string) error {
+	return errors.New("Present should not be called")
+}
+
+func (f *fakeBatchSolver) CleanUp(domain, fqdn, value string) error {
+	return errors.New("CleanUp should not be called")
+}
+
+func (f *fakeBatchSolver) ApplyTXTChanges(zone string, changes []util.TXTRecordChange) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.batches = append(f.batches, changes)
+	return f.err
+}
+
+func TestTXTBatcher(t *testing.T) {
+	tests := map[string]struct {
+		keys        []string
+		err         error
+		expBatches  int
+		expChanges  int
+		expErrorSet bool
+	}{
+		"changes with the same key are applied in one batch": {
+			keys:       []string{"a", "a", "a"},
+			expBatches: 1,
+			expChanges: 3,
+		},
+		"changes with different keys are applied in separate batches": {
+			keys:       []string{"a", "b", "a"},
+			expBatches: 2,
+			expChanges: 3,
+		},
+		"errors applying a batch are returned for every change": {
+			keys:        []string{"a", "a"},
+			err:         errors.New("failed"),
+			expBatches:  1,
+			expChanges:  2,
+			expErrorSet: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			clock := fakeclock.NewFakeClock(time.Now())
+			batcher := newTXTBatcher(clock, time.Second)
+			slv := &fakeBatchSolver{err: test.err}
+
+			errs := make(chan error, len(test.keys))
+			for _, key := range test.keys {
+				key := key
+				go func() {
+					errs <- batcher.submit(key, "example.com.", slv, util.TXTRecordChange{
+						Action: util.TXTRecordPresent,
+						FQDN:   "_acme-challenge." + key + ".example.com.",
+						Value:  "value",
+					})
+				}()
+			}
+
+			// wait for all changes to be submitted before the window ends
+			for {
+				batcher.lock.Lock()
+				submitted := 0
+				for _, b := range batcher.pending {
+					submitted += len(b.changes)
+				}
+				batcher.lock.Unlock()
+				if submitted == len(test.keys) {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			clock.Step(time.Second)
+
+			for range test.keys {
+				err := <-errs
+				if test.expErrorSet != (err != nil) {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+
+			if len(slv.batches) != test.expBatches {
+				t.Errorf("expected %d batches, got %d", test.expBatches, len(slv.batches))
+			}
+			changes := 0
+			for _, b := range slv.batches {
+				changes += len(b)
+			}
+			if changes != test.expChanges {
+				t.Errorf("expected %d changes, got %d", test.expChanges, changes)
+			}
+			if len(batcher.pending) != 0 {
+				t.Errorf("expected no pending batches, got %d", len(batcher.pending))
+			}
+		})
+	}
+}
+
+// blockingBatchSolver blocks every ApplyTXTChanges call until it is released,
+// and records the number of calls running at once.
+type blockingBatchSolver struct {
+	fakeBatchSolver
+	started chan struct{}
+	release chan struct{}
+
+	running    int32
+	maxRunning int32
+}
+
+func (f *blockingBatchSolver) ApplyTXTChanges(zone string, changes []util.TXTRecordChange) error {
+	running := atomic.AddInt32(&f.running, 1)
+	defer atomic.AddInt32(&f.running, -1)
+	for {
+		max := atomic.LoadInt32(&f.maxRunning)
+		if running <= max || atomic.CompareAndSwapInt32(&f.maxRunning, max, running) {
+			break
+		}
+	}
+	f.started <- struct{}{}
+	<-f.release
+	return f.fakeBatchSolver.ApplyTXTChanges(zone, changes)
+}
+
+func TestTXTBatcherSerializesBatches(t *testing.T) {
+	// the fake clock runs timers while holding its lock, which would
+	// deadlock with a batch blocked in ApplyTXTChanges
+	batcher := newTXTBatcher(clock.RealClock{}, 10*time.Millisecond)
+	slv := &blockingBatchSolver{started: make(chan struct{}, 2), release: make(chan struct{})}
+
+	errs := make(chan error, 2)
+	submit := func(key, value string) {
+		go func() {
+			errs <- batcher.submit(key, "example.com.", slv, util.TXTRecordChange{
+				Action: util.TXTRecordPresent,
+				FQDN:   "_acme-challenge.example.com.",
+				Value:  value,
+			})
+		}()
+	}
+
+	// the first batch is being applied when the second one is flushed.
+	// They use different provider configurations, but change the same zone.
+	submit("a", "one")
+	<-slv.started
+	submit("b", "two")
+
+	select {
+	case <-slv.started:
+		t.Fatal("expected the second batch to wait for the first one to be applied")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(slv.release)
+	<-slv.started
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	if max := atomic.LoadInt32(&slv.maxRunning); max != 1 {
+		t.Errorf("expected batches to be applied one at a time, got %d at once", max)
+	}
+	if len(slv.batches) != 2 {
+		t.Errorf("expected 2 batches, got %d", len(slv.batches))
+	}
+	if len(batcher.applying) != 0 {
+		t.Errorf("expected no batches being applied, got %d", len(batcher.applying))
+	}
+}
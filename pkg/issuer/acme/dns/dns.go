@@ -24,9 +24,13 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/clock"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
 	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -39,6 +43,8 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/externaldns"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/rfc2136"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
@@ -63,6 +69,7 @@ type dnsProviderConstructors struct {
 	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error)
 	acmeDNS      func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error)
 	digitalOcean func(token string, dns01Nameservers []string, userAgent string) (*digitalocean.DNSProvider, error)
+	powerDNS     func(host, apiKey, serverID, userAgent string) (*powerdns.DNSProvider, error)
 }
 
 // Solver is a solver for the acme dns01 challenge.
@@ -73,10 +80,14 @@ type Solver struct {
 	secretLister            internalinformers.SecretLister
 	dnsProviderConstructors dnsProviderConstructors
 	webhookSolvers          map[string]webhook.Solver
+	txtBatcher              *txtBatcher
 }
 
 // Present performs the work to configure DNS to resolve a DNS01 challenge.
-func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) (err error) {
+	ctx, span := tracing.Start(ctx, "dns01 present", tracing.WithResource(ch))
+	defer func() { tracing.End(span, err) }()
+
 	log := logf.WithResource(logf.FromContext(ctx, "Present"), ch).WithValues("domain", ch.Spec.DNSName)
 	ctx = logf.NewContext(ctx, log)
 
@@ -86,7 +97,10 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	}
 	if err == nil {
 		log.V(logf.InfoLevel).Info("presenting DNS01 challenge for domain")
-		return webhookSolver.Present(req)
+		span := startProviderSpan(ctx, util.TXTRecordPresent, ch.Spec.Solver.DNS01, req.ResolvedFQDN)
+		err = webhookSolver.Present(req)
+		tracing.End(span, err)
+		return err
 	}
 
 	slv, providerConfig, err := s.solverForChallenge(ctx, issuer, ch)
@@ -101,11 +115,19 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 
 	log.V(logf.DebugLevel).Info("presenting DNS01 challenge for domain")
 
-	return slv.Present(ch.Spec.DNSName, fqdn, ch.Spec.Key)
+	return s.changeTXTRecord(ctx, issuer, slv, providerConfig, util.TXTRecordChange{
+		Action: util.TXTRecordPresent,
+		Domain: ch.Spec.DNSName,
+		FQDN:   fqdn,
+		Value:  ch.Spec.Key,
+	})
 }
 
 // Check verifies that the DNS records for the ACME challenge have propagated.
-func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) (err error) {
+	ctx, span := tracing.Start(ctx, "dns01 check", tracing.WithResource(ch))
+	defer func() { tracing.End(span, err) }()
+
 	log := logf.WithResource(logf.FromContext(ctx, "Check"), ch).WithValues("domain", ch.Spec.DNSName)
 
 	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, false, s.DNS01Nameservers...)
@@ -134,7 +156,10 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 
 // CleanUp removes DNS records which are no longer needed after
 // certificate issuance.
-func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) (err error) {
+	ctx, span := tracing.Start(ctx, "dns01 cleanup", tracing.WithResource(ch))
+	defer func() { tracing.End(span, err) }()
+
 	log := logf.WithResource(logf.FromContext(ctx, "CleanUp"), ch).WithValues("domain", ch.Spec.DNSName)
 	ctx = logf.NewContext(ctx, log)
 
@@ -144,7 +169,10 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	}
 	if err == nil {
 		log.V(logf.DebugLevel).Info("cleaning up DNS01 challenge")
-		return webhookSolver.CleanUp(req)
+		span := startProviderSpan(ctx, util.TXTRecordCleanUp, ch.Spec.Solver.DNS01, req.ResolvedFQDN)
+		err = webhookSolver.CleanUp(req)
+		tracing.End(span, err)
+		return err
 	}
 
 	slv, providerConfig, err := s.solverForChallenge(ctx, issuer, ch)
@@ -157,7 +185,85 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return err
 	}
 
-	return slv.CleanUp(ch.Spec.DNSName, fqdn, ch.Spec.Key)
+	return s.changeTXTRecord(ctx, issuer, slv, providerConfig, util.TXTRecordChange{
+		Action: util.TXTRecordCleanUp,
+		Domain: ch.Spec.DNSName,
+		FQDN:   fqdn,
+		Value:  ch.Spec.Key,
+	})
+}
+
+// changeTXTRecord applies the change using the given solver. If the solver
+// supports batching, the change is applied together with other changes made
+// to the same zone using the same provider configuration.
+func (s *Solver) changeTXTRecord(ctx context.Context, issuer v1.GenericIssuer, slv solver, providerConfig *cmacme.ACMEChallengeSolverDNS01, change util.TXTRecordChange) (err error) {
+	span := startProviderSpan(ctx, change.Action, providerConfig, change.FQDN)
+	defer func() { tracing.End(span, err) }()
+
+	bslv, ok := slv.(batchSolver)
+	if !ok || s.txtBatcher == nil {
+		if change.Action == util.TXTRecordCleanUp {
+			return slv.CleanUp(change.Domain, change.FQDN, change.Value)
+		}
+		return slv.Present(change.Domain, change.FQDN, change.Value)
+	}
+
+	zone, err := util.FindZoneByFqdn(change.FQDN, s.DNS01Nameservers)
+	if err != nil {
+		return err
+	}
+
+	// Changes may only be batched if they are applied using the same
+	// credentials, so key batches on the provider configuration as well as
+	// the namespace any referenced secrets are loaded from.
+	cfg, err := json.Marshal(providerConfig)
+	if err != nil {
+		return err
+	}
+	key := strings.Join([]string{s.ResourceNamespace(issuer), string(cfg), zone}, "/")
+
+	return s.txtBatcher.submit(key, zone, bslv, change)
+}
+
+// startProviderSpan starts a span covering a call to the DNS provider
+// configured in providerConfig. The provider clients are not passed a
+// context, so this is where the time spent in each provider is recorded.
+func startProviderSpan(ctx context.Context, action util.TXTRecordAction, providerConfig *cmacme.ACMEChallengeSolverDNS01, fqdn string) trace.Span {
+	_, span := tracing.Start(ctx, "dns01 provider "+strings.ToLower(string(action)), trace.WithAttributes(
+		attribute.String("dns01.provider", providerName(providerConfig)),
+		attribute.String("dns01.fqdn", fqdn),
+	))
+	return span
+}
+
+// providerName returns the name of the DNS provider configured in
+// providerConfig, as used in the Issuer resource.
+func providerName(providerConfig *cmacme.ACMEChallengeSolverDNS01) string {
+	switch {
+	case providerConfig.Akamai != nil:
+		return "akamai"
+	case providerConfig.CloudDNS != nil:
+		return "cloudDNS"
+	case providerConfig.Cloudflare != nil:
+		return "cloudflare"
+	case providerConfig.Route53 != nil:
+		return "route53"
+	case providerConfig.AzureDNS != nil:
+		return "azureDNS"
+	case providerConfig.DigitalOcean != nil:
+		return "digitalocean"
+	case providerConfig.AcmeDNS != nil:
+		return "acmeDNS"
+	case providerConfig.PowerDNS != nil:
+		return "powerDNS"
+	case providerConfig.RFC2136 != nil:
+		return "rfc2136"
+	case providerConfig.ExternalDNS != nil:
+		return "externalDNS"
+	case providerConfig.Webhook != nil:
+		return "webhook"
+	}
+	return ""
 }
 
 func followCNAME(strategy cmacme.CNAMEStrategy) bool {
@@ -409,6 +515,22 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		if err != nil {
 			return nil, providerConfig, fmt.Errorf("error instantiating acmedns challenge solver: %s", err)
 		}
+	case providerConfig.PowerDNS != nil:
+		dbg.Info("preparing to create PowerDNS provider")
+		apiKey, err := s.loadSecretData(&providerConfig.PowerDNS.APIKey, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting powerdns api key")
+		}
+
+		impl, err = s.dnsProviderConstructors.powerDNS(
+			providerConfig.PowerDNS.Host,
+			strings.TrimSpace(string(apiKey)),
+			providerConfig.PowerDNS.ServerID,
+			s.RESTConfig.UserAgent,
+		)
+		if err != nil {
+			return nil, providerConfig, fmt.Errorf("error instantiating powerdns challenge solver: %s", err)
+		}
 	default:
 		return nil, providerConfig, fmt.Errorf("no dns provider config specified for challenge")
 	}
@@ -474,6 +596,9 @@ func (s *Solver) dns01SolverForConfig(config *cmacme.ACMEChallengeSolverDNS01) (
 	case config.RFC2136 != nil:
 		solverName = "rfc2136"
 		c = config.RFC2136
+	case config.ExternalDNS != nil:
+		solverName = "externaldns"
+		c = config.ExternalDNS
 	}
 	if solverName == "" {
 		return nil, nil, errNotFound
@@ -490,8 +615,9 @@ func (s *Solver) dns01SolverForConfig(config *cmacme.ACMEChallengeSolverDNS01) (
 func NewSolver(ctx *controller.Context) (*Solver, error) {
 	secretsLister := ctx.KubeSharedInformerFactory.Secrets().Lister()
 	webhookSolvers := []webhook.Solver{
-		&webhookslv.Webhook{},
+		webhookslv.New(webhookslv.WithSecretsLister(secretsLister)),
 		rfc2136.New(rfc2136.WithNamespace(ctx.Namespace), rfc2136.WithSecretsLister(secretsLister)),
+		externaldns.New(externaldns.WithNameservers(ctx.DNS01Nameservers, ctx.DNS01CheckAuthoritative)),
 	}
 
 	initialized := make(map[string]webhook.Solver)
@@ -519,8 +645,10 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 			azuredns.NewDNSProviderCredentials,
 			acmedns.NewDNSProviderHostBytes,
 			digitalocean.NewDNSProviderCredentials,
+			powerdns.NewDNSProviderCredentials,
 		},
 		webhookSolvers: initialized,
+		txtBatcher:     newTXTBatcher(clock.RealClock{}, defaultTXTBatchWindow),
 	}, nil
 }
 
@@ -18,9 +18,15 @@ package dns
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -33,6 +39,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/acmedns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 )
 
@@ -278,6 +285,37 @@ func TestSolverFor(t *testing.T) {
 			domain:             "example.com",
 			expectedSolverType: reflect.TypeOf(&acmedns.DNSProvider{}),
 		},
+		"loads api key for powerdns provider": {
+			solverFixture: &solverFixture{
+				Builder: &test.Builder{
+					KubeObjects: []runtime.Object{
+						newSecret("powerdns-key", "default", map[string][]byte{
+							"api-key": []byte("secret"),
+						}),
+					},
+				},
+				Issuer: newIssuer("test", "default"),
+				Challenge: &cmacme.Challenge{
+					Spec: cmacme.ChallengeSpec{
+						Solver: cmacme.ACMEChallengeSolver{
+							DNS01: &cmacme.ACMEChallengeSolverDNS01{
+								PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+									Host: "http://127.0.0.1:8081",
+									APIKey: cmmeta.SecretKeySelector{
+										LocalObjectReference: cmmeta.LocalObjectReference{
+											Name: "powerdns-key",
+										},
+										Key: "api-key",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			domain:             "example.com",
+			expectedSolverType: reflect.TypeOf(&powerdns.DNSProvider{}),
+		},
 	}
 	testFn := func(test testT) func(*testing.T) {
 		return func(t *testing.T) {
@@ -352,6 +390,58 @@ func TestSolveForDigitalOcean(t *testing.T) {
 
 }
 
+type fakeSolver struct {
+	err error
+}
+
+func (f *fakeSolver) Present(domain, fqdn, value string) error {
+	return f.err
+}
+
+func (f *fakeSolver) CleanUp(domain, fqdn, value string) error {
+	return f.err
+}
+
+func TestChangeTXTRecordSpan(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(prev)
+
+	s := &Solver{}
+	providerConfig := &cmacme.ACMEChallengeSolverDNS01{AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{}}
+	change := util.TXTRecordChange{Domain: "example.com", FQDN: "_acme-challenge.example.com.", Value: "key"}
+
+	change.Action = util.TXTRecordPresent
+	if err := s.changeTXTRecord(context.Background(), newIssuer("test", "default"), &fakeSolver{}, providerConfig, change); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	change.Action = util.TXTRecordCleanUp
+	if err := s.changeTXTRecord(context.Background(), newIssuer("test", "default"), &fakeSolver{err: errors.New("failed")}, providerConfig, change); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	for i, exp := range []struct {
+		name string
+		code codes.Code
+	}{{"dns01 provider present", codes.Unset}, {"dns01 provider cleanup", codes.Error}} {
+		if spans[i].Name != exp.name || spans[i].Status.Code != exp.code {
+			t.Errorf("expected span %q with status %v, got %q with status %v", exp.name, exp.code, spans[i].Name, spans[i].Status.Code)
+		}
+		attrs := attribute.NewSet(spans[i].Attributes...)
+		if v, _ := attrs.Value("dns01.provider"); v.AsString() != "azureDNS" {
+			t.Errorf("expected dns01.provider attribute azureDNS, got %q", v.AsString())
+		}
+		if v, _ := attrs.Value("dns01.fqdn"); v.AsString() != change.FQDN {
+			t.Errorf("expected dns01.fqdn attribute %q, got %q", change.FQDN, v.AsString())
+		}
+	}
+}
+
 func TestRoute53TrimCreds(t *testing.T) {
 	f := &solverFixture{
 		Builder: &test.Builder{
@@ -0,0 +1,362 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externaldns implements a DNS provider for solving the DNS-01
+// challenge by publishing TXT records through external-dns DNSEndpoint
+// resources.
+// external-dns must be running with the 'crd' source enabled, and is
+// responsible for holding the credentials for the DNS provider.
+package externaldns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/pointer"
+
+	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const SolverName = "externaldns"
+
+const (
+	// defaultRecordTTL is the TTL used for TXT records if one is not
+	// specified in the solver config.
+	defaultRecordTTL int64 = 60
+
+	// defaultPropagationTimeout is how long Present will wait for
+	// external-dns to publish the TXT record before returning an error.
+	defaultPropagationTimeout = 2 * time.Minute
+	// defaultPollInterval is how often the DNS record is checked whilst
+	// waiting for it to propagate.
+	defaultPollInterval = 5 * time.Second
+)
+
+// DNSEndpointGVR is the resource that is managed by this solver.
+var DNSEndpointGVR = schema.GroupVersionResource{
+	Group:    "externaldns.k8s.io",
+	Version:  "v1alpha1",
+	Resource: "dnsendpoints",
+}
+
+var dnsEndpointGVK = DNSEndpointGVR.GroupVersion().WithKind("DNSEndpoint")
+
+// Solver creates DNSEndpoint resources containing the TXT records for
+// DNS01 challenges, which are then published by external-dns.
+// Challenges for the same FQDN in the same namespace share a single
+// DNSEndpoint, with one target per challenge key.
+type Solver struct {
+	client dynamic.Interface
+
+	nameservers      []string
+	useAuthoritative bool
+
+	propagationTimeout time.Duration
+	pollInterval       time.Duration
+}
+
+type Option func(*Solver)
+
+// WithNameservers configures the nameservers that are queried when waiting
+// for external-dns to publish a record.
+func WithNameservers(nameservers []string, useAuthoritative bool) Option {
+	return func(s *Solver) {
+		s.nameservers = nameservers
+		s.useAuthoritative = useAuthoritative
+	}
+}
+
+// WithDynamicClient configures the client used to manage DNSEndpoint
+// resources. If not set, a client is built when Initialize is called.
+func WithDynamicClient(client dynamic.Interface) Option {
+	return func(s *Solver) {
+		s.client = client
+	}
+}
+
+// WithPropagationTimeout configures how long Present waits for the record
+// to be published, and how often it checks.
+func WithPropagationTimeout(timeout, interval time.Duration) Option {
+	return func(s *Solver) {
+		s.propagationTimeout = timeout
+		s.pollInterval = interval
+	}
+}
+
+func New(opts ...Option) *Solver {
+	s := &Solver{
+		nameservers:        util.RecursiveNameservers,
+		useAuthoritative:   true,
+		propagationTimeout: defaultPropagationTimeout,
+		pollInterval:       defaultPollInterval,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+func (s *Solver) Name() string {
+	return SolverName
+}
+
+// Present adds the challenge key to the DNSEndpoint for the challenge's
+// FQDN and waits for external-dns to publish it.
+func (s *Solver) Present(ch *whapi.ChallengeRequest) error {
+	cfg, err := loadConfig(ch)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	if err := s.updateTargets(ctx, ch, cfg, func(targets []string) []string {
+		for _, t := range targets {
+			if t == ch.Key {
+				return targets
+			}
+		}
+		return append(targets, ch.Key)
+	}); err != nil {
+		return fmt.Errorf("error presenting DNSEndpoint for %q: %w", ch.ResolvedFQDN, err)
+	}
+
+	log := logf.Log.WithName(SolverName).WithValues("fqdn", ch.ResolvedFQDN, "namespace", ch.ResourceNamespace)
+	log.V(logf.DebugLevel).Info("waiting for external-dns to publish DNS01 record")
+
+	return util.WaitFor(s.propagationTimeout, s.pollInterval, func() (bool, error) {
+		return util.PreCheckDNS(ch.ResolvedFQDN, ch.Key, s.nameservers, s.useAuthoritative)
+	})
+}
+
+// CleanUp removes the challenge key from the DNSEndpoint for the
+// challenge's FQDN, deleting the DNSEndpoint once it has no targets left.
+func (s *Solver) CleanUp(ch *whapi.ChallengeRequest) error {
+	cfg, err := loadConfig(ch)
+	if err != nil {
+		return err
+	}
+
+	err = s.updateTargets(context.TODO(), ch, cfg, func(targets []string) []string {
+		var remaining []string
+		for _, t := range targets {
+			if t != ch.Key {
+				remaining = append(remaining, t)
+			}
+		}
+		return remaining
+	})
+	if err != nil {
+		return fmt.Errorf("error cleaning up DNSEndpoint for %q: %w", ch.ResolvedFQDN, err)
+	}
+
+	return nil
+}
+
+func (s *Solver) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	if s.client != nil {
+		return nil
+	}
+
+	cl, err := dynamic.NewForConfig(kubeClientConfig)
+	if err != nil {
+		return err
+	}
+	s.client = cl
+
+	return nil
+}
+
+// updateTargets applies mutate to the TXT targets of the DNSEndpoint for
+// the given challenge, creating the DNSEndpoint if it does not exist and
+// deleting it if no targets remain.
+func (s *Solver) updateTargets(ctx context.Context, ch *whapi.ChallengeRequest, cfg *cmacme.ACMEIssuerDNS01ProviderExternalDNS, mutate func([]string) []string) error {
+	client := s.client.Resource(DNSEndpointGVR).Namespace(ch.ResourceNamespace)
+	name := endpointName(ch.ResolvedFQDN)
+
+	isRetriable := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+
+	return retry.OnError(retry.DefaultBackoff, isRetriable, func() error {
+		existing, err := client.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			targets := mutate(nil)
+			if len(targets) == 0 {
+				return nil
+			}
+			obj, err := buildDNSEndpoint(name, ch, cfg, targets).toUnstructured()
+			if err != nil {
+				return err
+			}
+			_, err = client.Create(ctx, obj, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		ep, err := fromUnstructured(existing)
+		if err != nil {
+			return err
+		}
+		targets := mutate(ep.targets())
+		if len(targets) == 0 {
+			err := client.Delete(ctx, name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{ResourceVersion: pointer.String(existing.GetResourceVersion())},
+			})
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		desired, err := buildDNSEndpoint(name, ch, cfg, targets).toUnstructured()
+		if err != nil {
+			return err
+		}
+		updated := existing.DeepCopy()
+		updated.Object["spec"] = desired.Object["spec"]
+		updated.SetLabels(mergeMaps(existing.GetLabels(), cfg.Labels))
+		updated.SetAnnotations(mergeMaps(existing.GetAnnotations(), cfg.Annotations))
+		_, err = client.Update(ctx, updated, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func loadConfig(ch *whapi.ChallengeRequest) (*cmacme.ACMEIssuerDNS01ProviderExternalDNS, error) {
+	if ch.Config == nil {
+		return nil, fmt.Errorf("no challenge solver config provided")
+	}
+
+	cfg := cmacme.ACMEIssuerDNS01ProviderExternalDNS{}
+	if err := json.Unmarshal(ch.Config.Raw, &cfg); err != nil {
+		return nil, fmt.Errorf("error decoding solver config: %v", err)
+	}
+
+	return &cfg, nil
+}
+
+// endpointName returns a deterministic name for the DNSEndpoint used to
+// publish records for the given FQDN.
+func endpointName(fqdn string) string {
+	return fmt.Sprintf("cm-acme-dns01-%x", sha256.Sum256([]byte(util.UnFqdn(fqdn))))[:30]
+}
+
+// dnsEndpoint is a minimal representation of the external-dns DNSEndpoint
+// resource, containing only the fields used by this solver.
+type dnsEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec dnsEndpointSpec `json:"spec,omitempty"`
+}
+
+type dnsEndpointSpec struct {
+	Endpoints []endpoint `json:"endpoints,omitempty"`
+}
+
+type endpoint struct {
+	DNSName    string   `json:"dnsName,omitempty"`
+	Targets    []string `json:"targets,omitempty"`
+	RecordType string   `json:"recordType,omitempty"`
+	RecordTTL  int64    `json:"recordTTL,omitempty"`
+}
+
+func buildDNSEndpoint(name string, ch *whapi.ChallengeRequest, cfg *cmacme.ACMEIssuerDNS01ProviderExternalDNS, targets []string) *dnsEndpoint {
+	ttl := defaultRecordTTL
+	if cfg.RecordTTL != nil {
+		ttl = *cfg.RecordTTL
+	}
+
+	return &dnsEndpoint{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: dnsEndpointGVK.GroupVersion().String(),
+			Kind:       dnsEndpointGVK.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   ch.ResourceNamespace,
+			Labels:      cfg.Labels,
+			Annotations: cfg.Annotations,
+		},
+		Spec: dnsEndpointSpec{
+			Endpoints: []endpoint{
+				{
+					DNSName:    util.UnFqdn(ch.ResolvedFQDN),
+					Targets:    targets,
+					RecordType: "TXT",
+					RecordTTL:  ttl,
+				},
+			},
+		},
+	}
+}
+
+// targets returns the TXT targets of all endpoints in the DNSEndpoint.
+func (e *dnsEndpoint) targets() []string {
+	var targets []string
+	for _, ep := range e.Spec.Endpoints {
+		if ep.RecordType == "TXT" {
+			targets = append(targets, ep.Targets...)
+		}
+	}
+	return targets
+}
+
+func (e *dnsEndpoint) toUnstructured() (*unstructured.Unstructured, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(e)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+func fromUnstructured(obj *unstructured.Unstructured) (*dnsEndpoint, error) {
+	ep := new(dnsEndpoint)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ep); err != nil {
+		return nil, fmt.Errorf("error decoding DNSEndpoint %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+	return ep, nil
+}
+
+// mergeMaps returns a copy of a with all entries from b added to it.
+func mergeMaps(a, b map[string]string) map[string]string {
+	if len(b) == 0 {
+		return a
+	}
+	out := make(map[string]string, len(a)+len(b))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		out[k] = v
+	}
+	return out
+}
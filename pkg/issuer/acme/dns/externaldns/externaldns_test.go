@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldns
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/pointer"
+
+	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+func newChallengeRequest(t *testing.T, key string, cfg cmacme.ACMEIssuerDNS01ProviderExternalDNS) *whapi.ChallengeRequest {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &whapi.ChallengeRequest{
+		Type:              "dns-01",
+		DNSName:           "example.com",
+		ResolvedFQDN:      "_acme-challenge.example.com.",
+		ResolvedZone:      "example.com.",
+		ResourceNamespace: "ns",
+		Key:               key,
+		Config:            &apiextensionsv1.JSON{Raw: b},
+	}
+}
+
+func TestPresentAndCleanUp(t *testing.T) {
+	var checked []string
+	origPreCheckDNS := util.PreCheckDNS
+	defer func() { util.PreCheckDNS = origPreCheckDNS }()
+	util.PreCheckDNS = func(fqdn, value string, _ []string, _ bool) (bool, error) {
+		checked = append(checked, value)
+		return true, nil
+	}
+
+	cl := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		DNSEndpointGVR: "DNSEndpointList",
+	})
+	s := New(WithDynamicClient(cl), WithPropagationTimeout(time.Second, time.Millisecond))
+	cfg := cmacme.ACMEIssuerDNS01ProviderExternalDNS{
+		Labels:    map[string]string{"external-dns": "public"},
+		RecordTTL: pointer.Int64(120),
+	}
+
+	get := func() *dnsEndpoint {
+		obj, err := cl.Resource(DNSEndpointGVR).Namespace("ns").Get(context.TODO(), endpointName("_acme-challenge.example.com."), metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get DNSEndpoint: %v", err)
+		}
+		ep, err := fromUnstructured(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ep
+	}
+
+	if err := s.Present(newChallengeRequest(t, "key1", cfg)); err != nil {
+		t.Fatalf("unexpected error presenting first key: %v", err)
+	}
+	if err := s.Present(newChallengeRequest(t, "key2", cfg)); err != nil {
+		t.Fatalf("unexpected error presenting second key: %v", err)
+	}
+	// presenting the same key again should not add a duplicate target
+	if err := s.Present(newChallengeRequest(t, "key1", cfg)); err != nil {
+		t.Fatalf("unexpected error re-presenting first key: %v", err)
+	}
+
+	ep := get()
+	expected := []endpoint{{DNSName: "_acme-challenge.example.com", Targets: []string{"key1", "key2"}, RecordType: "TXT", RecordTTL: 120}}
+	if !reflect.DeepEqual(ep.Spec.Endpoints, expected) {
+		t.Errorf("unexpected endpoints, exp=%+v got=%+v", expected, ep.Spec.Endpoints)
+	}
+	if ep.Labels["external-dns"] != "public" {
+		t.Errorf("expected DNSEndpoint to have configured labels, got %v", ep.Labels)
+	}
+	if !reflect.DeepEqual(checked, []string{"key1", "key2", "key1"}) {
+		t.Errorf("expected DNS propagation to be checked for each key, got %v", checked)
+	}
+
+	if err := s.CleanUp(newChallengeRequest(t, "key1", cfg)); err != nil {
+		t.Fatalf("unexpected error cleaning up first key: %v", err)
+	}
+	if targets := get().targets(); !reflect.DeepEqual(targets, []string{"key2"}) {
+		t.Errorf("expected only key2 to remain, got %v", targets)
+	}
+
+	if err := s.CleanUp(newChallengeRequest(t, "key2", cfg)); err != nil {
+		t.Fatalf("unexpected error cleaning up second key: %v", err)
+	}
+	_, err := cl.Resource(DNSEndpointGVR).Namespace("ns").Get(context.TODO(), endpointName("_acme-challenge.example.com."), metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected DNSEndpoint to be deleted, got err=%v", err)
+	}
+
+	// cleaning up when the DNSEndpoint no longer exists should succeed
+	if err := s.CleanUp(newChallengeRequest(t, "key2", cfg)); err != nil {
+		t.Errorf("unexpected error cleaning up already removed key: %v", err)
+	}
+}
+
+func TestPresentPropagationTimeout(t *testing.T) {
+	origPreCheckDNS := util.PreCheckDNS
+	defer func() { util.PreCheckDNS = origPreCheckDNS }()
+	util.PreCheckDNS = func(string, string, []string, bool) (bool, error) {
+		return false, nil
+	}
+
+	cl := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		DNSEndpointGVR: "DNSEndpointList",
+	})
+	s := New(WithDynamicClient(cl), WithPropagationTimeout(10*time.Millisecond, time.Millisecond))
+	if err := s.Present(newChallengeRequest(t, "key", cmacme.ACMEIssuerDNS01ProviderExternalDNS{})); err == nil {
+		t.Errorf("expected error when record is not published")
+	}
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package powerdns implements a DNS provider for solving the DNS-01 challenge
+// using the PowerDNS authoritative server HTTP API. For more information see:
+//
+//	https://doc.powerdns.com/authoritative/http-api/
+package powerdns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const (
+	defaultServerID = "localhost"
+	defaultTTL      = 60
+
+	apiKeyHeader = "X-API-Key"
+)
+
+// rrsetLock serialises read-modify-write cycles on TXT RRsets, so that
+// concurrently presented challenges for the same name do not overwrite each
+// other's values.
+var rrsetLock sync.Mutex
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	baseURL   *url.URL
+	apiKey    string
+	userAgent string
+	client    *http.Client
+}
+
+// NewDNSProviderCredentials returns a DNSProvider instance configured to use
+// the PowerDNS API at the given host.
+// If serverID is empty, the default 'localhost' server is used.
+func NewDNSProviderCredentials(host, apiKey, serverID, userAgent string) (*DNSProvider, error) {
+	if host == "" {
+		return nil, fmt.Errorf("PowerDNS host missing")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("PowerDNS API key missing")
+	}
+	if serverID == "" {
+		serverID = defaultServerID
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PowerDNS host %q: %v", host, err)
+	}
+	u = u.JoinPath("api", "v1", "servers", serverID)
+
+	return &DNSProvider{
+		baseURL:   u,
+		apiKey:    apiKey,
+		userAgent: userAgent,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge.
+// If a TXT RRset already exists for the name, the value is added to it.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	return c.ApplyTXTChanges("", []util.TXTRecordChange{
+		{Action: util.TXTRecordPresent, Domain: domain, FQDN: fqdn, Value: value},
+	})
+}
+
+// CleanUp removes the TXT record matching the specified parameters, deleting
+// the RRset if it contains no other values.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	return c.ApplyTXTChanges("", []util.TXTRecordChange{
+		{Action: util.TXTRecordCleanUp, Domain: domain, FQDN: fqdn, Value: value},
+	})
+}
+
+// ApplyTXTChanges applies the given changes, making a single PATCH request
+// for each PowerDNS zone containing changed RRsets.
+// The zone is looked up using the PowerDNS API, so the zone argument is
+// ignored.
+func (c *DNSProvider) ApplyTXTChanges(_ string, changes []util.TXTRecordChange) error {
+	rrsetLock.Lock()
+	defer rrsetLock.Unlock()
+
+	var zones []zone
+	if err := c.do(http.MethodGet, c.baseURL.JoinPath("zones"), nil, &zones); err != nil {
+		return err
+	}
+
+	// group the changes by zone, preserving the order they were made in
+	var zoneIDs []string
+	changesByZone := make(map[string][]util.TXTRecordChange)
+	for _, change := range changes {
+		change.FQDN = util.ToFqdn(change.FQDN)
+		z, err := findZone(zones, change.FQDN)
+		if err != nil {
+			return err
+		}
+		if _, ok := changesByZone[z.ID]; !ok {
+			zoneIDs = append(zoneIDs, z.ID)
+		}
+		changesByZone[z.ID] = append(changesByZone[z.ID], change)
+	}
+
+	for _, id := range zoneIDs {
+		if err := c.updateZone(id, changesByZone[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateZone applies changes to the TXT RRsets of the zone with the given ID.
+func (c *DNSProvider) updateZone(id string, changes []util.TXTRecordChange) error {
+	var z zone
+	if err := c.do(http.MethodGet, c.baseURL.JoinPath("zones", id), nil, &z); err != nil {
+		return err
+	}
+
+	var names []string
+	current := make(map[string][]string)
+	records := make(map[string][]string)
+	ttls := make(map[string]int)
+	for _, change := range changes {
+		name := strings.ToLower(change.FQDN)
+		if _, ok := records[name]; !ok {
+			names = append(names, change.FQDN)
+			current[name] = currentRecords(z, change.FQDN)
+			records[name] = current[name]
+			ttls[name] = currentTTL(z, change.FQDN)
+		}
+		records[name] = mutate(records[name], change)
+	}
+
+	var rrsets []rrSet
+	for _, fqdn := range names {
+		name := strings.ToLower(fqdn)
+		if equal(current[name], records[name]) {
+			continue
+		}
+		rrset := rrSet{
+			Name:    fqdn,
+			Type:    "TXT",
+			TTL:     ttls[name],
+			Records: []record{},
+		}
+		if len(records[name]) == 0 {
+			rrset.ChangeType = "DELETE"
+		} else {
+			rrset.ChangeType = "REPLACE"
+			for _, r := range records[name] {
+				rrset.Records = append(rrset.Records, record{Content: strconv.Quote(r)})
+			}
+		}
+		rrsets = append(rrsets, rrset)
+	}
+	if len(rrsets) == 0 {
+		return nil
+	}
+
+	return c.do(http.MethodPatch, c.baseURL.JoinPath("zones", z.ID), zonePatch{RRSets: rrsets}, nil)
+}
+
+// mutate returns the values of a TXT RRset after applying change.
+func mutate(records []string, change util.TXTRecordChange) []string {
+	if change.Action == util.TXTRecordCleanUp {
+		var remaining []string
+		for _, r := range records {
+			if r != change.Value {
+				remaining = append(remaining, r)
+			}
+		}
+		return remaining
+	}
+
+	for _, r := range records {
+		if r == change.Value {
+			return records
+		}
+	}
+	// copy to avoid modifying the backing array of the current records
+	return append(append([]string(nil), records...), change.Value)
+}
+
+// currentRecords returns the values of the TXT RRset for fqdn in the zone.
+func currentRecords(z zone, fqdn string) []string {
+	var current []string
+	for _, rrset := range z.RRSets {
+		if rrset.Type != "TXT" || !strings.EqualFold(rrset.Name, fqdn) {
+			continue
+		}
+		for _, r := range rrset.Records {
+			current = append(current, unquote(r.Content))
+		}
+	}
+	return current
+}
+
+// currentTTL returns the TTL of the TXT RRset for fqdn in the zone, or the
+// default TTL if the RRset does not exist.
+func currentTTL(z zone, fqdn string) int {
+	for _, rrset := range z.RRSets {
+		if rrset.Type == "TXT" && strings.EqualFold(rrset.Name, fqdn) && rrset.TTL > 0 {
+			return rrset.TTL
+		}
+	}
+	return defaultTTL
+}
+
+// findZone returns the zone with the longest name that the given fqdn is a
+// member of.
+func findZone(zones []zone, fqdn string) (*zone, error) {
+	var best *zone
+	for i, z := range zones {
+		name := util.ToFqdn(z.Name)
+		if !strings.EqualFold(fqdn, name) && !strings.HasSuffix(strings.ToLower(fqdn), "."+strings.ToLower(name)) {
+			continue
+		}
+		if best == nil || len(name) > len(best.Name) {
+			best = &zones[i]
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("no PowerDNS zone found for %q", fqdn)
+	}
+	return best, nil
+}
+
+func (c *DNSProvider) do(method string, u *url.URL, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(apiKeyHeader, c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("PowerDNS API request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Error != "" {
+			return fmt.Errorf("PowerDNS API %s %s failed with status %d: %s", method, u.Path, resp.StatusCode, apiErr.Error)
+		}
+		return fmt.Errorf("PowerDNS API %s %s failed with status %d", method, u.Path, resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type zone struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	RRSets []rrSet `json:"rrsets,omitempty"`
+}
+
+type zonePatch struct {
+	RRSets []rrSet `json:"rrsets"`
+}
+
+type rrSet struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	TTL        int      `json:"ttl,omitempty"`
+	ChangeType string   `json:"changetype,omitempty"`
+	Records    []record `json:"records"`
+}
+
+type record struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+// unquote removes the quotes PowerDNS requires around TXT record content.
+func unquote(s string) string {
+	if u, err := strconv.Unquote(s); err == nil {
+		return u
+	}
+	return s
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
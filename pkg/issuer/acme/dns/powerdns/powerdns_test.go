@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerdns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+func TestNewDNSProviderCredentials(t *testing.T) {
+	_, err := NewDNSProviderCredentials("", "key", "", "")
+	assert.Error(t, err, "expected error when host is missing")
+
+	_, err = NewDNSProviderCredentials("https://pdns.example.com", "", "", "")
+	assert.Error(t, err, "expected error when api key is missing")
+
+	p, err := NewDNSProviderCredentials("https://pdns.example.com:8081", "key", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://pdns.example.com:8081/api/v1/servers/localhost", p.baseURL.String())
+
+	p, err = NewDNSProviderCredentials("https://pdns.example.com:8081/", "key", "other", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://pdns.example.com:8081/api/v1/servers/other", p.baseURL.String())
+}
+
+func TestUnquote(t *testing.T) {
+	assert.Equal(t, "value", unquote(`"value"`))
+	assert.Equal(t, "value", unquote("value"))
+}
+
+func TestApplyTXTChanges(t *testing.T) {
+	var patches []zonePatch
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "key", r.Header.Get(apiKeyHeader))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/servers/localhost/zones":
+			_ = json.NewEncoder(w).Encode([]zone{
+				{ID: "example.com.", Name: "example.com."},
+				{ID: "sub.example.com.", Name: "sub.example.com."},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/servers/localhost/zones/example.com.":
+			_ = json.NewEncoder(w).Encode(zone{
+				ID:   "example.com.",
+				Name: "example.com.",
+				RRSets: []rrSet{
+					{Name: "_acme-challenge.a.example.com.", Type: "TXT", TTL: 120, Records: []record{{Content: `"existing"`}}},
+					{Name: "_acme-challenge.b.example.com.", Type: "TXT", TTL: 60, Records: []record{{Content: `"old"`}}},
+				},
+			})
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/servers/localhost/zones/example.com.":
+			var patch zonePatch
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&patch))
+			patches = append(patches, patch)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	p, err := NewDNSProviderCredentials(srv.URL, "key", "", "")
+	assert.NoError(t, err)
+
+	err = p.ApplyTXTChanges("example.com.", []util.TXTRecordChange{
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.a.example.com.", Value: "one"},
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.a.example.com.", Value: "two"},
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.a.example.com.", Value: "existing"},
+		{Action: util.TXTRecordCleanUp, FQDN: "_acme-challenge.b.example.com.", Value: "old"},
+		{Action: util.TXTRecordCleanUp, FQDN: "_acme-challenge.c.example.com.", Value: "missing"},
+	})
+	assert.NoError(t, err)
+
+	// all changes should be made using a single request
+	assert.Equal(t, []zonePatch{{RRSets: []rrSet{
+		{
+			Name:       "_acme-challenge.a.example.com.",
+			Type:       "TXT",
+			TTL:        120,
+			ChangeType: "REPLACE",
+			Records:    []record{{Content: `"existing"`}, {Content: `"one"`}, {Content: `"two"`}},
+		},
+		{
+			Name:       "_acme-challenge.b.example.com.",
+			Type:       "TXT",
+			TTL:        60,
+			ChangeType: "DELETE",
+			Records:    []record{},
+		},
+	}}}, patches)
+
+	// changes which leave the zone unmodified should not make any request
+	patches = nil
+	err = p.ApplyTXTChanges("example.com.", []util.TXTRecordChange{
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.a.example.com.", Value: "existing"},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, patches)
+}
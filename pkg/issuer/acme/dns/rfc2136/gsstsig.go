@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bodgit/tsig"
+	"github.com/bodgit/tsig/gss"
+	"github.com/miekg/dns"
+)
+
+// GSSTSIGCredentials contains the Kerberos credentials used to negotiate a
+// GSS-TSIG (RFC 3645) security context with the nameserver.
+// Exactly one of Password or Keytab must be set.
+type GSSTSIGCredentials struct {
+	// Realm is the Kerberos realm of the principal.
+	Realm string
+	// Username is the name of the principal, without the realm.
+	Username string
+	// Password is the password of the principal.
+	Password string
+	// Keytab is the contents of a keytab file containing the keys of the
+	// principal.
+	Keytab []byte
+	// KDCs is a list of KDC addresses in the form host:port. If empty, the
+	// KDCs are discovered using DNS SRV records.
+	KDCs []string
+}
+
+// NewDNSProviderGSSTSIG returns a DNSProvider instance configured for rfc2136
+// dynamic update, authenticating updates using GSS-TSIG with the given
+// Kerberos credentials.
+// The host part of nameserver is used to build the DNS/<host> service
+// principal name, so it should usually be the hostname of the nameserver.
+func NewDNSProviderGSSTSIG(nameserver string, creds GSSTSIGCredentials) (*DNSProvider, error) {
+	if creds.Realm == "" {
+		return nil, fmt.Errorf("GSS-TSIG realm missing")
+	}
+	if creds.Username == "" {
+		return nil, fmt.Errorf("GSS-TSIG username missing")
+	}
+	if (creds.Password == "") == (len(creds.Keytab) == 0) {
+		return nil, fmt.Errorf("exactly one of GSS-TSIG password or keytab must be provided")
+	}
+
+	d, err := NewDNSProviderCredentials(nameserver, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	d.gssTSIG = &creds
+
+	return d, nil
+}
+
+// krb5Config renders a minimal krb5.conf for the realm of the credentials.
+func (c *GSSTSIGCredentials) krb5Config() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[libdefaults]\n")
+	fmt.Fprintf(&b, "  default_realm = %s\n", c.Realm)
+	fmt.Fprintf(&b, "  dns_lookup_realm = false\n")
+	fmt.Fprintf(&b, "  dns_lookup_kdc = %t\n", len(c.KDCs) == 0)
+	// Tickets issued by Active Directory contain a PAC and are often too
+	// large for UDP, so always talk to the KDC over TCP.
+	fmt.Fprintf(&b, "  udp_preference_limit = 1\n")
+	if len(c.KDCs) > 0 {
+		fmt.Fprintf(&b, "[realms]\n")
+		fmt.Fprintf(&b, "  %s = {\n", c.Realm)
+		for _, kdc := range c.KDCs {
+			fmt.Fprintf(&b, "    kdc = %s\n", kdc)
+		}
+		fmt.Fprintf(&b, "  }\n")
+	}
+	return b.String()
+}
+
+// exchangeGSSTSIG negotiates a new GSS-TSIG security context with the
+// nameserver and uses it to sign and send m.
+func (r *DNSProvider) exchangeGSSTSIG(c *dns.Client, m *dns.Msg) (*dns.Msg, error) {
+	// Signed messages and the TKEY exchange are usually too large for UDP
+	c.Net = "tcp"
+
+	gssClient, err := gss.NewClient(c, gss.WithConfig(r.gssTSIG.krb5Config()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating GSS-TSIG client: %v", err)
+	}
+	defer gssClient.Close()
+
+	var keyName string
+	if len(r.gssTSIG.Keytab) > 0 {
+		keyName, err = r.negotiateWithKeytab(gssClient)
+	} else {
+		keyName, _, err = gssClient.NegotiateContextWithCredentials(r.nameserver, r.gssTSIG.Realm, r.gssTSIG.Username, r.gssTSIG.Password)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error negotiating GSS-TSIG context: %v", err)
+	}
+
+	c.TsigProvider = gssClient
+	m.SetTsig(keyName, tsig.GSS, 300, time.Now().Unix())
+
+	reply, _, err := c.Exchange(m, r.nameserver)
+	return reply, err
+}
+
+// negotiateWithKeytab writes the keytab to a temporary file, as required by
+// the GSS-TSIG client, and negotiates a security context using it.
+func (r *DNSProvider) negotiateWithKeytab(gssClient *gss.Client) (string, error) {
+	f, err := os.CreateTemp("", "cert-manager-rfc2136-keytab-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(r.gssTSIG.Keytab); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	keyName, _, err := gssClient.NegotiateContextWithKeytab(r.nameserver, r.gssTSIG.Realm, r.gssTSIG.Username, f.Name())
+	return keyName, err
+}
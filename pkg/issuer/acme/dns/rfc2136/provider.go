@@ -181,6 +181,10 @@ func (s *Solver) buildDNSProvider(ch *whapi.ChallengeRequest) (*DNSProvider, err
 	}
 
 	l := s.secretLister.Secrets(ch.ResourceNamespace)
+	if cfg.GSSTSIG != nil {
+		return buildGSSTSIGDNSProvider(l, cfg)
+	}
+
 	secret, err := loadSecretKeySelector(l, cfg.TSIGSecret, "")
 	if err != nil {
 		return nil, err
@@ -192,3 +196,27 @@ func (s *Solver) buildDNSProvider(ch *whapi.ChallengeRequest) (*DNSProvider, err
 
 	return NewDNSProviderCredentials(cfg.Nameserver, cfg.TSIGAlgorithm, cfg.TSIGKeyName, key)
 }
+
+func buildGSSTSIGDNSProvider(l corelisters.SecretNamespaceLister, cfg *cmacme.ACMEIssuerDNS01ProviderRFC2136) (*DNSProvider, error) {
+	creds := GSSTSIGCredentials{
+		Realm:    cfg.GSSTSIG.Realm,
+		Username: cfg.GSSTSIG.Username,
+		KDCs:     cfg.GSSTSIG.KDCs,
+	}
+	if cfg.GSSTSIG.PasswordSecretRef != nil {
+		password, err := loadSecretKeySelector(l, *cfg.GSSTSIG.PasswordSecretRef, "")
+		if err != nil {
+			return nil, err
+		}
+		creds.Password = string(password)
+	}
+	if cfg.GSSTSIG.KeytabSecretRef != nil {
+		keytab, err := loadSecretKeySelector(l, *cfg.GSSTSIG.KeytabSecretRef, "")
+		if err != nil {
+			return nil, err
+		}
+		creds.Keytab = keytab
+	}
+
+	return NewDNSProviderGSSTSIG(cfg.Nameserver, creds)
+}
@@ -47,6 +47,7 @@ type DNSProvider struct {
 	tsigAlgorithm string
 	tsigKeyName   string
 	tsigSecret    string
+	gssTSIG       *GSSTSIGCredentials
 }
 
 // NewDNSProviderCredentials uses the supplied credentials to return a
@@ -126,16 +127,24 @@ func (r *DNSProvider) changeRecord(action, fqdn, zone, value string, ttl int) er
 
 	// Setup client
 	c := new(dns.Client)
-	c.TsigProvider = tsigHMACProvider(r.tsigSecret)
 	c.SingleInflight = true
-	// TSIG authentication / msg signing
-	if len(r.tsigKeyName) > 0 && len(r.tsigSecret) > 0 {
-		m.SetTsig(dns.Fqdn(r.tsigKeyName), r.tsigAlgorithm, 300, time.Now().Unix())
-		c.TsigSecret = map[string]string{dns.Fqdn(r.tsigKeyName): r.tsigSecret}
-	}
 
-	// Send the query
-	reply, _, err := c.Exchange(m, r.nameserver)
+	var reply *dns.Msg
+	var err error
+	if r.gssTSIG != nil {
+		// GSS-TSIG authentication / msg signing
+		reply, err = r.exchangeGSSTSIG(c, m)
+	} else {
+		c.TsigProvider = tsigHMACProvider(r.tsigSecret)
+		// TSIG authentication / msg signing
+		if len(r.tsigKeyName) > 0 && len(r.tsigSecret) > 0 {
+			m.SetTsig(dns.Fqdn(r.tsigKeyName), r.tsigAlgorithm, 300, time.Now().Unix())
+			c.TsigSecret = map[string]string{dns.Fqdn(r.tsigKeyName): r.tsigSecret}
+		}
+
+		// Send the query
+		reply, _, err = c.Exchange(m, r.nameserver)
+	}
 	if err != nil {
 		return fmt.Errorf("DNS update failed: %v", err)
 	}
@@ -172,40 +172,195 @@ func NewDNSProvider(accessKeyID, secretAccessKey, hostedZoneID, region, role str
 
 // Present creates a TXT record using the specified parameters
 func (r *DNSProvider) Present(domain, fqdn, value string) error {
-	value = `"` + value + `"`
-	return r.changeRecord(route53.ChangeActionUpsert, fqdn, value, route53TTL)
+	return r.ApplyTXTChanges("", []util.TXTRecordChange{
+		{Action: util.TXTRecordPresent, Domain: domain, FQDN: fqdn, Value: value},
+	})
 }
 
 // CleanUp removes the TXT record matching the specified parameters
 func (r *DNSProvider) CleanUp(domain, fqdn, value string) error {
-	value = `"` + value + `"`
-	return r.changeRecord(route53.ChangeActionDelete, fqdn, value, route53TTL)
+	return r.ApplyTXTChanges("", []util.TXTRecordChange{
+		{Action: util.TXTRecordCleanUp, Domain: domain, FQDN: fqdn, Value: value},
+	})
+}
+
+// ApplyTXTChanges applies the given changes, making a single
+// ChangeResourceRecordSets request per hosted zone.
+// All values of a TXT record are held in a single record set, so the changes
+// to each record are merged with its current values into one change to its
+// record set. Route 53 rejects change batches which change a record set more
+// than once.
+// The hosted zone is looked up using the Route 53 API, so the zone argument
+// is ignored.
+func (r *DNSProvider) ApplyTXTChanges(_ string, changes []util.TXTRecordChange) error {
+	var hostedZoneIDs []string
+	fqdnsInZone := make(map[string][]string)
+	changesForFQDN := make(map[string][]util.TXTRecordChange)
+	for _, change := range changes {
+		if _, ok := changesForFQDN[change.FQDN]; !ok {
+			hostedZoneID, err := r.getHostedZoneID(change.FQDN)
+			if err != nil {
+				return fmt.Errorf("failed to determine Route 53 hosted zone ID: %v", err)
+			}
+			if _, ok := fqdnsInZone[hostedZoneID]; !ok {
+				hostedZoneIDs = append(hostedZoneIDs, hostedZoneID)
+			}
+			fqdnsInZone[hostedZoneID] = append(fqdnsInZone[hostedZoneID], change.FQDN)
+		}
+		changesForFQDN[change.FQDN] = append(changesForFQDN[change.FQDN], change)
+	}
+
+	for _, hostedZoneID := range hostedZoneIDs {
+		var rrChanges []*route53.Change
+		for _, fqdn := range fqdnsInZone[hostedZoneID] {
+			fqdnChanges, err := r.txtRecordSetChanges(hostedZoneID, fqdn, changesForFQDN[fqdn])
+			if err != nil {
+				return err
+			}
+			rrChanges = append(rrChanges, fqdnChanges...)
+		}
+		if err := r.changeRecords(hostedZoneID, rrChanges); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// txtRecordSetChanges returns the Route 53 changes which apply the given
+// changes to the TXT record named fqdn. The current values of the record are
+// kept, so that values presented for other challenges aren't removed. The
+// solver applies the batches of a zone one at a time, so the record set isn't
+// rewritten concurrently between being read and being changed.
+// Previous versions of cert-manager created a multivalue answer record set
+// per value; these are replaced by a single record set.
+func (r *DNSProvider) txtRecordSetChanges(hostedZoneID, fqdn string, changes []util.TXTRecordChange) ([]*route53.Change, error) {
+	existing, err := r.getTXTRecordSets(hostedZoneID, fqdn)
+	if err != nil {
+		return nil, err
+	}
+
+	var current []string
+	for _, rrSet := range existing {
+		for _, rr := range rrSet.ResourceRecords {
+			current = appendValue(current, aws.StringValue(rr.Value))
+		}
+	}
+	desired := append([]string(nil), current...)
+	for _, change := range changes {
+		value := `"` + change.Value + `"`
+		if change.Action == util.TXTRecordCleanUp {
+			desired = removeValue(desired, value)
+		} else {
+			desired = appendValue(desired, value)
+		}
+	}
+
+	single := len(existing) == 1 && existing[0].SetIdentifier == nil
+	if single && equalValues(current, desired) {
+		return nil, nil
+	}
+
+	var rrChanges []*route53.Change
+	if !single || len(desired) == 0 {
+		for _, rrSet := range existing {
+			rrChanges = append(rrChanges, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: rrSet,
+			})
+		}
+	}
+	if len(desired) > 0 {
+		rrChanges = append(rrChanges, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: newTXTRecordSet(fqdn, desired, route53TTL),
+		})
+	}
+	return rrChanges, nil
 }
 
-func (r *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
-	hostedZoneID, err := r.getHostedZoneID(fqdn)
+// getTXTRecordSets returns the TXT record sets named fqdn in the hosted zone.
+func (r *DNSProvider) getTXTRecordSets(hostedZoneID, fqdn string) ([]*route53.ResourceRecordSet, error) {
+	resp, err := r.client.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(hostedZoneID),
+		StartRecordName: aws.String(fqdn),
+		StartRecordType: aws.String(route53.RRTypeTxt),
+	})
 	if err != nil {
-		return fmt.Errorf("failed to determine Route 53 hosted zone ID: %v", err)
+		return nil, fmt.Errorf("failed to list Route 53 record sets: %v", removeReqID(err))
+	}
+
+	var rrSets []*route53.ResourceRecordSet
+	for _, rrSet := range resp.ResourceRecordSets {
+		if !strings.EqualFold(util.ToFqdn(aws.StringValue(rrSet.Name)), util.ToFqdn(fqdn)) ||
+			aws.StringValue(rrSet.Type) != route53.RRTypeTxt {
+			continue
+		}
+		rrSets = append(rrSets, rrSet)
+	}
+	return rrSets, nil
+}
+
+func appendValue(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func removeValue(values []string, value string) []string {
+	var out []string
+	for _, v := range values {
+		if v != value {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func equalValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// changeRecords submits the changes in a single change batch and waits for
+// it to be applied.
+// If a batch consisting only of deletions is rejected because one of the
+// records no longer exists, the records are deleted individually instead.
+func (r *DNSProvider) changeRecords(hostedZoneID string, changes []*route53.Change) error {
+	if len(changes) == 0 {
+		return nil
 	}
 
-	recordSet := newTXTRecordSet(fqdn, value, ttl)
 	reqParams := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: aws.String(hostedZoneID),
 		ChangeBatch: &route53.ChangeBatch{
 			Comment: aws.String("Managed by cert-manager"),
-			Changes: []*route53.Change{
-				{
-					Action:            &action,
-					ResourceRecordSet: recordSet,
-				},
-			},
+			Changes: changes,
 		},
 	}
 
 	resp, err := r.client.ChangeResourceRecordSets(reqParams)
 	if err != nil {
 		if awserr, ok := err.(awserr.Error); ok {
-			if action == route53.ChangeActionDelete && awserr.Code() == route53.ErrCodeInvalidChangeBatch {
+			if onlyDeletions(changes) && awserr.Code() == route53.ErrCodeInvalidChangeBatch {
+				if len(changes) > 1 {
+					r.log.V(logf.DebugLevel).WithValues("error", err).Info("retrying deletions individually after InvalidChangeBatch error")
+					for _, change := range changes {
+						if err := r.changeRecords(hostedZoneID, []*route53.Change{change}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
 				r.log.V(logf.DebugLevel).WithValues("error", err).Info("ignoring InvalidChangeBatch error")
 				// If we try to delete something and get a 'InvalidChangeBatch' that
 				// means it's already deleted, no need to consider it an error.
@@ -233,6 +388,15 @@ func (r *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
 	})
 }
 
+func onlyDeletions(changes []*route53.Change) bool {
+	for _, change := range changes {
+		if aws.StringValue(change.Action) != route53.ChangeActionDelete {
+			return false
+		}
+	}
+	return true
+}
+
 func (r *DNSProvider) getHostedZoneID(fqdn string) (string, error) {
 	if r.hostedZoneID != "" {
 		return r.hostedZoneID, nil
@@ -279,16 +443,16 @@ func (r *DNSProvider) getHostedZoneID(fqdn string) (string, error) {
 	return hostedZoneID, nil
 }
 
-func newTXTRecordSet(fqdn, value string, ttl int) *route53.ResourceRecordSet {
+func newTXTRecordSet(fqdn string, values []string, ttl int) *route53.ResourceRecordSet {
+	rrs := make([]*route53.ResourceRecord, len(values))
+	for i, value := range values {
+		rrs[i] = &route53.ResourceRecord{Value: aws.String(value)}
+	}
 	return &route53.ResourceRecordSet{
-		Name:             aws.String(fqdn),
-		Type:             aws.String(route53.RRTypeTxt),
-		TTL:              aws.Int64(int64(ttl)),
-		MultiValueAnswer: aws.Bool(true),
-		SetIdentifier:    aws.String(value),
-		ResourceRecords: []*route53.ResourceRecord{
-			{Value: aws.String(value)},
-		},
+		Name:            aws.String(fqdn),
+		Type:            aws.String(route53.RRTypeTxt),
+		TTL:             aws.Int64(int64(ttl)),
+		ResourceRecords: rrs,
 	}
 }
 
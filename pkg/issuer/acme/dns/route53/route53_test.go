@@ -9,10 +9,15 @@ this directory.
 package route53
 
 import (
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -112,9 +117,12 @@ func TestRoute53Present(t *testing.T) {
 	mockResponses := MockResponseMap{
 		"/2013-04-01/hostedzonesbyname":         MockResponse{StatusCode: 200, Body: ListHostedZonesByNameResponse},
 		"/2013-04-01/hostedzone/ABCDEFG/rrset/": MockResponse{StatusCode: 200, Body: ChangeResourceRecordSetsResponse},
+		"/2013-04-01/hostedzone/ABCDEFG/rrset":  MockResponse{StatusCode: 200, Body: ListResourceRecordSetsResponse},
 		"/2013-04-01/hostedzone/HIJKLMN/rrset/": MockResponse{StatusCode: 200, Body: ChangeResourceRecordSetsResponse},
+		"/2013-04-01/hostedzone/HIJKLMN/rrset":  MockResponse{StatusCode: 200, Body: ListResourceRecordSetsResponse},
 		"/2013-04-01/change/123456":             MockResponse{StatusCode: 200, Body: GetChangeResponse},
 		"/2013-04-01/hostedzone/OPQRSTU/rrset/": MockResponse{StatusCode: 403, Body: ChangeResourceRecordSets403Response},
+		"/2013-04-01/hostedzone/OPQRSTU/rrset":  MockResponse{StatusCode: 200, Body: ListResourceRecordSetsResponse},
 	}
 
 	ts := newMockServer(t, mockResponses)
@@ -149,6 +157,189 @@ func TestRoute53Present(t *testing.T) {
 	assert.Equal(t, `failed to change Route 53 record set: AccessDenied: User: arn:aws:iam::0123456789:user/test-cert-manager is not authorized to perform: route53:ChangeResourceRecordSets on resource: arn:aws:route53:::hostedzone/OPQRSTU`, err.Error())
 }
 
+// fakeRecordSet is the XML representation of a Route 53 ResourceRecordSet.
+type fakeRecordSet struct {
+	Name          string       `xml:"Name"`
+	Type          string       `xml:"Type"`
+	SetIdentifier string       `xml:"SetIdentifier,omitempty"`
+	TTL           int64        `xml:"TTL"`
+	Records       []fakeRecord `xml:"ResourceRecords>ResourceRecord"`
+}
+
+type fakeRecord struct {
+	Value string `xml:"Value"`
+}
+
+func (rrSet fakeRecordSet) values() []string {
+	var values []string
+	for _, rr := range rrSet.Records {
+		values = append(values, rr.Value)
+	}
+	return values
+}
+
+func (rrSet fakeRecordSet) key() string {
+	return rrSet.Name + "/" + rrSet.SetIdentifier
+}
+
+// fakeRoute53 serves the record sets of the hosted zone ABCDEFG, and applies
+// change batches to them the way Route 53 does: a batch is rejected if it
+// changes the same record set twice, or deletes a record set which doesn't
+// exactly match an existing one.
+type fakeRoute53 struct {
+	rrSets  map[string]fakeRecordSet
+	batches [][]string
+}
+
+func (f *fakeRoute53) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/xml")
+	switch {
+	case r.URL.Path == "/2013-04-01/hostedzone/ABCDEFG/rrset" && r.Method == http.MethodGet:
+		resp := struct {
+			XMLName            xml.Name        `xml:"https://route53.amazonaws.com/doc/2013-04-01/ ListResourceRecordSetsResponse"`
+			ResourceRecordSets []fakeRecordSet `xml:"ResourceRecordSets>ResourceRecordSet"`
+			IsTruncated        bool            `xml:"IsTruncated"`
+			MaxItems           string          `xml:"MaxItems"`
+		}{MaxItems: "100"}
+		for _, rrSet := range f.rrSets {
+			resp.ResourceRecordSets = append(resp.ResourceRecordSets, rrSet)
+		}
+		sort.Slice(resp.ResourceRecordSets, func(i, j int) bool {
+			return resp.ResourceRecordSets[i].key() < resp.ResourceRecordSets[j].key()
+		})
+		_ = xml.NewEncoder(w).Encode(resp)
+	case r.URL.Path == "/2013-04-01/hostedzone/ABCDEFG/rrset/" && r.Method == http.MethodPost:
+		var req struct {
+			Changes []struct {
+				Action string        `xml:"Action"`
+				RRSet  fakeRecordSet `xml:"ResourceRecordSet"`
+			} `xml:"ChangeBatch>Changes>Change"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var batch []string
+		changed := make(map[string]bool)
+		rrSets := make(map[string]fakeRecordSet)
+		for k, v := range f.rrSets {
+			rrSets[k] = v
+		}
+		invalid := false
+		for _, change := range req.Changes {
+			batch = append(batch, change.Action+" "+change.RRSet.Name+" "+strings.Join(change.RRSet.values(), ","))
+			key := change.RRSet.key()
+			if changed[key] {
+				invalid = true
+			}
+			changed[key] = true
+			if change.Action == route53.ChangeActionDelete {
+				if existing, ok := rrSets[key]; !ok || !reflect.DeepEqual(existing, change.RRSet) {
+					invalid = true
+				}
+				delete(rrSets, key)
+			} else {
+				rrSets[key] = change.RRSet
+			}
+		}
+		f.batches = append(f.batches, batch)
+		if invalid {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`<?xml version="1.0"?>
+<ErrorResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>InvalidChangeBatch</Code>
+    <Message>Invalid change batch</Message>
+  </Error>
+</ErrorResponse>`))
+			return
+		}
+		f.rrSets = rrSets
+		_, _ = w.Write([]byte(ChangeResourceRecordSetsResponse))
+	case r.URL.Path == "/2013-04-01/change/123456":
+		_, _ = w.Write([]byte(GetChangeResponse))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRoute53ApplyTXTChanges(t *testing.T) {
+	legacy := fakeRecordSet{Name: "_acme-challenge.legacy.example.com.", Type: "TXT", SetIdentifier: `"old"`, TTL: 10, Records: []fakeRecord{{`"old"`}}}
+	fake := &fakeRoute53{rrSets: map[string]fakeRecordSet{legacy.key(): legacy}}
+	ts := httptest.NewServer(fake)
+	defer ts.Close()
+
+	provider, err := makeRoute53Provider(ts)
+	require.NoError(t, err)
+	provider.hostedZoneID = "ABCDEFG"
+	provider.log = logf.Log.WithName("route53")
+
+	err = provider.ApplyTXTChanges("example.com.", []util.TXTRecordChange{
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.example.com.", Value: "one"},
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.example.com.", Value: "two"},
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.example.com.", Value: "two"},
+		{Action: util.TXTRecordPresent, FQDN: "_acme-challenge.foo.example.com.", Value: "three"},
+	})
+	require.NoError(t, err)
+	// all values of a record should be set in a single record set, and all
+	// record sets should be changed in a single batch
+	assert.Equal(t, [][]string{{
+		`UPSERT _acme-challenge.example.com. "one","two"`,
+		`UPSERT _acme-challenge.foo.example.com. "three"`,
+	}}, fake.batches)
+
+	fake.batches = nil
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "four")
+	require.NoError(t, err)
+	// values presented earlier should be kept
+	assert.Equal(t, [][]string{{
+		`UPSERT _acme-challenge.example.com. "one","two","four"`,
+	}}, fake.batches)
+
+	fake.batches = nil
+	err = provider.ApplyTXTChanges("example.com.", []util.TXTRecordChange{
+		{Action: util.TXTRecordCleanUp, FQDN: "_acme-challenge.example.com.", Value: "one"},
+		{Action: util.TXTRecordCleanUp, FQDN: "_acme-challenge.example.com.", Value: "two"},
+		{Action: util.TXTRecordCleanUp, FQDN: "_acme-challenge.foo.example.com.", Value: "three"},
+		{Action: util.TXTRecordCleanUp, FQDN: "_acme-challenge.foo.example.com.", Value: "unknown"},
+	})
+	require.NoError(t, err)
+	// record sets should be updated to remove some of their values, and
+	// deleted once they have no values left
+	assert.Equal(t, [][]string{{
+		`UPSERT _acme-challenge.example.com. "four"`,
+		`DELETE _acme-challenge.foo.example.com. "three"`,
+	}}, fake.batches)
+
+	fake.batches = nil
+	err = provider.CleanUp("example.com", "_acme-challenge.example.com.", "unknown")
+	require.NoError(t, err)
+	// records without changes shouldn't be submitted
+	assert.Empty(t, fake.batches)
+
+	fake.batches = nil
+	err = provider.Present("legacy.example.com", "_acme-challenge.legacy.example.com.", "new")
+	require.NoError(t, err)
+	// multivalue answer record sets created by previous versions should be
+	// replaced by a single record set
+	assert.Equal(t, [][]string{{
+		`DELETE _acme-challenge.legacy.example.com. "old"`,
+		`UPSERT _acme-challenge.legacy.example.com. "old","new"`,
+	}}, fake.batches)
+
+	var remaining []fakeRecordSet
+	for _, rrSet := range fake.rrSets {
+		remaining = append(remaining, rrSet)
+	}
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i].key() < remaining[j].key() })
+	assert.Equal(t, []fakeRecordSet{
+		{Name: "_acme-challenge.example.com.", Type: "TXT", TTL: 10, Records: []fakeRecord{{`"four"`}}},
+		{Name: "_acme-challenge.legacy.example.com.", Type: "TXT", TTL: 10, Records: []fakeRecord{{`"old"`}, {`"new"`}}},
+	}, remaining)
+}
+
 func TestAssumeRole(t *testing.T) {
 	creds := &sts.Credentials{
 		AccessKeyId:     aws.String("foo"),
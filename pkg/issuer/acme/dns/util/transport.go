@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// dohPrefix is the prefix of nameservers which should be queried using
+	// DNS-over-HTTPS (RFC 8484), e.g. https://1.1.1.1/dns-query
+	dohPrefix = "https://"
+	// dotPrefix is the prefix of nameservers which should be queried using
+	// DNS-over-TLS (RFC 7858), e.g. tls://1.1.1.1:853
+	dotPrefix = "tls://"
+
+	defaultDoTPort = "853"
+	dohMediaType   = "application/dns-message"
+	dohMaxResponse = 64 * 1024
+)
+
+var (
+	// dohTransport is used to make DNS-over-HTTPS requests. It can be
+	// overridden in tests.
+	dohTransport http.RoundTripper = http.DefaultTransport
+	// dotRootCAs is the set of root CAs used to verify DNS-over-TLS servers.
+	// If nil, the system roots are used. It can be overridden in tests.
+	dotRootCAs *x509.CertPool
+)
+
+// ValidateNameserver checks that server is a valid nameserver address for
+// DNS01 checks. Nameservers may be specified as a host and port to use plain
+// DNS, an https:// URL to use DNS-over-HTTPS, or a tls://host[:port] address
+// to use DNS-over-TLS.
+func ValidateNameserver(server string) error {
+	switch {
+	case strings.HasPrefix(server, dohPrefix):
+		u, err := url.Parse(server)
+		if err != nil {
+			return err
+		}
+		if u.Host == "" {
+			return fmt.Errorf("DNS-over-HTTPS URL must contain a host")
+		}
+		return nil
+	case strings.HasPrefix(server, dotPrefix):
+		host := dotAddress(server)
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			return err
+		}
+		return nil
+	default:
+		// ensure all servers have a port number
+		_, _, err := net.SplitHostPort(server)
+		return err
+	}
+}
+
+// exchange sends m to the nameserver ns, using DNS-over-HTTPS or
+// DNS-over-TLS if ns is a URL with the corresponding scheme, or plain DNS
+// otherwise.
+// Plain DNS queries are sent over UDP, retrying over TCP if the response is
+// truncated or the query times out.
+func exchange(m *dns.Msg, ns string) (*dns.Msg, error) {
+	switch {
+	case strings.HasPrefix(ns, dohPrefix):
+		return exchangeDoH(m, ns)
+	case strings.HasPrefix(ns, dotPrefix):
+		return exchangeDoT(m, ns)
+	}
+
+	udp := &dns.Client{Net: "udp", Timeout: DNSTimeout}
+	in, _, err := udp.Exchange(m, ns)
+
+	if (in != nil && in.Truncated) ||
+		(err != nil && strings.HasPrefix(err.Error(), "read udp") && strings.HasSuffix(err.Error(), "i/o timeout")) {
+		logf.V(logf.DebugLevel).Infof("UDP dns lookup failed, retrying with TCP: %v", err)
+		tcp := &dns.Client{Net: "tcp", Timeout: DNSTimeout}
+		// If the TCP request succeeds, the err will reset to nil
+		in, _, err = tcp.Exchange(m, ns)
+	}
+	return in, err
+}
+
+// exchangeDoH sends m to the DNS-over-HTTPS server at the URL u using a POST
+// request, as described in RFC 8484 section 4.1.
+func exchangeDoH(m *dns.Msg, u string) (*dns.Msg, error) {
+	// RFC 8484 recommends using an ID of 0 to maximise cache friendliness
+	q := m.Copy()
+	q.Id = 0
+	b, err := q.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	client := &http.Client{Transport: dohTransport, Timeout: DNSTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("DNS-over-HTTPS request to %s failed: %v", u, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DNS-over-HTTPS request to %s failed with status %d", u, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dohMaxResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	in := new(dns.Msg)
+	if err := in.Unpack(body); err != nil {
+		return nil, fmt.Errorf("invalid DNS-over-HTTPS response from %s: %v", u, err)
+	}
+	in.Id = m.Id
+	return in, nil
+}
+
+// exchangeDoT sends m to the DNS-over-TLS server at the address ns.
+func exchangeDoT(m *dns.Msg, ns string) (*dns.Msg, error) {
+	addr := dotAddress(ns)
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &dns.Client{
+		Net:     "tcp-tls",
+		Timeout: DNSTimeout,
+		TLSConfig: &tls.Config{
+			ServerName: host,
+			RootCAs:    dotRootCAs,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	in, _, err := client.Exchange(m, addr)
+	return in, err
+}
+
+// dotAddress returns the host:port address of a tls:// nameserver, using the
+// default DNS-over-TLS port if none is given.
+func dotAddress(ns string) string {
+	addr := strings.TrimSuffix(strings.TrimPrefix(ns, dotPrefix), "/")
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return net.JoinHostPort(strings.Trim(addr, "[]"), defaultDoTPort)
+	}
+	return addr
+}
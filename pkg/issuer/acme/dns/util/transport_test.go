@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+// testDNSHandler answers queries for a zone example.com. containing a single
+// TXT record at _acme-challenge.example.com.
+func testDNSHandler(req *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	q := req.Question[0]
+	switch {
+	case q.Name == "example.com." && q.Qtype == dns.TypeSOA:
+		rr, _ := dns.NewRR("example.com. 60 IN SOA ns.example.com. admin.example.com. 1 60 60 60 60")
+		m.Answer = append(m.Answer, rr)
+	case q.Name == "_acme-challenge.example.com." && q.Qtype == dns.TypeTXT:
+		rr, _ := dns.NewRR(`_acme-challenge.example.com. 60 IN TXT "value"`)
+		m.Answer = append(m.Answer, rr)
+	case q.Name == "example.com." || q.Name == "_acme-challenge.example.com.":
+	default:
+		m.Rcode = dns.RcodeNameError
+	}
+	return m
+}
+
+// newTestResolvers starts a DNS-over-HTTPS server and a DNS-over-TLS server
+// using testDNSHandler, and configures the DNS clients to trust them.
+func newTestResolvers(t *testing.T) (doh string, dot string) {
+	dohServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != dohMediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		req := new(dns.Msg)
+		if err := req.Unpack(b); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp, err := testDNSHandler(req).Pack()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", dohMediaType)
+		_, _ = w.Write(resp)
+	}))
+	dohServer.StartTLS()
+	t.Cleanup(dohServer.Close)
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: dohServer.TLS.Certificates})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dotServer := &dns.Server{
+		Listener: l,
+		Net:      "tcp-tls",
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			_ = w.WriteMsg(testDNSHandler(req))
+		}),
+	}
+	go func() { _ = dotServer.ActivateAndServe() }()
+	t.Cleanup(func() { _ = dotServer.Shutdown() })
+
+	roots := x509.NewCertPool()
+	roots.AddCert(dohServer.Certificate())
+	oldTransport, oldRootCAs := dohTransport, dotRootCAs
+	dohTransport, dotRootCAs = dohServer.Client().Transport, roots
+	t.Cleanup(func() { dohTransport, dotRootCAs = oldTransport, oldRootCAs })
+
+	return dohServer.URL + "/dns-query", "tls://" + l.Addr().String()
+}
+
+func TestDNSQueryEncrypted(t *testing.T) {
+	doh, dot := newTestResolvers(t)
+
+	for name, ns := range map[string]string{"DNS-over-HTTPS": doh, "DNS-over-TLS": dot} {
+		t.Run(name, func(t *testing.T) {
+			in, err := DNSQuery("_acme-challenge.example.com.", dns.TypeTXT, []string{ns}, true)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(in.Answer) != 1 {
+				t.Fatalf("expected 1 answer, got %d", len(in.Answer))
+			}
+			if txt, ok := in.Answer[0].(*dns.TXT); !ok || txt.Txt[0] != "value" {
+				t.Errorf("unexpected answer %v", in.Answer[0])
+			}
+
+			ok, err := checkAuthoritativeNss("_acme-challenge.example.com.", "value", []string{ns})
+			if err != nil || !ok {
+				t.Errorf("expected TXT record to be found, got %t: %v", ok, err)
+			}
+
+			fqdnToZoneLock.Lock()
+			delete(fqdnToZone, "_acme-challenge.foo.example.com.")
+			fqdnToZoneLock.Unlock()
+			zone, err := FindZoneByFqdn("_acme-challenge.foo.example.com.", []string{ns})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if zone != "example.com." {
+				t.Errorf("expected zone example.com., got %s", zone)
+			}
+		})
+	}
+}
+
+func TestValidateNameserver(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8:53":                       true,
+		"8.8.8.8":                          false,
+		"https://1.1.1.1/dns-query":        true,
+		"https://dns.example.com":          true,
+		"https:///dns-query":               false,
+		"tls://1.1.1.1":                    true,
+		"tls://1.1.1.1:853":                true,
+		"tls://[2606:4700:4700::1111]":     true,
+		"tls://[2606:4700:4700::1111]:853": true,
+	}
+	for ns, valid := range tests {
+		t.Run(ns, func(t *testing.T) {
+			err := ValidateNameserver(ns)
+			if valid != (err == nil) {
+				t.Errorf("expected valid=%t, got error %v", valid, err)
+			}
+		})
+	}
+}
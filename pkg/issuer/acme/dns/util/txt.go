@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+// TXTRecordAction is the kind of change to make to a DNS01 challenge TXT
+// record.
+type TXTRecordAction string
+
+const (
+	// TXTRecordPresent adds the value to the TXT record.
+	TXTRecordPresent TXTRecordAction = "Present"
+	// TXTRecordCleanUp removes the value from the TXT record.
+	TXTRecordCleanUp TXTRecordAction = "CleanUp"
+)
+
+// TXTRecordChange is a single change to a DNS01 challenge TXT record, as
+// applied in a batch by DNS providers that support batching changes within a
+// zone.
+type TXTRecordChange struct {
+	Action TXTRecordAction
+	Domain string
+	FQDN   string
+	Value  string
+}
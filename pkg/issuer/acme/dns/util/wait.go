@@ -159,6 +159,8 @@ func checkAuthoritativeNss(fqdn, value string, nameservers []string) (bool, erro
 
 // DNSQuery will query a nameserver, iterating through the supplied servers as it retries
 // The nameserver should include a port, to facilitate testing where we talk to a mock dns server.
+// Nameservers may also be https:// URLs or tls:// addresses, in which case they are
+// queried using DNS-over-HTTPS or DNS-over-TLS respectively.
 func DNSQuery(fqdn string, rtype uint16, nameservers []string, recursive bool) (in *dns.Msg, err error) {
 	m := new(dns.Msg)
 	m.SetQuestion(fqdn, rtype)
@@ -171,16 +173,7 @@ func DNSQuery(fqdn string, rtype uint16, nameservers []string, recursive bool) (
 	// Will retry the request based on the number of servers (n+1)
 	for i := 1; i <= len(nameservers)+1; i++ {
 		ns := nameservers[i%len(nameservers)]
-		udp := &dns.Client{Net: "udp", Timeout: DNSTimeout}
-		in, _, err = udp.Exchange(m, ns)
-
-		if (in != nil && in.Truncated) ||
-			(err != nil && strings.HasPrefix(err.Error(), "read udp") && strings.HasSuffix(err.Error(), "i/o timeout")) {
-			logf.V(logf.DebugLevel).Infof("UDP dns lookup failed, retrying with TCP: %v", err)
-			tcp := &dns.Client{Net: "tcp", Timeout: DNSTimeout}
-			// If the TCP request succeeds, the err will reset to nil
-			in, _, err = tcp.Exchange(m, ns)
-		}
+		in, err = exchange(m, ns)
 
 		if err == nil {
 			break
@@ -189,7 +182,23 @@ func DNSQuery(fqdn string, rtype uint16, nameservers []string, recursive bool) (
 	return
 }
 
-func ValidateCAA(domain string, issuerID []string, iswildcard bool, nameservers []string) error {
+// CAAAccount describes the ACME account and validation method that will be
+// used to issue a certificate, which are matched against the RFC 8657
+// 'accounturi' and 'validationmethods' parameters of CAA records.
+// Parameters are only checked if the corresponding field is set.
+type CAAAccount struct {
+	// URI is the URI of the ACME account, as returned by the ACME server on
+	// registration.
+	URI string
+	// ValidationMethod is the ACME challenge type that will be used to
+	// validate the domain, e.g. 'dns-01' or 'http-01'.
+	ValidationMethod string
+}
+
+// ValidateCAA checks that the CAA records for domain permit the issuer with
+// one of the given issuer domain names to issue a certificate using the
+// given account.
+func ValidateCAA(domain string, issuerID []string, iswildcard bool, nameservers []string, account CAAAccount) error {
 	// see https://tools.ietf.org/html/rfc6844#section-4
 	// for more information about how CAA lookup is performed
 	fqdn := ToFqdn(domain)
@@ -264,31 +273,88 @@ func ValidateCAA(domain string, issuerID []string, iswildcard bool, nameservers
 		}
 	}
 
-	if !matchCAA(caas, issuerSet, iswildcard) {
-		// TODO(dmo): better error message
-		return fmt.Errorf("CAA record does not match issuer")
-	}
-	return nil
+	return matchCAA(caas, issuerSet, iswildcard, account)
 }
 
-func matchCAA(caas []*dns.CAA, issuerIDs map[string]bool, iswildcard bool) bool {
-	matches := false
+// matchCAA returns nil if any of the relevant CAA records permit issuance by
+// one of the issuer IDs using the given account, or an error describing why
+// issuance is not permitted otherwise.
+func matchCAA(caas []*dns.CAA, issuerIDs map[string]bool, iswildcard bool, account CAAAccount) error {
+	tag := issueTag
+	if iswildcard {
+		// if we require a wildcard certificate, we must prioritize any
+		// issuewild tags - only if one of them matches (regardless of any
+		// other entries) can we issue a wildcard certificate.
+		// If there are no issuewild tags, the issue tags apply to wildcard
+		// certificates too.
+		for _, caa := range caas {
+			if strings.EqualFold(caa.Tag, issuewildTag) {
+				tag = issuewildTag
+				break
+			}
+		}
+	}
+
+	// the reason the last record for a matching issuer was rejected, which
+	// is more useful to report than a generic mismatch
+	var paramErr error
 	for _, caa := range caas {
-		// if we require a wildcard certificate, we must prioritize any issuewild
-		// tags - only if it matches (regardless of any other entries) can we
-		// issue a wildcard certificate
-		if iswildcard && caa.Tag == issuewildTag {
-			return issuerIDs[caa.Value]
+		if !strings.EqualFold(caa.Tag, tag) {
+			continue
+		}
+
+		issuer, params := parseCAAValue(caa.Value)
+		if !issuerIDs[issuer] {
+			continue
+		}
+
+		// see https://www.rfc-editor.org/rfc/rfc8657 for the definition of
+		// the accounturi and validationmethods parameters
+		if uri, ok := params["accounturi"]; ok && account.URI != "" && uri != account.URI {
+			paramErr = fmt.Errorf("CAA record %q only permits issuance using ACME account %q, but the issuer is registered with account %q", caa.Value, uri, account.URI)
+			continue
+		}
+		if methods, ok := params["validationmethods"]; ok && account.ValidationMethod != "" && !containsValidationMethod(methods, account.ValidationMethod) {
+			paramErr = fmt.Errorf("CAA record %q only permits validation methods %q, but the challenge uses %q", caa.Value, methods, account.ValidationMethod)
+			continue
 		}
 
-		// issue tags allow any certificate, we perform a check which will only
-		// be returned if we do not need a wildcard certificate, or if we need
-		// a wildcard certificate and no issuewild entries are present
-		if caa.Tag == issueTag {
-			matches = matches || issuerIDs[caa.Value]
+		return nil
+	}
+
+	if paramErr != nil {
+		return paramErr
+	}
+	return fmt.Errorf("CAA record does not match issuer")
+}
+
+// parseCAAValue parses the value of an issue or issuewild CAA record into
+// the issuer domain name and its parameters, as defined in RFC 8659 section
+// 4.2. Parameter tags are lower-cased.
+func parseCAAValue(value string) (string, map[string]string) {
+	parts := strings.Split(value, ";")
+	issuer := strings.TrimSpace(parts[0])
+
+	params := make(map[string]string)
+	for _, p := range parts[1:] {
+		k, v, ok := strings.Cut(p, "=")
+		if !ok {
+			continue
 		}
+		params[strings.ToLower(strings.TrimSpace(k))] = strings.TrimSpace(v)
 	}
-	return matches
+	return issuer, params
+}
+
+// containsValidationMethod returns true if the comma-separated list of
+// validation methods contains method.
+func containsValidationMethod(methods, method string) bool {
+	for _, m := range strings.Split(methods, ",") {
+		if strings.EqualFold(strings.TrimSpace(m), method) {
+			return true
+		}
+	}
+	return false
 }
 
 // lookupNameservers returns the authoritative nameservers for the given fqdn.
@@ -94,6 +94,7 @@ func TestMatchCAA(t *testing.T) {
 		caas       []*dns.CAA
 		issuerIDs  map[string]bool
 		isWildcard bool
+		account    CAAAccount
 		matches    bool
 	}{
 		"matches with a single 'issue' caa for a non-wildcard domain": {
@@ -153,13 +154,61 @@ func TestMatchCAA(t *testing.T) {
 			isWildcard: true,
 			matches:    false,
 		},
+		"matches with a wildcard name if one of several issuewild tags matches": {
+			caas: []*dns.CAA{
+				{Tag: issuewildTag, Value: "not-example-ca"},
+				{Tag: issuewildTag, Value: "example-ca"},
+			},
+			issuerIDs:  map[string]bool{"example-ca": true},
+			isWildcard: true,
+			matches:    true,
+		},
+		"matches with parameters that do not restrict issuance": {
+			caas:      []*dns.CAA{{Tag: issueTag, Value: "example-ca; foo=bar"}},
+			issuerIDs: map[string]bool{"example-ca": true},
+			account:   CAAAccount{URI: "https://example-ca/acct/1", ValidationMethod: "dns-01"},
+			matches:   true,
+		},
+		"matches if accounturi matches the account": {
+			caas:      []*dns.CAA{{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/1"}},
+			issuerIDs: map[string]bool{"example-ca": true},
+			account:   CAAAccount{URI: "https://example-ca/acct/1"},
+			matches:   true,
+		},
+		"does not match if accounturi does not match the account": {
+			caas:      []*dns.CAA{{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/2"}},
+			issuerIDs: map[string]bool{"example-ca": true},
+			account:   CAAAccount{URI: "https://example-ca/acct/1"},
+			matches:   false,
+		},
+		"matches if validationmethods contains the challenge type": {
+			caas:      []*dns.CAA{{Tag: issueTag, Value: "example-ca;validationmethods=http-01,dns-01"}},
+			issuerIDs: map[string]bool{"example-ca": true},
+			account:   CAAAccount{ValidationMethod: "dns-01"},
+			matches:   true,
+		},
+		"does not match if validationmethods does not contain the challenge type": {
+			caas:      []*dns.CAA{{Tag: issueTag, Value: "example-ca; validationmethods=http-01"}},
+			issuerIDs: map[string]bool{"example-ca": true},
+			account:   CAAAccount{ValidationMethod: "dns-01"},
+			matches:   false,
+		},
+		"matches if one of several records permits the account": {
+			caas: []*dns.CAA{
+				{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/2"},
+				{Tag: issueTag, Value: "example-ca; accounturi=https://example-ca/acct/1; validationmethods=dns-01"},
+			},
+			issuerIDs: map[string]bool{"example-ca": true},
+			account:   CAAAccount{URI: "https://example-ca/acct/1", ValidationMethod: "dns-01"},
+			matches:   true,
+		},
 	}
 
 	for n, test := range tests {
 		t.Run(n, func(t *testing.T) {
-			m := matchCAA(test.caas, test.issuerIDs, test.isWildcard)
-			if test.matches != m {
-				t.Errorf("expected match to equal %t but got %t", test.matches, m)
+			err := matchCAA(test.caas, test.issuerIDs, test.isWildcard, test.account)
+			if test.matches != (err == nil) {
+				t.Errorf("expected match to equal %t but got error %v", test.matches, err)
 			}
 		})
 	}
@@ -262,25 +311,25 @@ func TestValidateCAA(t *testing.T) {
 	// google installs a CAA record at google.com
 	// ask for the www.google.com record to test that
 	// we recurse up the labels
-	err := ValidateCAA("www.google.com", []string{"letsencrypt", "pki.goog"}, false, RecursiveNameservers)
+	err := ValidateCAA("www.google.com", []string{"letsencrypt", "pki.goog"}, false, RecursiveNameservers, CAAAccount{})
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
 	// now ask, expecting a CA that won't match
-	err = ValidateCAA("www.google.com", []string{"daniel.homebrew.ca"}, false, RecursiveNameservers)
+	err = ValidateCAA("www.google.com", []string{"daniel.homebrew.ca"}, false, RecursiveNameservers, CAAAccount{})
 	if err == nil {
 		t.Fatalf("expected err, got success")
 	}
 	// if the CAA record allows non-wildcards then it has an `issue` tag,
 	// and it is known that it has no issuewild tags, then wildcard certificates
 	// will also be allowed
-	err = ValidateCAA("www.google.com", []string{"pki.goog"}, true, RecursiveNameservers)
+	err = ValidateCAA("www.google.com", []string{"pki.goog"}, true, RecursiveNameservers, CAAAccount{})
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
 	// ask for a domain you know does not have CAA records.
 	// it should succeed
-	err = ValidateCAA("www.example.org", []string{"daniel.homebrew.ca"}, false, RecursiveNameservers)
+	err = ValidateCAA("www.example.org", []string{"daniel.homebrew.ca"}, false, RecursiveNameservers, CAAAccount{})
 	if err != nil {
 		t.Fatalf("expected err, got %s", err)
 	}
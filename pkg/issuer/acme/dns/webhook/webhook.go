@@ -18,24 +18,63 @@ package webhook
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
+	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/rest"
 
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
 	"github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/scheme"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+// grpcTimeout is the maximum time to wait for a gRPC webhook to respond.
+const grpcTimeout = 2 * time.Minute
+
+// grpcTarget returns the address of a gRPC webhook served by the given
+// Service. It can be overridden in tests.
+var grpcTarget = func(serviceName, namespace string, port int32) string {
+	return net.JoinHostPort(grpcServerName(serviceName, namespace), strconv.Itoa(int(port)))
+}
+
 type Webhook struct {
 	restConfigShallowCopy rest.Config
+	secretLister          internalinformers.SecretLister
+}
+
+type Option func(*Webhook)
+
+// WithSecretsLister sets the lister used to read the TLS Secrets of webhooks
+// served over gRPC.
+func WithSecretsLister(secretLister internalinformers.SecretLister) Option {
+	return func(r *Webhook) {
+		r.secretLister = secretLister
+	}
+}
+
+func New(opts ...Option) *Webhook {
+	r := &Webhook{}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
 }
 
 func (r *Webhook) Name() string {
@@ -44,12 +83,22 @@ func (r *Webhook) Name() string {
 
 // Present creates a TXT record using the specified parameters
 func (r *Webhook) Present(ch *v1alpha1.ChallengeRequest) error {
-	cl, pl, solverName, err := r.buildPayload(ch, v1alpha1.ChallengeActionPresent)
+	cfg, pl, err := r.buildPayload(ch, v1alpha1.ChallengeActionPresent)
 	if err != nil {
 		return err
 	}
 
-	result := cl.Post().Resource(solverName).Body(pl).Do(context.TODO())
+	if cfg.GRPC != nil {
+		return r.solveGRPC(cfg, pl.Request)
+	}
+
+	// obtain a REST client that can be used to communicate with the webhook
+	cl, err := r.restClientForGroup(cfg.GroupName)
+	if err != nil {
+		return err
+	}
+
+	result := cl.Post().Resource(cfg.SolverName).Body(pl).Do(context.TODO())
 	// we will check this error after parsing the response
 	resErr := result.Error()
 
@@ -80,12 +129,22 @@ func (r *Webhook) Present(ch *v1alpha1.ChallengeRequest) error {
 
 // CleanUp removes the TXT record matching the specified parameters
 func (r *Webhook) CleanUp(ch *v1alpha1.ChallengeRequest) error {
-	cl, pl, solverName, err := r.buildPayload(ch, v1alpha1.ChallengeActionCleanUp)
+	cfg, pl, err := r.buildPayload(ch, v1alpha1.ChallengeActionCleanUp)
+	if err != nil {
+		return err
+	}
+
+	if cfg.GRPC != nil {
+		return r.solveGRPC(cfg, pl.Request)
+	}
+
+	// obtain a REST client that can be used to communicate with the webhook
+	cl, err := r.restClientForGroup(cfg.GroupName)
 	if err != nil {
 		return err
 	}
 
-	result := cl.Post().Resource(solverName).Body(pl).Do(context.TODO())
+	result := cl.Post().Resource(cfg.SolverName).Body(pl).Do(context.TODO())
 	// we will check this error after parsing the response
 	resErr := result.Error()
 
@@ -130,20 +189,14 @@ func (r *Webhook) Initialize(kubeClientConfig *rest.Config, stopCh <-chan struct
 	return nil
 }
 
-func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.ChallengeAction) (*rest.RESTClient, *v1alpha1.ChallengePayload, string, error) {
+func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.ChallengeAction) (*cmacme.ACMEIssuerDNS01ProviderWebhook, *v1alpha1.ChallengePayload, error) {
 	// create a copy just to be certain we don't modify something unexpectedly
 	req := ch.DeepCopy()
 
 	// extract the complete solver config, including groupName and solverName
 	cfg, err := loadConfig(*req.Config)
 	if err != nil {
-		return nil, nil, "", err
-	}
-
-	// obtain a REST client that can be used to communicate with the webhook
-	cl, err := r.restClientForGroup(cfg.GroupName)
-	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, err
 	}
 
 	// build the ChallengePayload resource
@@ -157,7 +210,84 @@ func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.Ch
 	// only the 'config' field and submit that to the webhook.
 	pl.Request.Config = cfg.Config
 
-	return cl, pl, cfg.SolverName, nil
+	return cfg, pl, nil
+}
+
+// solveGRPC sends the request to a webhook served over gRPC.
+func (r *Webhook) solveGRPC(cfg *cmacme.ACMEIssuerDNS01ProviderWebhook, req *v1alpha1.ChallengeRequest) error {
+	tlsConfig, err := r.grpcTLSConfig(cfg.GRPC, req.ResourceNamespace)
+	if err != nil {
+		return err
+	}
+
+	target := grpcTarget(cfg.GRPC.ServiceName, req.ResourceNamespace, cfg.GRPC.Port)
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return fmt.Errorf("error connecting to gRPC webhook %q: %v", target, err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), grpcTimeout)
+	defer cancel()
+
+	resp, err := webhook.NewGRPCClient(conn).Solve(ctx, &webhook.GRPCRequest{
+		SolverName: cfg.SolverName,
+		Request:    req,
+	})
+	if err != nil {
+		return fmt.Errorf("error calling gRPC webhook %q: %v", target, err)
+	}
+
+	if resp.Success {
+		logf.Log.V(logf.DebugLevel).Info("gRPC webhook call succeeded", "action", req.Action)
+		return nil
+	}
+
+	if resp.Result == nil {
+		return fmt.Errorf("invalid payload response, did not succeed but no result provided")
+	}
+
+	if resp.Result.Message != "" {
+		return errors.New(resp.Result.Message)
+	}
+
+	return fmt.Errorf("gRPC webhook call failed with status %q", resp.Result.Status)
+}
+
+// grpcTLSConfig builds the client TLS configuration for a gRPC webhook from
+// the referenced TLS Secret.
+func (r *Webhook) grpcTLSConfig(cfg *cmacme.ACMEIssuerDNS01ProviderWebhookGRPC, namespace string) (*tls.Config, error) {
+	if r.secretLister == nil {
+		return nil, fmt.Errorf("gRPC webhooks are not supported: no secrets lister configured")
+	}
+
+	secret, err := r.secretLister.Secrets(namespace).Get(cfg.TLSSecretRef.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting gRPC webhook TLS secret: %v", err)
+	}
+
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("error loading client certificate from secret %s/%s: %v", namespace, cfg.TLSSecretRef.Name, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(secret.Data[cmmeta.TLSCAKey]) {
+		return nil, fmt.Errorf("no CA certificates found in key %q of secret %s/%s", cmmeta.TLSCAKey, namespace, cfg.TLSSecretRef.Name)
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   grpcServerName(cfg.ServiceName, namespace),
+	}, nil
+}
+
+// grpcServerName returns the DNS name the serving certificate of a gRPC
+// webhook must be valid for.
+func grpcServerName(serviceName, namespace string) string {
+	return fmt.Sprintf("%s.%s.svc", serviceName, namespace)
 }
 
 func loadConfig(cfgJSON apiextensionsv1.JSON) (*cmacme.ACMEIssuerDNS01ProviderWebhook, error) {
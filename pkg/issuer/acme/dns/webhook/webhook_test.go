@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+type fakeSolver struct {
+	presented []string
+	err       error
+}
+
+func (f *fakeSolver) Name() string { return "fake" }
+
+func (f *fakeSolver) Present(ch *v1alpha1.ChallengeRequest) error {
+	f.presented = append(f.presented, ch.Key)
+	return f.err
+}
+
+func (f *fakeSolver) CleanUp(ch *v1alpha1.ChallengeRequest) error { return f.err }
+
+func (f *fakeSolver) Initialize(*rest.Config, <-chan struct{}) error { return nil }
+
+// writePEM PEM encodes the DER encoded data and writes it to a file in dir.
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) []byte {
+	b := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, name), b, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// newKeyPair issues a certificate signed by the given CA, or a self-signed
+// CA certificate if ca is nil, returning the certificate and PEM encoded
+// certificate and key.
+func newKeyPair(t *testing.T, dir, name string, template *x509.Certificate, ca *x509.Certificate, caKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, []byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if ca == nil {
+		ca, caKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key, writePEM(t, dir, name+".crt", "CERTIFICATE", der), writePEM(t, dir, name+".key", "EC PRIVATE KEY", keyDER)
+}
+
+func TestGRPCWebhook(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey, caPEM, _ := newKeyPair(t, dir, "ca", &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	newKeyPair(t, dir, "server", &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "server"},
+		DNSNames:    []string{"solver.sandbox.svc"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+	_, _, clientCertPEM, clientKeyPEM := newKeyPair(t, dir, "client", &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "client"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	solver := &fakeSolver{}
+	server := grpc.NewServer(grpc.Creds(webhook.NewGRPCServerCredentials(
+		filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt"))))
+	webhook.RegisterGRPCSolvers(server, solver)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = server.Serve(l) }()
+	defer server.Stop()
+
+	oldTarget := grpcTarget
+	grpcTarget = func(string, string, int32) string { return l.Addr().String() }
+	defer func() { grpcTarget = oldTarget }()
+
+	r := New(WithSecretsLister(testlisters.NewFakeSecretLister(
+		testlisters.SetFakeSecretNamespaceListerGet(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "solver-client", Namespace: "sandbox"},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       clientCertPEM,
+				corev1.TLSPrivateKeyKey: clientKeyPEM,
+				cmmeta.TLSCAKey:         caPEM,
+			},
+		}, nil),
+	)))
+
+	newRequest := func(solverName string) *v1alpha1.ChallengeRequest {
+		cfg, err := json.Marshal(cmacme.ACMEIssuerDNS01ProviderWebhook{
+			SolverName: solverName,
+			GRPC: &cmacme.ACMEIssuerDNS01ProviderWebhookGRPC{
+				ServiceName:  "solver",
+				Port:         443,
+				TLSSecretRef: cmmeta.LocalObjectReference{Name: "solver-client"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &v1alpha1.ChallengeRequest{
+			Key:               "key",
+			ResourceNamespace: "sandbox",
+			Config:            &apiextensionsv1.JSON{Raw: cfg},
+		}
+	}
+
+	if err := r.Present(newRequest("fake")); err != nil {
+		t.Fatalf("unexpected error presenting challenge: %v", err)
+	}
+	if len(solver.presented) != 1 || solver.presented[0] != "key" {
+		t.Errorf("expected challenge to be presented once, got %v", solver.presented)
+	}
+
+	solver.err = errors.New("solver failed")
+	if err := r.CleanUp(newRequest("fake")); err == nil || err.Error() != "solver failed" {
+		t.Errorf("expected solver error to be returned, got %v", err)
+	}
+
+	if err := r.Present(newRequest("unknown")); err == nil {
+		t.Errorf("expected error calling unknown solver")
+	}
+}
@@ -108,6 +108,10 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	log := logf.FromContext(ctx).WithName(loggerName)
 	ctx = logf.NewContext(ctx, log)
 
+	if ch.Spec.Solver.HTTP01 != nil && ch.Spec.Solver.HTTP01.SharedSolver != nil {
+		return s.presentSharedSolver(ctx, ch)
+	}
+
 	podErr := s.ensurePod(ctx, ch)
 	svcName, svcErr := s.ensureService(ctx, ch)
 	if svcErr != nil {
@@ -135,6 +139,35 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	)
 }
 
+// presentSharedSolver realises the routes required to direct the HTTP01
+// challenge to a shared acmesolver. No Pod or Service is created, as the
+// shared acmesolver discovers the challenge token by watching Challenges.
+func (s *Solver) presentSharedSolver(ctx context.Context, ch *cmacme.Challenge) error {
+	shared := ch.Spec.Solver.HTTP01.SharedSolver
+	if shared.CatchAllRoute {
+		logf.FromContext(ctx).V(logf.DebugLevel).Info("shared HTTP01 solver uses a catch-all route, no resources need to be created")
+		return nil
+	}
+	if ch.Spec.Solver.HTTP01.Ingress != nil {
+		_, err := s.ensureIngress(ctx, ch, shared.ServiceName)
+		return err
+	}
+	if ch.Spec.Solver.HTTP01.GatewayHTTPRoute != nil {
+		_, err := s.ensureGatewayHTTPRoute(ctx, ch, shared.ServiceName)
+		return err
+	}
+	return fmt.Errorf("couldn't Present challenge %s/%s: no Ingress nor Gateway HTTP01 solvers were specified for the shared solver", ch.Namespace, ch.Name)
+}
+
+// solverServicePort returns the Service port that challenge requests should be
+// routed to for the given Challenge.
+func solverServicePort(ch *cmacme.Challenge) int32 {
+	if ch.Spec.Solver.HTTP01 != nil && ch.Spec.Solver.HTTP01.SharedSolver != nil && ch.Spec.Solver.HTTP01.SharedSolver.ServicePort != 0 {
+		return ch.Spec.Solver.HTTP01.SharedSolver.ServicePort
+	}
+	return acmeSolverListenPort
+}
+
 func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	log := logf.FromContext(ctx, loggerName, "selfCheck")
 	ctx = logf.NewContext(ctx, log)
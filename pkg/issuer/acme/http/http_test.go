@@ -26,10 +26,14 @@ import (
 	"testing"
 
 	"github.com/miekg/dns"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	coretesting "k8s.io/client-go/testing"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 )
 
 // countReachabilityTestCalls is a wrapper function that allows us to count the number
@@ -190,3 +194,75 @@ func TestReachabilityCustomDnsServers(t *testing.T) {
 		}
 	}
 }
+
+func TestPresentSharedSolver(t *testing.T) {
+	chal := &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-challenge",
+			Namespace: "foo",
+		},
+		Spec: cmacme.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Key:     "key",
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+						ServiceName: "acmesolver",
+						ServicePort: 8080,
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		chal    *cmacme.Challenge
+		builder *testpkg.Builder
+	}{
+		"should not create any resources if a catch-all route is used": {
+			chal: func(chal *cmacme.Challenge) *cmacme.Challenge {
+				chal.Spec.Solver.HTTP01.SharedSolver = &cmacme.ACMEChallengeSolverHTTP01SharedSolver{CatchAllRoute: true}
+				return chal
+			}(chal.DeepCopy()),
+			builder: &testpkg.Builder{},
+		},
+		"should only create an ingress routing to the shared solver service": {
+			chal: func(chal *cmacme.Challenge) *cmacme.Challenge {
+				chal.Spec.Solver.HTTP01.Ingress = &cmacme.ACMEChallengeSolverHTTP01Ingress{}
+				return chal
+			}(chal.DeepCopy()),
+			builder: &testpkg.Builder{
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewCustomMatch(coretesting.NewCreateAction(networkingv1.SchemeGroupVersion.WithResource("ingresses"), "foo", nil),
+						func(exp, actual coretesting.Action) error {
+							ing := actual.(coretesting.CreateAction).GetObject().(*networkingv1.Ingress)
+							backend := ing.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+							if backend.Name != "acmesolver" || backend.Port.Number != 8080 {
+								return fmt.Errorf("expected ingress backend to be acmesolver:8080, got %s:%d", backend.Name, backend.Port.Number)
+							}
+							return nil
+						}),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.T = t
+			test.builder.InitWithRESTConfig()
+			s, err := NewSolver(test.builder.Context)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.builder.Start()
+			defer test.builder.Stop()
+
+			if err := s.Present(context.Background(), nil, test.chal); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.builder.CheckAndFinish()
+		})
+	}
+}
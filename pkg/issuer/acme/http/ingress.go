@@ -168,7 +168,7 @@ func buildIngressResource(ch *cmacme.Challenge, svcName string) (*networkingv1.I
 		ingressClassName = http01IngressCfg.IngressClassName
 	}
 
-	ingPathToAdd := ingressPath(ch.Spec.Token, svcName)
+	ingPathToAdd := ingressPath(ch.Spec.Token, svcName, solverServicePort(ch))
 
 	httpHost := ch.Spec.DNSName
 	// if we need to verify ownership of an IP the challenge should propagate on all hosts
@@ -243,7 +243,7 @@ func (s *Solver) addChallengePathToIngress(ctx context.Context, ch *cmacme.Chall
 		return nil, err
 	}
 
-	ingPathToAdd := ingressPath(ch.Spec.Token, svcName)
+	ingPathToAdd := ingressPath(ch.Spec.Token, svcName, solverServicePort(ch))
 	// check for an existing Rule for the given domain on the ingress resource
 	for _, rule := range ing.Spec.Rules {
 		if rule.Host == ch.Spec.DNSName {
@@ -375,7 +375,7 @@ func (s *Solver) cleanupIngresses(ctx context.Context, ch *cmacme.Challenge) err
 
 // ingressPath returns the ingress HTTPIngressPath object needed to solve this
 // challenge.
-func ingressPath(token, serviceName string) networkingv1.HTTPIngressPath {
+func ingressPath(token, serviceName string, servicePort int32) networkingv1.HTTPIngressPath {
 	return networkingv1.HTTPIngressPath{
 		Path:     solverPathFn(token),
 		PathType: func() *networkingv1.PathType { s := networkingv1.PathTypeImplementationSpecific; return &s }(),
@@ -383,7 +383,7 @@ func ingressPath(token, serviceName string) networkingv1.HTTPIngressPath {
 			Service: &networkingv1.IngressServiceBackend{
 				Name: serviceName,
 				Port: networkingv1.ServiceBackendPort{
-					Number: acmeSolverListenPort,
+					Number: servicePort,
 				},
 			},
 		},
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+// SharedHTTP01Solver is a long-running HTTP01 solver that responds to
+// requests for every pending challenge it knows about, rather than for a
+// single token.
+// Challenges are discovered by registering the handler returned by
+// ChallengeEventHandler with a Challenge informer.
+type SharedHTTP01Solver struct {
+	ListenPort int
+
+	// IssuerName and IssuerKind optionally restrict the challenges served to
+	// those created for a single issuer.
+	IssuerName string
+	IssuerKind string
+
+	lock sync.RWMutex
+	// keys maps a challenge's domain and token to the key that should be
+	// returned.
+	keys map[challengeID]string
+
+	http.Server
+}
+
+type challengeID struct {
+	domain string
+	token  string
+}
+
+// SetChallenge registers the key to respond with for the given domain and
+// token.
+func (h *SharedHTTP01Solver) SetChallenge(domain, token, key string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.keys == nil {
+		h.keys = make(map[challengeID]string)
+	}
+	h.keys[challengeID{domain: domain, token: token}] = key
+}
+
+// RemoveChallenge stops responding to requests for the given domain and
+// token.
+func (h *SharedHTTP01Solver) RemoveChallenge(domain, token string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	delete(h.keys, challengeID{domain: domain, token: token})
+}
+
+func (h *SharedHTTP01Solver) keyFor(domain, token string) (string, bool) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	key, ok := h.keys[challengeID{domain: domain, token: token}]
+	return key, ok
+}
+
+// shouldServe returns true if the given Challenge is a pending HTTP01
+// challenge that should be solved by this shared solver.
+func (h *SharedHTTP01Solver) shouldServe(ch *cmacme.Challenge) bool {
+	if ch.Spec.Type != cmacme.ACMEChallengeTypeHTTP01 {
+		return false
+	}
+	if ch.Spec.Solver.HTTP01 == nil || ch.Spec.Solver.HTTP01.SharedSolver == nil {
+		return false
+	}
+	if h.IssuerName != "" && ch.Spec.IssuerRef.Name != h.IssuerName {
+		return false
+	}
+	if h.IssuerKind != "" && ch.Spec.IssuerRef.Kind != h.IssuerKind {
+		return false
+	}
+	if ch.DeletionTimestamp != nil {
+		return false
+	}
+	return !acme.IsFinalState(ch.Status.State)
+}
+
+// ChallengeEventHandler returns an event handler that keeps the set of
+// challenges served by this solver in sync with Challenge resources.
+func (h *SharedHTTP01Solver) ChallengeEventHandler() cache.ResourceEventHandler {
+	update := func(obj interface{}) {
+		ch, ok := obj.(*cmacme.Challenge)
+		if !ok {
+			return
+		}
+		if h.shouldServe(ch) {
+			h.SetChallenge(ch.Spec.DNSName, ch.Spec.Token, ch.Spec.Key)
+			return
+		}
+		h.RemoveChallenge(ch.Spec.DNSName, ch.Spec.Token)
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: update,
+		UpdateFunc: func(_, newObj interface{}) {
+			update(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			ch, ok := obj.(*cmacme.Challenge)
+			if !ok {
+				return
+			}
+			h.RemoveChallenge(ch.Spec.DNSName, ch.Spec.Token)
+		},
+	}
+}
+
+func (h *SharedHTTP01Solver) Listen(log logr.Logger) error {
+	log.Info("starting shared listener",
+		"issuer_name", h.IssuerName,
+		"issuer_kind", h.IssuerKind,
+		"listen_port", h.ListenPort,
+	)
+
+	h.Server = http.Server{
+		Addr:    fmt.Sprintf(":%d", h.ListenPort),
+		Handler: h.handler(log),
+	}
+
+	return h.Server.ListenAndServe()
+}
+
+func (h *SharedHTTP01Solver) handler(log logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := strings.Split(r.Host, ":")[0]
+		basePath := path.Dir(r.URL.EscapedPath())
+		token := path.Base(r.URL.EscapedPath())
+
+		log := log.WithValues(
+			"host", host,
+			"path", r.URL.EscapedPath(),
+			"base_path", basePath,
+			"token", token,
+		)
+		if r.URL.EscapedPath() == "/" || r.URL.EscapedPath() == "/healthz" {
+			w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		log.Info("validating request")
+		if basePath != HTTPChallengePath {
+			log.Info("invalid base_path", "expected_base_path", HTTPChallengePath)
+			http.NotFound(w, r)
+			return
+		}
+
+		key, ok := h.keyFor(host, token)
+		if !ok {
+			log.Info("no pending challenge found for host and token")
+			http.NotFound(w, r)
+			return
+		}
+
+		log.Info("got successful challenge request, writing key")
+		w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, key)
+	})
+}
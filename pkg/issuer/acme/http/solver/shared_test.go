@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+func sharedChallenge(domain, token, key string, mods ...func(*cmacme.Challenge)) *cmacme.Challenge {
+	ch := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeHTTP01,
+			DNSName: domain,
+			Token:   token,
+			Key:     key,
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{CatchAllRoute: true},
+				},
+			},
+			IssuerRef: cmmeta.ObjectReference{Name: "issuer", Kind: "Issuer"},
+		},
+		Status: cmacme.ChallengeStatus{State: cmacme.Pending},
+	}
+	for _, mod := range mods {
+		mod(ch)
+	}
+	return ch
+}
+
+func TestSharedHTTP01Solver(t *testing.T) {
+	tests := map[string]struct {
+		issuerName string
+		challenge  *cmacme.Challenge
+		host       string
+		path       string
+		expStatus  int
+		expBody    string
+	}{
+		"responds with the key for a pending challenge": {
+			challenge: sharedChallenge("example.com", "token", "key"),
+			host:      "example.com",
+			path:      "/.well-known/acme-challenge/token",
+			expStatus: http.StatusOK,
+			expBody:   "key",
+		},
+		"responds for a request that includes a port": {
+			challenge: sharedChallenge("example.com", "token", "key"),
+			host:      "example.com:80",
+			path:      "/.well-known/acme-challenge/token",
+			expStatus: http.StatusOK,
+			expBody:   "key",
+		},
+		"does not respond for a different host": {
+			challenge: sharedChallenge("example.com", "token", "key"),
+			host:      "foo.example.com",
+			path:      "/.well-known/acme-challenge/token",
+			expStatus: http.StatusNotFound,
+		},
+		"does not respond for a different token": {
+			challenge: sharedChallenge("example.com", "token", "key"),
+			host:      "example.com",
+			path:      "/.well-known/acme-challenge/other",
+			expStatus: http.StatusNotFound,
+		},
+		"does not respond outside the challenge path": {
+			challenge: sharedChallenge("example.com", "token", "key"),
+			host:      "example.com",
+			path:      "/token",
+			expStatus: http.StatusNotFound,
+		},
+		"does not respond for a challenge in a final state": {
+			challenge: sharedChallenge("example.com", "token", "key", func(ch *cmacme.Challenge) {
+				ch.Status.State = cmacme.Valid
+			}),
+			host:      "example.com",
+			path:      "/.well-known/acme-challenge/token",
+			expStatus: http.StatusNotFound,
+		},
+		"does not respond for a challenge that does not use a shared solver": {
+			challenge: sharedChallenge("example.com", "token", "key", func(ch *cmacme.Challenge) {
+				ch.Spec.Solver.HTTP01.SharedSolver = nil
+			}),
+			host:      "example.com",
+			path:      "/.well-known/acme-challenge/token",
+			expStatus: http.StatusNotFound,
+		},
+		"does not respond for a challenge of a different issuer": {
+			issuerName: "other-issuer",
+			challenge:  sharedChallenge("example.com", "token", "key"),
+			host:       "example.com",
+			path:       "/.well-known/acme-challenge/token",
+			expStatus:  http.StatusNotFound,
+		},
+		"responds to health checks": {
+			challenge: sharedChallenge("example.com", "token", "key"),
+			host:      "example.com",
+			path:      "/healthz",
+			expStatus: http.StatusOK,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &SharedHTTP01Solver{IssuerName: test.issuerName}
+			s.ChallengeEventHandler().OnAdd(test.challenge)
+
+			req := httptest.NewRequest(http.MethodGet, test.path, nil)
+			req.Host = test.host
+			rec := httptest.NewRecorder()
+			s.handler(logr.Discard()).ServeHTTP(rec, req)
+
+			if rec.Code != test.expStatus {
+				t.Errorf("expected status %d but got %d", test.expStatus, rec.Code)
+			}
+			if test.expBody != "" {
+				body, _ := io.ReadAll(rec.Body)
+				if string(body) != test.expBody {
+					t.Errorf("expected body %q but got %q", test.expBody, string(body))
+				}
+			}
+		})
+	}
+}
+
+func TestSharedHTTP01SolverChallengeRemoved(t *testing.T) {
+	s := &SharedHTTP01Solver{}
+	h := s.ChallengeEventHandler()
+	ch := sharedChallenge("example.com", "token", "key")
+
+	h.OnAdd(ch)
+	if _, ok := s.keyFor("example.com", "token"); !ok {
+		t.Fatalf("expected challenge to be served after it was added")
+	}
+
+	valid := ch.DeepCopy()
+	valid.Status.State = cmacme.Valid
+	h.OnUpdate(ch, valid)
+	if _, ok := s.keyFor("example.com", "token"); ok {
+		t.Errorf("expected challenge not to be served after it reached a final state")
+	}
+
+	h.OnAdd(ch)
+	h.OnDelete(ch)
+	if _, ok := s.keyFor("example.com", "token"); ok {
+		t.Errorf("expected challenge not to be served after it was deleted")
+	}
+}
@@ -17,13 +17,23 @@ limitations under the License.
 package issuer
 
 import (
+	"errors"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/labels"
+	gwlisters "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
+
+	"github.com/cert-manager/cert-manager/internal/referencegrant"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 )
 
+// ErrReferenceNotPermitted is returned when an issuerRef refers to an Issuer
+// in another namespace, and no ReferenceGrant in that namespace permits the
+// reference.
+var ErrReferenceNotPermitted = errors.New("cross-namespace issuer reference is not permitted")
+
 // Helper is an interface that defines a method that returns an issuer for the given
 // IssuerRef and namespace.
 type Helper interface {
@@ -36,16 +46,24 @@ type Helper interface {
 type helperImpl struct {
 	issuerLister        cmlisters.IssuerLister
 	clusterIssuerLister cmlisters.ClusterIssuerLister
+
+	// referenceGrantLister is used to check whether references to Issuers
+	// in other namespaces are permitted. If nil, all cross-namespace
+	// references are denied.
+	referenceGrantLister gwlisters.ReferenceGrantLister
 }
 
 var _ Helper = &helperImpl{}
 
 // NewHelper will construct a new instance of a Helper using values supplied on
 // the provided controller context.
-func NewHelper(issuerLister cmlisters.IssuerLister, clusterIssuerLister cmlisters.ClusterIssuerLister) Helper {
+// The referenceGrantLister may be nil if Gateway API support is disabled, in
+// which case IssuerRefs that refer to Issuers in other namespaces are denied.
+func NewHelper(issuerLister cmlisters.IssuerLister, clusterIssuerLister cmlisters.ClusterIssuerLister, referenceGrantLister gwlisters.ReferenceGrantLister) Helper {
 	return &helperImpl{
-		issuerLister:        issuerLister,
-		clusterIssuerLister: clusterIssuerLister,
+		issuerLister:         issuerLister,
+		clusterIssuerLister:  clusterIssuerLister,
+		referenceGrantLister: referenceGrantLister,
 	}
 }
 
@@ -54,9 +72,19 @@ func NewHelper(issuerLister cmlisters.IssuerLister, clusterIssuerLister cmlister
 // This namespace will be used to read the Issuer resource.
 // In most cases, the ns parameter should be set to the namespace of the resource
 // that defines the IssuerRef (i.e. the namespace of the Certificate resource).
+// If the IssuerRef refers to an Issuer in another namespace, a ReferenceGrant
+// in that namespace must permit CertificateRequests in ns to reference it,
+// otherwise an error wrapping ErrReferenceNotPermitted is returned. Grants for
+// other kinds, such as Certificates, are not honoured.
 func (h *helperImpl) GetGenericIssuer(ref cmmeta.ObjectReference, ns string) (cmapi.GenericIssuer, error) {
 	switch ref.Kind {
 	case "", cmapi.IssuerKind:
+		if ref.Namespace != "" && ref.Namespace != ns {
+			if err := h.checkReferenceGrant(ref, ns); err != nil {
+				return nil, err
+			}
+			ns = ref.Namespace
+		}
 		return h.issuerLister.Issuers(ns).Get(ref.Name)
 	case cmapi.ClusterIssuerKind:
 		// handle edge case where the ClusterIssuerLister is not set.
@@ -72,3 +100,20 @@ func (h *helperImpl) GetGenericIssuer(ref cmmeta.ObjectReference, ns string) (cm
 		return nil, fmt.Errorf(`invalid value %q for issuerRef.kind. Must be empty, %q or %q`, ref.Kind, cmapi.IssuerKind, cmapi.ClusterIssuerKind)
 	}
 }
+
+// checkReferenceGrant returns an error if no ReferenceGrant in the namespace
+// of the referenced Issuer permits CertificateRequests in namespace ns to
+// reference it.
+func (h *helperImpl) checkReferenceGrant(ref cmmeta.ObjectReference, ns string) error {
+	if h.referenceGrantLister == nil {
+		return fmt.Errorf("%w: Gateway API support must be enabled to use ReferenceGrants", ErrReferenceNotPermitted)
+	}
+	grants, err := h.referenceGrantLister.ReferenceGrants(ref.Namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	if !referencegrant.Permits(grants, cmapi.CertificateRequestKind, ns, ref.Name) {
+		return fmt.Errorf("%w: no ReferenceGrant in namespace %q allows CertificateRequests in namespace %q to reference Issuer %q", ErrReferenceNotPermitted, ref.Namespace, ns, ref.Name)
+	}
+	return nil
+}
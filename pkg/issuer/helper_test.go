@@ -17,10 +17,13 @@ limitations under the License.
 package issuer
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -109,3 +112,93 @@ func TestGetGenericIssuer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGenericIssuerCrossNamespace(t *testing.T) {
+	sharedIssuer := gen.Issuer("shared", gen.SetIssuerNamespace("issuers"))
+	grant := &gwapi.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "issuers", Name: "team-a"},
+		Spec: gwapi.ReferenceGrantSpec{
+			From: []gwapi.ReferenceGrantFrom{
+				{Group: "cert-manager.io", Kind: "CertificateRequest", Namespace: "team-a"},
+			},
+			To: []gwapi.ReferenceGrantTo{
+				{Group: "cert-manager.io", Kind: "Issuer"},
+			},
+		},
+	}
+	certificateOnlyGrant := grant.DeepCopy()
+	certificateOnlyGrant.Spec.From[0].Kind = "Certificate"
+
+	tests := map[string]struct {
+		namespace               string
+		gwObjects               []runtime.Object
+		nilReferenceGrantLister bool
+		expected                v1.GenericIssuer
+		expectNotPermitted      bool
+	}{
+		"permitted by a ReferenceGrant": {
+			namespace: "team-a",
+			gwObjects: []runtime.Object{grant},
+			expected:  sharedIssuer,
+		},
+		"same namespace doesn't need a ReferenceGrant": {
+			namespace: "issuers",
+			expected:  sharedIssuer,
+		},
+		"no ReferenceGrant": {
+			namespace:          "team-a",
+			expectNotPermitted: true,
+		},
+		"ReferenceGrant for another namespace": {
+			namespace:          "team-b",
+			gwObjects:          []runtime.Object{grant},
+			expectNotPermitted: true,
+		},
+		"ReferenceGrant for Certificates only": {
+			namespace:          "team-a",
+			gwObjects:          []runtime.Object{certificateOnlyGrant},
+			expectNotPermitted: true,
+		},
+		"Gateway API support disabled": {
+			namespace:               "team-a",
+			gwObjects:               []runtime.Object{grant},
+			nilReferenceGrantLister: true,
+			expectNotPermitted:      true,
+		},
+	}
+
+	for name, row := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := test.Builder{
+				CertManagerObjects: []runtime.Object{sharedIssuer},
+				GWObjects:          row.gwObjects,
+			}
+			b.Init()
+			c := &helperImpl{
+				issuerLister:         b.FakeCMInformerFactory().Certmanager().V1().Issuers().Lister(),
+				clusterIssuerLister:  b.FakeCMInformerFactory().Certmanager().V1().ClusterIssuers().Lister(),
+				referenceGrantLister: b.GWShared.Gateway().V1beta1().ReferenceGrants().Lister(),
+			}
+			b.Start()
+			defer b.Stop()
+
+			if row.nilReferenceGrantLister {
+				c.referenceGrantLister = nil
+			}
+
+			actual, err := c.GetGenericIssuer(cmmeta.ObjectReference{Name: "shared", Kind: "Issuer", Namespace: "issuers"}, row.namespace)
+			if row.expectNotPermitted {
+				if !errors.Is(err, ErrReferenceNotPermitted) {
+					t.Errorf("Expected ErrReferenceNotPermitted, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %s", err)
+			}
+			if !reflect.DeepEqual(actual, row.expected) {
+				t.Errorf("Expected %#v but got %#v", row.expected, actual)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/go-logr/logr"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/internal/tracing"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
@@ -263,7 +264,7 @@ func httpClientForVcertTPP(caBundle []byte) *http.Client {
 	// Copy vcert's initialization of the HTTP client, which overrides the default timeout.
 	// https://github.com/Venafi/vcert/blob/89645a7710a7b529765274cb60dc5e28066217a1/pkg/venafi/tpp/tpp.go#L481-L513
 	return &http.Client{
-		Transport: transport,
+		Transport: tracing.NewTransport(transport),
 		Timeout:   time.Second * 30,
 	}
 }
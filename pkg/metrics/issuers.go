@@ -0,0 +1,35 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+// SetIssuerBudgetWaitingRequests sets the number of signing requests waiting
+// on the rate limit or concurrency budget of the given issuer.
+func (m *Metrics) SetIssuerBudgetWaitingRequests(kind, namespace, name string, count int) {
+	m.issuerBudgetWaitingRequests.WithLabelValues(kind, namespace, name).Set(float64(count))
+}
+
+// SetIssuerBudgetInFlightRequests sets the number of signing requests
+// currently in progress against the given issuer.
+func (m *Metrics) SetIssuerBudgetInFlightRequests(kind, namespace, name string, count int) {
+	m.issuerBudgetInFlightRequests.WithLabelValues(kind, namespace, name).Set(float64(count))
+}
+
+// RemoveIssuerBudget removes the budget metrics of the given issuer.
+func (m *Metrics) RemoveIssuerBudget(kind, namespace, name string) {
+	m.issuerBudgetWaitingRequests.DeleteLabelValues(kind, namespace, name)
+	m.issuerBudgetInFlightRequests.DeleteLabelValues(kind, namespace, name)
+}
@@ -23,6 +23,15 @@ limitations under the License.
 // acme_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // venafi_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // controller_sync_call_count{"controller"}
+// issuer_budget_waiting_requests{"issuer_kind", "issuer_namespace", "issuer_name"}
+// issuer_budget_in_flight_requests{"issuer_kind", "issuer_namespace", "issuer_name"}
+// workqueue_depth{"name"}
+// workqueue_adds_total{"name"}
+// workqueue_queue_duration_seconds{"name"}
+// workqueue_work_duration_seconds{"name"}
+// workqueue_unfinished_work_seconds{"name"}
+// workqueue_longest_running_processor_seconds{"name"}
+// workqueue_retries_total{"name"}
 package metrics
 
 import (
@@ -62,6 +71,20 @@ type Metrics struct {
 	venafiClientRequestDurationSeconds *prometheus.SummaryVec
 	controllerSyncCallCount            *prometheus.CounterVec
 	controllerSyncErrorCount           *prometheus.CounterVec
+	issuerBudgetWaitingRequests        *prometheus.GaugeVec
+	issuerBudgetInFlightRequests       *prometheus.GaugeVec
+	notificationDeadLetterCount        *prometheus.CounterVec
+
+	workqueueDepth                          *prometheus.GaugeVec
+	workqueueAdds                           *prometheus.CounterVec
+	workqueueQueueDurationSeconds           *prometheus.HistogramVec
+	workqueueWorkDurationSeconds            *prometheus.HistogramVec
+	workqueueUnfinishedWorkSeconds          *prometheus.GaugeVec
+	workqueueLongestRunningProcessorSeconds *prometheus.GaugeVec
+	workqueueRetries                        *prometheus.CounterVec
+
+	unmanagedCertificateExpiryTimeSeconds *prometheus.GaugeVec
+	unmanagedCertificateKeySizeBits       *prometheus.GaugeVec
 }
 
 var readyConditionStatuses = [...]cmmeta.ConditionStatus{cmmeta.ConditionTrue, cmmeta.ConditionFalse, cmmeta.ConditionUnknown}
@@ -184,6 +207,129 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			},
 			[]string{"controller"},
 		)
+
+		// issuerBudgetWaitingRequests is the number of signing requests that
+		// are waiting on an issuer's rate limit or concurrency budget.
+		issuerBudgetWaitingRequests = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuer_budget_waiting_requests",
+				Help:      "The number of signing requests waiting on the rate limit or concurrency budget of an issuer.",
+			},
+			[]string{"issuer_kind", "issuer_namespace", "issuer_name"},
+		)
+
+		issuerBudgetInFlightRequests = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuer_budget_in_flight_requests",
+				Help:      "The number of signing requests currently in progress against an issuer.",
+			},
+			[]string{"issuer_kind", "issuer_namespace", "issuer_name"},
+		)
+
+		// notificationDeadLetterCount is the number of events that could not
+		// be delivered to a NotificationPolicy sink after all retries.
+		notificationDeadLetterCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "notification_dead_letter_count",
+				Help:      "The number of events that could not be delivered to a NotificationPolicy sink.",
+			},
+			[]string{"policy", "sink", "type"},
+		)
+
+		// The workqueue metrics are reported for the work queue of every
+		// controller, identified by the name of the queue.
+		workqueueDepth = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "workqueue",
+				Name:      "depth",
+				Help:      "The number of items waiting in a work queue.",
+			},
+			[]string{"name"},
+		)
+
+		workqueueAdds = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "workqueue",
+				Name:      "adds_total",
+				Help:      "The number of items added to a work queue.",
+			},
+			[]string{"name"},
+		)
+
+		workqueueQueueDurationSeconds = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "workqueue",
+				Name:      "queue_duration_seconds",
+				Help:      "How long in seconds an item stays in a work queue before being processed.",
+				Buckets:   prometheus.ExponentialBuckets(10e-9, 10, 10),
+			},
+			[]string{"name"},
+		)
+
+		workqueueWorkDurationSeconds = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "workqueue",
+				Name:      "work_duration_seconds",
+				Help:      "How long in seconds processing an item from a work queue takes.",
+				Buckets:   prometheus.ExponentialBuckets(10e-9, 10, 10),
+			},
+			[]string{"name"},
+		)
+
+		workqueueUnfinishedWorkSeconds = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "workqueue",
+				Name:      "unfinished_work_seconds",
+				Help:      "The total number of seconds items from a work queue have been in progress and not yet marked as done.",
+			},
+			[]string{"name"},
+		)
+
+		workqueueLongestRunningProcessorSeconds = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "workqueue",
+				Name:      "longest_running_processor_seconds",
+				Help:      "How long in seconds the longest running item from a work queue has been in progress.",
+			},
+			[]string{"name"},
+		)
+
+		workqueueRetries = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "workqueue",
+				Name:      "retries_total",
+				Help:      "The number of retries handled by a work queue.",
+			},
+			[]string{"name"},
+		)
+
+		unmanagedCertificateExpiryTimeSeconds = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "unmanaged_certificate_expiration_timestamp_seconds",
+				Help:      "The date after which a certificate stored in a Secret or ConfigMap not managed by cert-manager expires. Expressed as a Unix Epoch Time.",
+			},
+			[]string{"kind", "namespace", "name", "key", "issuer"},
+		)
+
+		unmanagedCertificateKeySizeBits = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "unmanaged_certificate_key_size_bits",
+				Help:      "The size of the public key of a certificate stored in a Secret or ConfigMap not managed by cert-manager.",
+			},
+			[]string{"kind", "namespace", "name", "key", "key_algorithm"},
+		)
 	)
 
 	// Create server and register Prometheus metrics handler
@@ -201,6 +347,20 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 		venafiClientRequestDurationSeconds: venafiClientRequestDurationSeconds,
 		controllerSyncCallCount:            controllerSyncCallCount,
 		controllerSyncErrorCount:           controllerSyncErrorCount,
+		issuerBudgetWaitingRequests:        issuerBudgetWaitingRequests,
+		issuerBudgetInFlightRequests:       issuerBudgetInFlightRequests,
+		notificationDeadLetterCount:        notificationDeadLetterCount,
+
+		workqueueDepth:                          workqueueDepth,
+		workqueueAdds:                           workqueueAdds,
+		workqueueQueueDurationSeconds:           workqueueQueueDurationSeconds,
+		workqueueWorkDurationSeconds:            workqueueWorkDurationSeconds,
+		workqueueUnfinishedWorkSeconds:          workqueueUnfinishedWorkSeconds,
+		workqueueLongestRunningProcessorSeconds: workqueueLongestRunningProcessorSeconds,
+		workqueueRetries:                        workqueueRetries,
+
+		unmanagedCertificateExpiryTimeSeconds: unmanagedCertificateExpiryTimeSeconds,
+		unmanagedCertificateKeySizeBits:       unmanagedCertificateKeySizeBits,
 	}
 
 	return m
@@ -218,6 +378,18 @@ func (m *Metrics) NewServer(ln net.Listener) *http.Server {
 	m.registry.MustRegister(m.acmeClientRequestCount)
 	m.registry.MustRegister(m.controllerSyncCallCount)
 	m.registry.MustRegister(m.controllerSyncErrorCount)
+	m.registry.MustRegister(m.issuerBudgetWaitingRequests)
+	m.registry.MustRegister(m.issuerBudgetInFlightRequests)
+	m.registry.MustRegister(m.notificationDeadLetterCount)
+	m.registry.MustRegister(m.workqueueDepth)
+	m.registry.MustRegister(m.workqueueAdds)
+	m.registry.MustRegister(m.workqueueQueueDurationSeconds)
+	m.registry.MustRegister(m.workqueueWorkDurationSeconds)
+	m.registry.MustRegister(m.workqueueUnfinishedWorkSeconds)
+	m.registry.MustRegister(m.workqueueLongestRunningProcessorSeconds)
+	m.registry.MustRegister(m.workqueueRetries)
+	m.registry.MustRegister(m.unmanagedCertificateExpiryTimeSeconds)
+	m.registry.MustRegister(m.unmanagedCertificateKeySizeBits)
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// UpdateUnmanagedCertificate sets the metrics of a certificate stored under
+// the given key of a Secret or ConfigMap which is not managed by
+// cert-manager.
+func (m *Metrics) UpdateUnmanagedCertificate(kind, namespace, name, key string, cert *x509.Certificate) {
+	m.unmanagedCertificateExpiryTimeSeconds.With(prometheus.Labels{
+		"kind":      kind,
+		"namespace": namespace,
+		"name":      name,
+		"key":       key,
+		"issuer":    cert.Issuer.String(),
+	}).Set(float64(cert.NotAfter.Unix()))
+
+	m.unmanagedCertificateKeySizeBits.With(prometheus.Labels{
+		"kind":          kind,
+		"namespace":     namespace,
+		"name":          name,
+		"key":           key,
+		"key_algorithm": cert.PublicKeyAlgorithm.String(),
+	}).Set(float64(publicKeySize(cert.PublicKey)))
+}
+
+// RemoveUnmanagedCertificates will delete the metrics of all certificates
+// stored in the given Secret or ConfigMap from continuing to be exposed.
+func (m *Metrics) RemoveUnmanagedCertificates(kind, namespace, name string) {
+	labels := prometheus.Labels{"kind": kind, "namespace": namespace, "name": name}
+	m.unmanagedCertificateExpiryTimeSeconds.DeletePartialMatch(labels)
+	m.unmanagedCertificateKeySizeBits.DeletePartialMatch(labels)
+}
+
+// publicKeySize returns the size in bits of the given public key, or 0 if
+// the key type is not known.
+func publicKeySize(pub interface{}) int {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return pub.N.BitLen()
+	case *ecdsa.PublicKey:
+		return pub.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return len(pub) * 8
+	default:
+		return 0
+	}
+}
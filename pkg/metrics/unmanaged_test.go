@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"strings"
+	"testing"
+	"time"
+
+	logtesting "github.com/go-logr/logr/testing"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/clock"
+)
+
+const unmanagedExpiryMetadata = `
+	# HELP certmanager_unmanaged_certificate_expiration_timestamp_seconds The date after which a certificate stored in a Secret or ConfigMap not managed by cert-manager expires. Expressed as a Unix Epoch Time.
+	# TYPE certmanager_unmanaged_certificate_expiration_timestamp_seconds gauge
+`
+
+const unmanagedKeySizeMetadata = `
+	# HELP certmanager_unmanaged_certificate_key_size_bits The size of the public key of a certificate stored in a Secret or ConfigMap not managed by cert-manager.
+	# TYPE certmanager_unmanaged_certificate_key_size_bits gauge
+`
+
+func TestUnmanagedCertificateMetrics(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := &x509.Certificate{
+		Issuer:             pkix.Name{CommonName: "test-ca"},
+		NotAfter:           time.Unix(2208988804, 0),
+		PublicKeyAlgorithm: x509.ECDSA,
+		PublicKey:          &key.PublicKey,
+	}
+
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+	m.UpdateUnmanagedCertificate("Secret", "test-ns", "test-secret", "tls.crt", cert)
+	m.UpdateUnmanagedCertificate("ConfigMap", "test-ns", "test-bundle", "ca.crt", cert)
+
+	if err := testutil.CollectAndCompare(m.unmanagedCertificateExpiryTimeSeconds,
+		strings.NewReader(unmanagedExpiryMetadata+`
+	certmanager_unmanaged_certificate_expiration_timestamp_seconds{issuer="CN=test-ca",key="ca.crt",kind="ConfigMap",name="test-bundle",namespace="test-ns"} 2.208988804e+09
+	certmanager_unmanaged_certificate_expiration_timestamp_seconds{issuer="CN=test-ca",key="tls.crt",kind="Secret",name="test-secret",namespace="test-ns"} 2.208988804e+09
+`),
+		"certmanager_unmanaged_certificate_expiration_timestamp_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	if err := testutil.CollectAndCompare(m.unmanagedCertificateKeySizeBits,
+		strings.NewReader(unmanagedKeySizeMetadata+`
+	certmanager_unmanaged_certificate_key_size_bits{key="ca.crt",key_algorithm="ECDSA",kind="ConfigMap",name="test-bundle",namespace="test-ns"} 256
+	certmanager_unmanaged_certificate_key_size_bits{key="tls.crt",key_algorithm="ECDSA",kind="Secret",name="test-secret",namespace="test-ns"} 256
+`),
+		"certmanager_unmanaged_certificate_key_size_bits",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveUnmanagedCertificates("Secret", "test-ns", "test-secret")
+
+	if err := testutil.CollectAndCompare(m.unmanagedCertificateExpiryTimeSeconds,
+		strings.NewReader(unmanagedExpiryMetadata+`
+	certmanager_unmanaged_certificate_expiration_timestamp_seconds{issuer="CN=test-ca",key="ca.crt",kind="ConfigMap",name="test-bundle",namespace="test-ns"} 2.208988804e+09
+`),
+		"certmanager_unmanaged_certificate_expiration_timestamp_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	if err := testutil.CollectAndCompare(m.unmanagedCertificateKeySizeBits,
+		strings.NewReader(unmanagedKeySizeMetadata+`
+	certmanager_unmanaged_certificate_key_size_bits{key="ca.crt",key_algorithm="ECDSA",kind="ConfigMap",name="test-bundle",namespace="test-ns"} 256
+`),
+		"certmanager_unmanaged_certificate_key_size_bits",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
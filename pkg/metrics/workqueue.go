@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"k8s.io/client-go/util/workqueue"
+)
+
+var _ workqueue.MetricsProvider = &Metrics{}
+
+// NewDepthMetric returns the gauge of items waiting in the named work queue.
+func (m *Metrics) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return m.workqueueDepth.WithLabelValues(name)
+}
+
+// NewAddsMetric returns the counter of items added to the named work queue.
+func (m *Metrics) NewAddsMetric(name string) workqueue.CounterMetric {
+	return m.workqueueAdds.WithLabelValues(name)
+}
+
+// NewLatencyMetric returns the histogram of how long items stay in the named
+// work queue before being processed.
+func (m *Metrics) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return m.workqueueQueueDurationSeconds.WithLabelValues(name)
+}
+
+// NewWorkDurationMetric returns the histogram of how long processing an item
+// from the named work queue takes.
+func (m *Metrics) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return m.workqueueWorkDurationSeconds.WithLabelValues(name)
+}
+
+// NewUnfinishedWorkSecondsMetric returns the gauge of the total time items
+// from the named work queue have been in progress.
+func (m *Metrics) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return m.workqueueUnfinishedWorkSeconds.WithLabelValues(name)
+}
+
+// NewLongestRunningProcessorSecondsMetric returns the gauge of how long the
+// longest running item from the named work queue has been in progress.
+func (m *Metrics) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return m.workqueueLongestRunningProcessorSeconds.WithLabelValues(name)
+}
+
+// NewRetriesMetric returns the counter of retries handled by the named work
+// queue.
+func (m *Metrics) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return m.workqueueRetries.WithLabelValues(name)
+}
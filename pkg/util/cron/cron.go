@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cron parses standard five field cron expressions and calculates
+// the times at which they activate.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Embed the IANA time zone database so that schedules can be evaluated
+	// in any time zone, regardless of the contents of the container image.
+	_ "time/tzdata"
+)
+
+// maxSearchYears bounds how far Next and Prev search for an activation time,
+// so that schedules which can never activate (e.g. `0 0 31 2 *`) terminate.
+const maxSearchYears = 5
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow bits
+
+	// domStar and dowStar record whether the day of month and day of week
+	// fields started with `*`. If either did, a day must match both fields;
+	// otherwise a day matching either field is enough.
+	domStar, dowStar bool
+}
+
+type bits uint64
+
+func (b bits) has(i int) bool {
+	return b&(1<<uint(i)) != 0
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is accepted as an alias for Sunday.
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Parse parses a cron expression with five space separated fields: minute,
+// hour, day of month, month and day of week. Each field may be `*`, a
+// number, a range (`1-5`) or a comma separated list of these, and each may
+// be followed by a step (`*/15`, `9-17/2`).
+func Parse(spec string) (*Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("expected %d fields but got %d in %q", len(fields), len(parts), spec)
+	}
+
+	var parsed [5]bits
+	for i, f := range fields {
+		b, err := parseField(parts[i], f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s field %q: %w", f.name, parts[i], err)
+		}
+		parsed[i] = b
+	}
+
+	dow := parsed[4]
+	if dow.has(7) {
+		dow |= 1
+		dow &^= 1 << 7
+	}
+
+	return &Schedule{
+		minute:  parsed[0],
+		hour:    parsed[1],
+		dom:     parsed[2],
+		month:   parsed[3],
+		dow:     dow,
+		domStar: strings.HasPrefix(parts[2], "*"),
+		dowStar: strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+func parseField(s string, f field) (bits, error) {
+	var b bits
+	for _, item := range strings.Split(s, ",") {
+		rangeAndStep := strings.SplitN(item, "/", 2)
+
+		var start, end int
+		var err error
+		switch r := rangeAndStep[0]; {
+		case r == "*":
+			start, end = f.min, f.max
+		case strings.Contains(r, "-"):
+			bounds := strings.SplitN(r, "-", 2)
+			if start, err = parseNumber(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if end, err = parseNumber(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if end < start {
+				return 0, fmt.Errorf("range %q ends before it starts", r)
+			}
+		default:
+			if start, err = parseNumber(r, f); err != nil {
+				return 0, err
+			}
+			end = start
+			// A step without a range means "from start to the maximum".
+			if len(rangeAndStep) == 2 {
+				end = f.max
+			}
+		}
+
+		step := 1
+		if len(rangeAndStep) == 2 {
+			step, err = strconv.Atoi(rangeAndStep[1])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("step %q must be a positive integer", rangeAndStep[1])
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			b |= 1 << uint(i)
+		}
+	}
+	return b, nil
+}
+
+func parseNumber(s string, f field) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if i < f.min || i > f.max {
+		return 0, fmt.Errorf("%d is outside of the range %d-%d", i, f.min, f.max)
+	}
+	return i, nil
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom.has(t.Day())
+	dowMatch := s.dow.has(int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Next returns the first time after t at which the schedule activates,
+// evaluated in t's location. The zero time is returned if the schedule does
+// not activate within the next few years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Year() + maxSearchYears
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+wrap:
+	if t.Year() > limit {
+		return time.Time{}
+	}
+	for !s.month.has(int(t.Month())) {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+	for !s.dayMatches(t) {
+		month := t.Month()
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		if t.Month() != month {
+			goto wrap
+		}
+	}
+	for !s.hour.has(t.Hour()) {
+		day := t.Day()
+		// Add durations rather than using time.Date so that the search
+		// always moves forwards across daylight saving transitions.
+		t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute)
+		if t.Day() != day {
+			goto wrap
+		}
+	}
+	for !s.minute.has(t.Minute()) {
+		hour := t.Hour()
+		t = t.Add(time.Minute)
+		if t.Hour() != hour {
+			goto wrap
+		}
+	}
+	return t
+}
+
+// Prev returns the latest time at or before t at which the schedule
+// activates, evaluated in t's location. The zero time is returned if the
+// schedule did not activate within the last few years.
+func (s *Schedule) Prev(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Year() - maxSearchYears
+	t = t.Truncate(time.Minute)
+
+wrap:
+	if t.Year() < limit {
+		return time.Time{}
+	}
+	for !s.month.has(int(t.Month())) {
+		// Move to the last minute of the previous month.
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc).Add(-time.Minute)
+		if t.Month() == time.December {
+			goto wrap
+		}
+	}
+	for !s.dayMatches(t) {
+		month := t.Month()
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).Add(-time.Minute)
+		if t.Month() != month {
+			goto wrap
+		}
+	}
+	for !s.hour.has(t.Hour()) {
+		day := t.Day()
+		t = t.Add(-time.Duration(t.Minute()+1) * time.Minute)
+		if t.Day() != day {
+			goto wrap
+		}
+	}
+	for !s.minute.has(t.Minute()) {
+		hour := t.Hour()
+		t = t.Add(-time.Minute)
+		if t.Hour() != hour {
+			goto wrap
+		}
+	}
+	return t
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		spec    string
+		wantErr bool
+	}{
+		"every minute":           {spec: "* * * * *"},
+		"lists ranges and steps": {spec: "0,30 9-17/2 1-15 */3 1-5"},
+		"sunday as 7":            {spec: "0 0 * * 7"},
+		"too few fields":         {spec: "0 0 * *", wantErr: true},
+		"too many fields":        {spec: "0 0 * * * *", wantErr: true},
+		"minute out of range":    {spec: "60 0 * * *", wantErr: true},
+		"day of month zero":      {spec: "0 0 0 * *", wantErr: true},
+		"reversed range":         {spec: "0 17-9 * * *", wantErr: true},
+		"zero step":              {spec: "*/0 * * * *", wantErr: true},
+		"not a number":           {spec: "a * * * *", wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(test.spec)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", test.spec, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNextAndPrev(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2023-03-15 is a Wednesday.
+	wed := time.Date(2023, 3, 15, 12, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		spec     string
+		from     time.Time
+		wantNext time.Time
+		wantPrev time.Time
+	}{
+		"weekdays at 09:00": {
+			spec:     "0 9 * * 1-5",
+			from:     wed,
+			wantNext: time.Date(2023, 3, 16, 9, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2023, 3, 15, 9, 0, 0, 0, time.UTC),
+		},
+		"weekends skip forwards and backwards": {
+			spec:     "0 10 * * 6,0",
+			from:     wed,
+			wantNext: time.Date(2023, 3, 18, 10, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2023, 3, 12, 10, 0, 0, 0, time.UTC),
+		},
+		"prev includes the current minute": {
+			spec:     "30 12 * * *",
+			from:     wed,
+			wantNext: time.Date(2023, 3, 16, 12, 30, 0, 0, time.UTC),
+			wantPrev: wed,
+		},
+		"day of month or day of week": {
+			spec:     "0 0 1 * 1",
+			from:     wed,
+			wantNext: time.Date(2023, 3, 20, 0, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC),
+		},
+		"across year boundaries": {
+			spec:     "15 3 29 2 *",
+			from:     wed,
+			wantNext: time.Date(2024, 2, 29, 3, 15, 0, 0, time.UTC),
+			wantPrev: time.Date(2020, 2, 29, 3, 15, 0, 0, time.UTC),
+		},
+		"evaluated in the location of the given time": {
+			spec:     "0 9 * * *",
+			from:     time.Date(2023, 7, 1, 12, 0, 0, 0, london),
+			wantNext: time.Date(2023, 7, 2, 8, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2023, 7, 1, 8, 0, 0, 0, time.UTC),
+		},
+		"never activates": {
+			spec: "0 0 31 2 *",
+			from: wed,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, err := Parse(test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.Next(test.from); !got.Equal(test.wantNext) {
+				t.Errorf("Next() = %v, want %v", got, test.wantNext)
+			}
+			if got := s.Prev(test.from); !got.Equal(test.wantPrev) {
+				t.Errorf("Prev() = %v, want %v", got, test.wantPrev)
+			}
+		})
+	}
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package pki
 
 import (
+	"fmt"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/cron"
 )
 
 // RenewalTimeFunc is a custom function type for calculating renewal time of a certificate.
@@ -57,3 +61,95 @@ func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Dura
 	rt := metav1.NewTime(notAfter.Add(-1 * renewBefore).Truncate(time.Second))
 	return &rt
 }
+
+// RenewalTimeInWindows adjusts a renewal time so that renewal happens inside
+// one of the given renewal windows. If renewalTime is outside of every window,
+// the start of the last window opening before renewalTime is used instead.
+// If no window opens between now and renewalTime, the start of the currently
+// open window is returned (so renewal happens immediately), or otherwise the
+// start of the next window. Expiry safety always wins: if no window opens
+// before notAfter, renewalTime is returned unchanged.
+// The result only changes when a window opens or closes, and calling
+// RenewalTimeInWindows with a previously adjusted time returns the same time,
+// so the result can be stored and re-evaluated later.
+func RenewalTimeInWindows(renewalTime, notAfter, now time.Time, windows *cmapi.RenewalWindows) (time.Time, error) {
+	if windows == nil || len(windows.Windows) == 0 {
+		return renewalTime, nil
+	}
+
+	loc := time.UTC
+	if windows.TimeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(windows.TimeZone)
+		if err != nil {
+			return renewalTime, fmt.Errorf("invalid renewal window time zone %q: %w", windows.TimeZone, err)
+		}
+	}
+
+	type window struct {
+		schedule *cron.Schedule
+		duration time.Duration
+	}
+	parsed := make([]window, len(windows.Windows))
+	for i, w := range windows.Windows {
+		schedule, err := cron.Parse(w.Schedule)
+		if err != nil {
+			return renewalTime, fmt.Errorf("invalid renewal window schedule %q: %w", w.Schedule, err)
+		}
+		parsed[i] = window{schedule: schedule, duration: w.Duration.Duration}
+	}
+
+	// openSince returns the start of the most recently opened window that is
+	// still open at t, or the zero time if no window is open.
+	openSince := func(t time.Time) time.Time {
+		var since time.Time
+		for _, w := range parsed {
+			if start := w.schedule.Prev(t); !start.IsZero() && t.Before(start.Add(w.duration)) && start.After(since) {
+				since = start
+			}
+		}
+		return since
+	}
+	latestStart := func(t time.Time) time.Time {
+		var latest time.Time
+		for _, w := range parsed {
+			if start := w.schedule.Prev(t); start.After(latest) {
+				latest = start
+			}
+		}
+		return latest
+	}
+	nextStart := func(t time.Time) time.Time {
+		var next time.Time
+		for _, w := range parsed {
+			if start := w.schedule.Next(t); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+		return next
+	}
+
+	renewalTime = renewalTime.In(loc)
+	now = now.In(loc).Truncate(time.Second)
+
+	if renewalTime.After(now) {
+		if !openSince(renewalTime).IsZero() {
+			return renewalTime, nil
+		}
+		if start := latestStart(renewalTime); start.After(now) {
+			return start, nil
+		}
+	}
+
+	if since := openSince(now); !since.IsZero() {
+		return since, nil
+	}
+
+	if next := nextStart(now); !next.IsZero() && next.Before(notAfter) {
+		return next, nil
+	}
+
+	// No window opens before the certificate expires, so ignore the windows
+	// rather than letting the certificate expire.
+	return renewalTime, nil
+}
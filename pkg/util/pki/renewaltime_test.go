@@ -23,6 +23,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
 func TestRenewalTime(t *testing.T) {
@@ -91,3 +93,112 @@ func TestRenewalTime(t *testing.T) {
 		})
 	}
 }
+
+func TestRenewalTimeInWindows(t *testing.T) {
+	// 2023-03-15 is a Wednesday.
+	wed := func(hour, min int) time.Time {
+		return time.Date(2023, 3, 15, hour, min, 0, 0, time.UTC)
+	}
+	businessHours := &cmapi.RenewalWindows{
+		Windows: []cmapi.RenewalWindow{
+			{Schedule: "0 9 * * 1-5", Duration: metav1.Duration{Duration: 8 * time.Hour}},
+		},
+	}
+
+	tests := map[string]struct {
+		renewalTime, notAfter, now time.Time
+		windows                    *cmapi.RenewalWindows
+		expected                   time.Time
+		expectedErr                bool
+	}{
+		"no windows returns the renewal time": {
+			renewalTime: wed(22, 0),
+			notAfter:    wed(23, 0).AddDate(0, 1, 0),
+			now:         wed(1, 0),
+			expected:    wed(22, 0),
+		},
+		"renewal time inside a window is unchanged": {
+			renewalTime: wed(12, 0),
+			notAfter:    wed(12, 0).AddDate(0, 1, 0),
+			now:         wed(1, 0),
+			windows:     businessHours,
+			expected:    wed(12, 0),
+		},
+		"renewal time outside a window moves to the start of the last window before it": {
+			renewalTime: wed(22, 0),
+			notAfter:    wed(22, 0).AddDate(0, 1, 0),
+			now:         wed(1, 0),
+			windows:     businessHours,
+			expected:    wed(9, 0),
+		},
+		"renewal over the weekend moves back to friday": {
+			renewalTime: time.Date(2023, 3, 19, 12, 0, 0, 0, time.UTC),
+			notAfter:    time.Date(2023, 4, 19, 12, 0, 0, 0, time.UTC),
+			now:         wed(1, 0),
+			windows:     businessHours,
+			expected:    time.Date(2023, 3, 17, 9, 0, 0, 0, time.UTC),
+		},
+		"renewal at the start of the open window if no later window opens before the renewal time": {
+			renewalTime: wed(22, 0),
+			notAfter:    wed(22, 0).AddDate(0, 1, 0),
+			now:         wed(10, 30),
+			windows:     businessHours,
+			expected:    wed(9, 0),
+		},
+		"renewal at the next window if none is open": {
+			renewalTime: wed(18, 0),
+			notAfter:    wed(18, 0).AddDate(0, 1, 0),
+			now:         wed(17, 30),
+			windows:     businessHours,
+			expected:    time.Date(2023, 3, 16, 9, 0, 0, 0, time.UTC),
+		},
+		"adjusted renewal times are stable": {
+			renewalTime: wed(9, 0),
+			notAfter:    wed(22, 0).AddDate(0, 1, 0),
+			now:         wed(1, 0),
+			windows:     businessHours,
+			expected:    wed(9, 0),
+		},
+		"overdue renewal time is unchanged if the certificate would expire before the next window": {
+			renewalTime: wed(17, 0),
+			notAfter:    wed(23, 0),
+			now:         wed(17, 30),
+			windows:     businessHours,
+			expected:    wed(17, 0),
+		},
+		"future renewal time is unchanged if the certificate would expire before the next window": {
+			renewalTime: wed(20, 0),
+			notAfter:    wed(23, 0),
+			now:         wed(17, 30),
+			windows:     businessHours,
+			expected:    wed(20, 0),
+		},
+		"windows are evaluated in the configured time zone": {
+			renewalTime: wed(22, 0),
+			notAfter:    wed(22, 0).AddDate(0, 1, 0),
+			now:         wed(1, 0),
+			windows: &cmapi.RenewalWindows{
+				TimeZone: "America/New_York",
+				Windows: []cmapi.RenewalWindow{
+					{Schedule: "0 9 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+			expected: wed(13, 0),
+		},
+		"invalid schedules return an error": {
+			renewalTime: wed(22, 0),
+			windows: &cmapi.RenewalWindows{
+				Windows: []cmapi.RenewalWindow{{Schedule: "0 9 * *"}},
+			},
+			expected:    wed(22, 0),
+			expectedErr: true,
+		},
+	}
+	for n, s := range tests {
+		t.Run(n, func(t *testing.T) {
+			renewalTime, err := RenewalTimeInWindows(s.renewalTime, s.notAfter, s.now, s.windows)
+			assert.Equal(t, s.expectedErr, err != nil, "unexpected error: %v", err)
+			assert.True(t, s.expected.Equal(renewalTime), "Expected renewal time: %v got: %v", s.expected, renewalTime)
+		})
+	}
+}
@@ -19,6 +19,7 @@ package predicate
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -44,3 +45,24 @@ func CertificateNextPrivateKeySecretName(name string) Func {
 		return *crt.Status.NextPrivateKeySecretName == name
 	}
 }
+
+// CertificateIssuedBy returns a predicate that used to filter Certificates
+// to only those whose 'spec.issuerRef' references the given Issuer or
+// ClusterIssuer.
+func CertificateIssuedBy(issuer runtime.Object) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		ref := crt.Spec.IssuerRef
+		if ref.Group != "" && ref.Group != certmanager.GroupName {
+			return false
+		}
+		switch iss := issuer.(type) {
+		case *cmapi.Issuer:
+			return (ref.Kind == "" || ref.Kind == cmapi.IssuerKind) && ref.Name == iss.Name
+		case *cmapi.ClusterIssuer:
+			return ref.Kind == cmapi.ClusterIssuerKind && ref.Name == iss.Name
+		default:
+			return false
+		}
+	}
+}
@@ -26,12 +26,16 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/component-base/featuregate"
+	gwclient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
 )
 
 type pluginInitializer struct {
 	externalClient    kubernetes.Interface
+	externalCMClient  cmclient.Interface
+	externalGWClient  gwclient.Interface
 	externalInformers informers.SharedInformerFactory
 	authorizer        authorizer.Authorizer
 	featureGates      featuregate.FeatureGate
@@ -40,9 +44,11 @@ type pluginInitializer struct {
 // New creates an instance of admission plugins initializer.
 // This constructor is public with a long param list so that callers immediately know that new information can be expected
 // during compilation when they update a level.
-func New(extClientset kubernetes.Interface, extInformers informers.SharedInformerFactory, authz authorizer.Authorizer, featureGates featuregate.FeatureGate) pluginInitializer {
+func New(extClientset kubernetes.Interface, extCMClientset cmclient.Interface, extGWClientset gwclient.Interface, extInformers informers.SharedInformerFactory, authz authorizer.Authorizer, featureGates featuregate.FeatureGate) pluginInitializer {
 	return pluginInitializer{
 		externalClient:    extClientset,
+		externalCMClient:  extCMClientset,
+		externalGWClient:  extGWClientset,
 		externalInformers: extInformers,
 		authorizer:        authz,
 		featureGates:      featureGates,
@@ -61,6 +67,14 @@ func (i pluginInitializer) Initialize(plugin admission.Interface) {
 		wants.SetExternalKubeClientSet(i.externalClient)
 	}
 
+	if wants, ok := plugin.(WantsExternalCertManagerClientSet); ok {
+		wants.SetExternalCertManagerClientSet(i.externalCMClient)
+	}
+
+	if wants, ok := plugin.(WantsExternalGatewayClientSet); ok {
+		wants.SetExternalGatewayClientSet(i.externalGWClient)
+	}
+
 	if wants, ok := plugin.(WantsExternalKubeInformerFactory); ok {
 		wants.SetExternalKubeInformerFactory(i.externalInformers)
 	}
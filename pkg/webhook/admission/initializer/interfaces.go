@@ -27,7 +27,9 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/component-base/featuregate"
+	gwclient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
 )
 
@@ -37,6 +39,18 @@ type WantsExternalKubeClientSet interface {
 	admission.InitializationValidator
 }
 
+// WantsExternalCertManagerClientSet defines a function which sets external cert-manager ClientSet for admission plugins that need it
+type WantsExternalCertManagerClientSet interface {
+	SetExternalCertManagerClientSet(cmclient.Interface)
+	admission.InitializationValidator
+}
+
+// WantsExternalGatewayClientSet defines a function which sets external Gateway API ClientSet for admission plugins that need it
+type WantsExternalGatewayClientSet interface {
+	SetExternalGatewayClientSet(gwclient.Interface)
+	admission.InitializationValidator
+}
+
 // WantsExternalKubeInformerFactory defines a function which sets InformerFactory for admission plugins that need it
 type WantsExternalKubeInformerFactory interface {
 	SetExternalKubeInformerFactory(informers.SharedInformerFactory)
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/jcmturner/gofork/encoding/asn1"
+	"github.com/jcmturner/gokrb5/v8/asn1tools"
+	"github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/gssapi"
+	"github.com/jcmturner/gokrb5/v8/iana"
+	"github.com/jcmturner/gokrb5/v8/iana/asnAppTag"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/jcmturner/gokrb5/v8/types"
+	"github.com/miekg/dns"
+)
+
+const (
+	gssTSIGAlgorithm = "gss-tsig."
+	tkeyModeGSS      = 3
+)
+
+// gssAcceptor implements the acceptor side of GSS-TSIG (RFC 3645) using the
+// Kerberos V5 mechanism. It negotiates security contexts in response to TKEY
+// queries and verifies messages signed using them.
+// Responses are never signed.
+type gssAcceptor struct {
+	keytab *keytab.Keytab
+
+	lock sync.Mutex
+	// contexts maps TKEY names to the key negotiated for that context
+	contexts map[string]types.EncryptionKey
+}
+
+var _ dns.TsigProvider = &gssAcceptor{}
+
+func newGSSAcceptor(kt *keytab.Keytab) *gssAcceptor {
+	return &gssAcceptor{
+		keytab:   kt,
+		contexts: make(map[string]types.EncryptionKey),
+	}
+}
+
+// negotiate accepts the KRB5 AP-REQ token in the given TKEY record and
+// returns the TKEY record containing the AP-REP token to send in response.
+func (g *gssAcceptor) negotiate(tkey *dns.TKEY) (*dns.TKEY, error) {
+	if dns.CanonicalName(tkey.Algorithm) != gssTSIGAlgorithm || tkey.Mode != tkeyModeGSS {
+		return nil, fmt.Errorf("unsupported TKEY algorithm %q or mode %d", tkey.Algorithm, tkey.Mode)
+	}
+
+	b, err := hex.DecodeString(tkey.Key)
+	if err != nil {
+		return nil, err
+	}
+	var token spnego.KRB5Token
+	if err := token.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	if !token.IsAPReq() {
+		return nil, errors.New("TKEY does not contain an AP-REQ")
+	}
+
+	apReq := token.APReq
+	if ok, err := apReq.Verify(g.keytab, kdcMaxSkew, types.HostAddress{}, nil); !ok {
+		return nil, fmt.Errorf("invalid AP-REQ: %v", err)
+	}
+	ticketKey := apReq.Ticket.DecryptedEncPart.Key
+
+	etype, err := crypto.GetEtype(ticketKey.KeyType)
+	if err != nil {
+		return nil, err
+	}
+	subkey, err := types.GenerateEncryptionKey(etype)
+	if err != nil {
+		return nil, err
+	}
+
+	repPart, err := asn1.Marshal(messages.EncAPRepPart{
+		CTime:          apReq.Authenticator.CTime,
+		Cusec:          apReq.Authenticator.Cusec,
+		Subkey:         subkey,
+		SequenceNumber: rand.Int63n(1 << 31),
+	})
+	if err != nil {
+		return nil, err
+	}
+	encPart, err := crypto.GetEncryptedData(asn1tools.AddASNAppTag(repPart, asnAppTag.EncAPRepPart), ticketKey, keyusage.AP_REP_ENCPART, 0)
+	if err != nil {
+		return nil, err
+	}
+	apRep, err := asn1.Marshal(messages.APRep{
+		PVNO:    iana.PVNO,
+		MsgType: msgtype.KRB_AP_REP,
+		EncPart: encPart,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// gokrb5 does not support marshalling AP-REP mech tokens, so build the
+	// RFC 1964 token by hand.
+	resp, err := asn1.Marshal(gssapi.OIDKRB5.OID())
+	if err != nil {
+		return nil, err
+	}
+	resp = append(resp, 0x02, 0x00)
+	resp = append(resp, asn1tools.AddASNAppTag(apRep, asnAppTag.APREP)...)
+	resp = asn1tools.AddASNAppTag(resp, 0)
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.contexts[tkey.Hdr.Name] = subkey
+
+	return &dns.TKEY{
+		Hdr:        dns.RR_Header{Name: tkey.Hdr.Name, Rrtype: dns.TypeTKEY, Class: dns.ClassANY},
+		Algorithm:  tkey.Algorithm,
+		Mode:       tkey.Mode,
+		Inception:  uint32(time.Now().Unix()),
+		Expiration: tkey.Expiration,
+		KeySize:    uint16(len(resp)),
+		Key:        hex.EncodeToString(resp),
+	}, nil
+}
+
+// Generate implements dns.TsigProvider. Signing responses is not supported.
+func (g *gssAcceptor) Generate(_ []byte, _ *dns.TSIG) ([]byte, error) {
+	return nil, dns.ErrSecret
+}
+
+// Verify implements dns.TsigProvider, verifying the MIC token of a message
+// signed by the initiator of a negotiated context.
+func (g *gssAcceptor) Verify(msg []byte, t *dns.TSIG) error {
+	if dns.CanonicalName(t.Algorithm) != gssTSIGAlgorithm {
+		return dns.ErrKeyAlg
+	}
+
+	g.lock.Lock()
+	key, ok := g.contexts[t.Hdr.Name]
+	g.lock.Unlock()
+	if !ok {
+		return dns.ErrSecret
+	}
+
+	mac, err := hex.DecodeString(t.MAC)
+	if err != nil {
+		return err
+	}
+	var token gssapi.MICToken
+	if err := token.Unmarshal(mac, false); err != nil {
+		return err
+	}
+	token.Payload = msg
+	if ok, err := token.Verify(key, keyusage.GSSAPI_INITIATOR_SIGN); !ok {
+		if err == nil {
+			err = dns.ErrSig
+		}
+		return err
+	}
+	return nil
+}
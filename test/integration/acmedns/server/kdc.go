@@ -0,0 +1,266 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/iana"
+	"github.com/jcmturner/gokrb5/v8/iana/errorcode"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	kdcEType      = etypeID.AES256_CTS_HMAC_SHA1_96
+	kdcTicketLife = 10 * time.Hour
+	kdcMaxSkew    = 5 * time.Minute
+	kdcMaxRequest = 64 * 1024
+	kdcKeyVersion = 1
+)
+
+// KDC is an extremely basic Kerberos Key Distribution Center that issues
+// tickets over TCP for a fixed set of principals, without pre-authentication.
+// It is suitable for use when testing GSS-TSIG support of the RFC2136
+// provider.
+type KDC struct {
+	// Realm is the Kerberos realm served by this KDC.
+	Realm string
+
+	// Principals maps principal names (e.g. 'user' or 'DNS/127.0.0.1') to
+	// their passwords.
+	Principals map[string]string
+
+	keytab   *keytab.Keytab
+	listener net.Listener
+}
+
+// Run starts the KDC, binding to a random port on 127.0.0.1.
+func (k *KDC) Run(ctx context.Context) error {
+	log := logf.FromContext(ctx, "kdc")
+
+	k.keytab = keytab.New()
+	now := time.Now()
+	for name, password := range k.Principals {
+		if err := k.keytab.AddEntry(name, k.Realm, password, now, kdcKeyVersion, kdcEType); err != nil {
+			return err
+		}
+	}
+	krbtgtPassword := make([]byte, 32)
+	if _, err := rand.Read(krbtgtPassword); err != nil {
+		return err
+	}
+	if err := k.keytab.AddEntry("krbtgt/"+k.Realm, k.Realm, hex.EncodeToString(krbtgtPassword), now, kdcKeyVersion, kdcEType); err != nil {
+		return err
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	k.listener = l
+	log = log.WithValues("address", l.Addr().String())
+	log.V(logf.InfoLevel).Info("listening on TCP port")
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				log.V(logf.DebugLevel).Info("KDC exited")
+				return
+			}
+			go k.serve(log, conn)
+		}
+	}()
+
+	return nil
+}
+
+// ListenAddr returns the address the KDC is listening on.
+func (k *KDC) ListenAddr() string {
+	return k.listener.Addr().String()
+}
+
+// Shutdown stops the KDC.
+func (k *KDC) Shutdown() error {
+	return k.listener.Close()
+}
+
+// Keytab returns a keytab containing the keys of the named principals.
+func (k *KDC) Keytab(principals ...string) (*keytab.Keytab, error) {
+	kt := keytab.New()
+	for _, name := range principals {
+		password, ok := k.Principals[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown principal %q", name)
+		}
+		if err := kt.AddEntry(name, k.Realm, password, time.Now(), kdcKeyVersion, kdcEType); err != nil {
+			return nil, err
+		}
+	}
+	return kt, nil
+}
+
+func (k *KDC) serve(log logr.Logger, conn net.Conn) {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(time.Minute))
+
+	// RFC 4120 7.2.2: messages are prefixed with their length as a 4 byte big endian integer.
+	var size uint32
+	if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+		return
+	}
+	if size > kdcMaxRequest {
+		return
+	}
+	req := make([]byte, size)
+	if _, err := io.ReadFull(conn, req); err != nil {
+		return
+	}
+
+	resp, err := k.handle(req)
+	if err != nil {
+		log.V(logf.WarnLevel).Info("failed to handle KDC request", "error", err.Error())
+		return
+	}
+
+	out := make([]byte, 4, 4+len(resp))
+	binary.BigEndian.PutUint32(out, uint32(len(resp)))
+	if _, err := conn.Write(append(out, resp...)); err != nil {
+		log.V(logf.WarnLevel).Info("failed to write KDC response", "error", err.Error())
+	}
+}
+
+func (k *KDC) handle(b []byte) ([]byte, error) {
+	var asReq messages.ASReq
+	if err := asReq.Unmarshal(b); err == nil {
+		return k.handleASReq(asReq)
+	}
+	var tgsReq messages.TGSReq
+	if err := tgsReq.Unmarshal(b); err == nil {
+		return k.handleTGSReq(tgsReq)
+	}
+	return nil, errors.New("unsupported KDC request")
+}
+
+func (k *KDC) handleASReq(req messages.ASReq) ([]byte, error) {
+	cname := req.ReqBody.CName
+	clientKey, _, err := k.keytab.GetEncryptionKey(cname, k.Realm, 0, kdcEType)
+	if err != nil {
+		return k.krbError(req.ReqBody.SName, errorcode.KDC_ERR_C_PRINCIPAL_UNKNOWN, err.Error())
+	}
+
+	// only ticket granting tickets may be requested using an AS-REQ
+	if sname := req.ReqBody.SName; len(sname.NameString) != 2 || sname.NameString[0] != "krbtgt" {
+		return k.krbError(sname, errorcode.KDC_ERR_S_PRINCIPAL_UNKNOWN, "only TGTs can be requested using AS-REQ")
+	}
+	rep, encPart, err := k.newKDCRep(cname, req.ReqBody.SName, req.ReqBody.Nonce)
+	if err != nil {
+		return k.krbError(req.ReqBody.SName, errorcode.KDC_ERR_S_PRINCIPAL_UNKNOWN, err.Error())
+	}
+	rep.MsgType = msgtype.KRB_AS_REP
+	if rep.EncPart, err = crypto.GetEncryptedData(encPart, clientKey, keyusage.AS_REP_ENCPART, kdcKeyVersion); err != nil {
+		return nil, err
+	}
+
+	asRep := messages.ASRep{KDCRepFields: rep}
+	return asRep.Marshal()
+}
+
+func (k *KDC) handleTGSReq(req messages.TGSReq) ([]byte, error) {
+	var apReq messages.APReq
+	for _, pa := range req.PAData {
+		if pa.PADataType == patype.PA_TGS_REQ {
+			if err := apReq.Unmarshal(pa.PADataValue); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if ok, err := apReq.Verify(k.keytab, kdcMaxSkew, types.HostAddress{}, nil); !ok {
+		return k.krbError(req.ReqBody.SName, errorcode.KRB_AP_ERR_BAD_INTEGRITY, fmt.Sprintf("invalid TGT: %v", err))
+	}
+
+	cname := apReq.Ticket.DecryptedEncPart.CName
+	rep, encPart, err := k.newKDCRep(cname, req.ReqBody.SName, req.ReqBody.Nonce)
+	if err != nil {
+		return k.krbError(req.ReqBody.SName, errorcode.KDC_ERR_S_PRINCIPAL_UNKNOWN, err.Error())
+	}
+	rep.MsgType = msgtype.KRB_TGS_REP
+	if rep.EncPart, err = crypto.GetEncryptedData(encPart, apReq.Ticket.DecryptedEncPart.Key, keyusage.TGS_REP_ENCPART_SESSION_KEY, 0); err != nil {
+		return nil, err
+	}
+
+	tgsRep := messages.TGSRep{KDCRepFields: rep}
+	return tgsRep.Marshal()
+}
+
+// newKDCRep issues a ticket for sname to cname, returning the reply fields
+// and the marshalled (unencrypted) encrypted part of the reply.
+func (k *KDC) newKDCRep(cname, sname types.PrincipalName, nonce int) (messages.KDCRepFields, []byte, error) {
+	now := time.Now().UTC()
+	flags := types.NewKrbFlags()
+	tkt, sessionKey, err := messages.NewTicket(cname, k.Realm, sname, k.Realm, flags, k.keytab, kdcEType, kdcKeyVersion, now, now, now.Add(kdcTicketLife), now.Add(kdcTicketLife))
+	if err != nil {
+		return messages.KDCRepFields{}, nil, err
+	}
+
+	encPart := messages.EncKDCRepPart{
+		Key:       sessionKey,
+		LastReqs:  []messages.LastReq{},
+		Nonce:     nonce,
+		Flags:     flags,
+		AuthTime:  now,
+		StartTime: now,
+		EndTime:   now.Add(kdcTicketLife),
+		RenewTill: now.Add(kdcTicketLife),
+		SRealm:    k.Realm,
+		SName:     sname,
+	}
+	b, err := encPart.Marshal()
+	if err != nil {
+		return messages.KDCRepFields{}, nil, err
+	}
+
+	return messages.KDCRepFields{
+		PVNO:   iana.PVNO,
+		CRealm: k.Realm,
+		CName:  cname,
+		Ticket: tkt,
+	}, b, nil
+}
+
+func (k *KDC) krbError(sname types.PrincipalName, code int32, text string) ([]byte, error) {
+	e := messages.NewKRBError(sname, k.Realm, code, text)
+	return e.Marshal()
+}
@@ -33,7 +33,10 @@ type rfc2136Handler struct {
 	txtRecords map[string][]string
 	zones      []string
 	tsigZone   string
-	lock       sync.Mutex
+	// gss is set if GSS-TSIG is enabled, in which case unauthenticated
+	// updates are refused.
+	gss  *gssAcceptor
+	lock sync.Mutex
 }
 
 // serveDNS implements github.com/miekg/dns.Handler
@@ -46,6 +49,11 @@ func (b *rfc2136Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
 	m.SetReply(req)
 	defer w.WriteMsg(m)
 
+	if b.gss != nil && len(req.Question) > 0 && req.Question[0].Qtype == dns.TypeTKEY {
+		b.negotiateTKEY(log, req, m)
+		return
+	}
+
 	var zone string
 	if len(req.Question) > 0 {
 		question := req.Question[0].Name
@@ -59,7 +67,13 @@ func (b *rfc2136Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
 		log = log.WithValues("zone", zone)
 	}
 
-	if t := req.IsTsig(); t != nil {
+	if b.gss != nil {
+		if req.Opcode == dns.OpcodeUpdate && (req.IsTsig() == nil || w.TsigStatus() != nil) {
+			log.V(logf.WarnLevel).Info("refusing update without valid GSS-TSIG signature", "tsigStatus", w.TsigStatus())
+			m.Rcode = dns.RcodeNotAuth
+			return
+		}
+	} else if t := req.IsTsig(); t != nil {
 		log.V(logf.DebugLevel).Info("TSIG requested on DNS request")
 		if w.TsigStatus() == nil {
 			log.V(logf.DebugLevel).Info("setting TSIG values on response")
@@ -101,6 +115,25 @@ func (b *rfc2136Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
 	}
 }
 
+func (b *rfc2136Handler) negotiateTKEY(log logr.Logger, req, m *dns.Msg) {
+	for _, rr := range req.Extra {
+		tkey, ok := rr.(*dns.TKEY)
+		if !ok {
+			continue
+		}
+		resp, err := b.gss.negotiate(tkey)
+		if err != nil {
+			log.V(logf.WarnLevel).Info("failed to negotiate GSS-TSIG context", "error", err.Error())
+			m.Rcode = dns.RcodeRefused
+			return
+		}
+		log.V(logf.DebugLevel).Info("negotiated GSS-TSIG context", "name", tkey.Hdr.Name)
+		m.Answer = []dns.RR{resp}
+		return
+	}
+	m.Rcode = dns.RcodeFormatError
+}
+
 func (b *rfc2136Handler) zoneForFQDN(s string) string {
 	for _, z := range b.zones {
 		if dns.IsSubDomain(z, s) {
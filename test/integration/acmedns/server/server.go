@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/jcmturner/gokrb5/v8/keytab"
 	"github.com/miekg/dns"
 
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -51,8 +52,18 @@ type BasicServer struct {
 	// TSIGZone is the DNS zone that should be used in TSIG responses
 	TSIGZone string
 
+	// GSS-TSIG configuration options
+	// EnableGSSTSIG enables GSS-TSIG (RFC 3645) support for the DNS server.
+	// If true, GSSTSIGKeytab must be provided, a TCP listener is started on
+	// the same port as the UDP one and unauthenticated updates are refused.
+	EnableGSSTSIG bool
+	// GSSTSIGKeytab contains the key of the DNS/<host> service principal
+	// used to accept GSS-TSIG security contexts.
+	GSSTSIGKeytab *keytab.Keytab
+
 	listenAddr string
 	server     *dns.Server
+	tcpServer  *dns.Server
 }
 
 // Run starts the test DNS server, binding to a random port on 127.0.0.1
@@ -82,16 +93,42 @@ func (b *BasicServer) RunWithAddress(ctx context.Context, listenAddr string) err
 		b.server.TsigSecret = map[string]string{b.TSIGKeyName: b.TSIGKeySecret}
 	}
 
+	var gss *gssAcceptor
+	if b.EnableGSSTSIG {
+		log.V(logf.DebugLevel).Info("enabling GSS-TSIG support")
+		gss = newGSSAcceptor(b.GSSTSIGKeytab)
+		b.server.TsigProvider = gss
+	}
+
 	if b.Handler == nil {
 		b.Handler = &rfc2136Handler{
 			log:        log,
 			txtRecords: make(map[string][]string),
 			zones:      b.Zones,
 			tsigZone:   b.TSIGZone,
+			gss:        gss,
 		}
 	}
 	b.server.Handler = b.Handler
 
+	if b.EnableGSSTSIG {
+		// GSS-TSIG clients negotiate contexts and send signed updates over TCP
+		l, err := net.Listen("tcp", b.listenAddr)
+		if err != nil {
+			pc.Close()
+			return err
+		}
+		log.V(logf.InfoLevel).Info("listening on TCP port")
+		b.tcpServer = &dns.Server{Listener: l, ReadTimeout: time.Hour, WriteTimeout: time.Hour, MsgAcceptFunc: msgAcceptFunc, TsigProvider: gss, Handler: b.Handler}
+		tcpStarted := make(chan struct{})
+		b.tcpServer.NotifyStartedFunc = func() { close(tcpStarted) }
+		go func() {
+			b.tcpServer.ActivateAndServe()
+			l.Close()
+		}()
+		<-tcpStarted
+	}
+
 	// Start the DNS server in a separate goroutine and wait for it to start
 	waitLock := sync.Mutex{}
 	waitLock.Lock()
@@ -113,6 +150,11 @@ func (b *BasicServer) ListenAddr() string {
 }
 
 func (b *BasicServer) Shutdown() error {
+	if b.tcpServer != nil {
+		if err := b.tcpServer.Shutdown(); err != nil {
+			return err
+		}
+	}
 	return b.server.Shutdown()
 }
 
@@ -80,7 +80,10 @@ func TestTriggerController(t *testing.T) {
 		Recorder:     framework.NewEventRecorder(t),
 		FieldManager: "cert-manager-certificates-trigger-test",
 	}
-	ctrl, queue, mustSync := trigger.NewController(logf.Log, controllerContext, shouldReissue)
+	ctrl, queue, mustSync, err := trigger.NewController(logf.Log, controllerContext, shouldReissue)
+	if err != nil {
+		t.Fatal(err)
+	}
 	c := controllerpkg.NewController(
 		ctx,
 		"trigger_test",
@@ -186,7 +189,10 @@ func TestTriggerController_RenewNearExpiry(t *testing.T) {
 		FieldManager: "cert-manager-certificates-trigger-test",
 	}
 	// Start the trigger controller
-	ctrl, queue, mustSync := trigger.NewController(logf.Log, controllerContext, shoudReissue)
+	ctrl, queue, mustSync, err := trigger.NewController(logf.Log, controllerContext, shoudReissue)
+	if err != nil {
+		t.Fatal(err)
+	}
 	c := controllerpkg.NewController(
 		logf.NewContext(ctx, logf.Log, "trigger_controller_RenewNearExpiry"),
 		"trigger_test",
@@ -282,7 +288,10 @@ func TestTriggerController_ExpBackoff(t *testing.T) {
 	}
 
 	// Start the trigger controller
-	ctrl, queue, mustSync := trigger.NewController(logf.Log, controllerContext, shoudReissue)
+	ctrl, queue, mustSync, err := trigger.NewController(logf.Log, controllerContext, shoudReissue)
+	if err != nil {
+		t.Fatal(err)
+	}
 	c := controllerpkg.NewController(
 		logf.NewContext(ctx, logf.Log, "trigger_controller_RenewNearExpiry"),
 		"trigger_test",
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package server implements an extremely basic stand-in for the PowerDNS
+// authoritative server HTTP API, supporting only listing zones, getting a
+// zone and patching its RRsets.
+// It is suitable for use when testing the PowerDNS DNS01 provider.
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const defaultServerID = "localhost"
+
+type BasicServer struct {
+	// Zones is a list of DNS zones that this server should accept updates
+	// for.
+	Zones []string
+
+	// APIKey is the key that must be presented in the X-API-Key header.
+	APIKey string
+
+	// ServerID is the ID of the PowerDNS server. Defaults to 'localhost'.
+	ServerID string
+
+	lock sync.Mutex
+	// rrsets maps zone name to a map of RRset name to TXT record contents.
+	rrsets map[string]map[string][]string
+	// patches counts the number of PATCH requests received.
+	patches int
+
+	server *httptest.Server
+}
+
+type zone struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	RRSets []rrSet `json:"rrsets,omitempty"`
+}
+
+type rrSet struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	TTL        int      `json:"ttl,omitempty"`
+	ChangeType string   `json:"changetype,omitempty"`
+	Records    []record `json:"records"`
+}
+
+type record struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+// Run starts the test PowerDNS API server, binding to a random port on
+// 127.0.0.1.
+func (b *BasicServer) Run() {
+	if b.ServerID == "" {
+		b.ServerID = defaultServerID
+	}
+	b.rrsets = make(map[string]map[string][]string)
+	for _, z := range b.Zones {
+		b.rrsets[z] = make(map[string][]string)
+	}
+	b.server = httptest.NewServer(http.HandlerFunc(b.handle))
+}
+
+// URL returns the base URL of the server, without the '/api/v1' prefix.
+func (b *BasicServer) URL() string {
+	return b.server.URL
+}
+
+func (b *BasicServer) Shutdown() {
+	b.server.Close()
+}
+
+// TXTRecords returns the (quoted) content of the TXT RRset with the given
+// name in the given zone.
+func (b *BasicServer) TXTRecords(zoneName, name string) []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return append([]string(nil), b.rrsets[zoneName][name]...)
+}
+
+// Patches returns the number of PATCH requests that have been received.
+func (b *BasicServer) Patches() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.patches
+}
+
+func (b *BasicServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-API-Key") != b.APIKey {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	prefix := "/api/v1/servers/" + b.ServerID + "/zones"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	zoneID := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	switch {
+	case zoneID == "" && r.Method == http.MethodGet:
+		var zones []zone
+		for _, z := range b.Zones {
+			zones = append(zones, zone{ID: z, Name: z})
+		}
+		writeJSON(w, http.StatusOK, zones)
+	case r.Method == http.MethodGet:
+		rrsets, ok := b.rrsets[zoneID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Could not find domain '"+zoneID+"'")
+			return
+		}
+		z := zone{ID: zoneID, Name: zoneID}
+		for name, contents := range rrsets {
+			rrset := rrSet{Name: name, Type: "TXT", TTL: 60}
+			for _, c := range contents {
+				rrset.Records = append(rrset.Records, record{Content: c})
+			}
+			z.RRSets = append(z.RRSets, rrset)
+		}
+		sort.Slice(z.RRSets, func(i, j int) bool { return z.RRSets[i].Name < z.RRSets[j].Name })
+		writeJSON(w, http.StatusOK, z)
+	case r.Method == http.MethodPatch:
+		rrsets, ok := b.rrsets[zoneID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Could not find domain '"+zoneID+"'")
+			return
+		}
+		var patch struct {
+			RRSets []rrSet `json:"rrsets"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, rrset := range patch.RRSets {
+			if rrset.Type != "TXT" || !strings.HasSuffix(rrset.Name, zoneID) {
+				writeError(w, http.StatusUnprocessableEntity, "RRset "+rrset.Name+" IN "+rrset.Type+": Name is out of zone")
+				return
+			}
+			switch rrset.ChangeType {
+			case "REPLACE":
+				var contents []string
+				for _, rec := range rrset.Records {
+					if !strings.HasPrefix(rec.Content, `"`) || !strings.HasSuffix(rec.Content, `"`) {
+						writeError(w, http.StatusUnprocessableEntity, "Record "+rrset.Name+"/TXT '"+rec.Content+"': Parsing record content failed")
+						return
+					}
+					contents = append(contents, rec.Content)
+				}
+				rrsets[rrset.Name] = contents
+			case "DELETE":
+				delete(rrsets, rrset.Name)
+			default:
+				writeError(w, http.StatusUnprocessableEntity, "Changetype not understood")
+				return
+			}
+		}
+		b.patches++
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
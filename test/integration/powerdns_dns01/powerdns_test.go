@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerdns
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	testserver "github.com/cert-manager/cert-manager/integration-tests/powerdns/server"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
+)
+
+const (
+	testZone    = "example.com."
+	testFqdn    = "_acme-challenge.example.com."
+	testAPIKey  = "secret-api-key"
+	testSubZone = "sub.example.com."
+)
+
+func newServer(t *testing.T) *testserver.BasicServer {
+	server := &testserver.BasicServer{
+		Zones:  []string{testZone, testSubZone},
+		APIKey: testAPIKey,
+	}
+	server.Run()
+	t.Cleanup(server.Shutdown)
+	return server
+}
+
+func TestPresentAndCleanUp(t *testing.T) {
+	server := newServer(t)
+	p, err := powerdns.NewDNSProviderCredentials(server.URL(), testAPIKey, "", "cert-manager-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Present("example.com", testFqdn, "value1"); err != nil {
+		t.Fatalf("unexpected error presenting record: %v", err)
+	}
+	if err := p.Present("*.example.com", testFqdn, "value2"); err != nil {
+		t.Fatalf("unexpected error presenting second record: %v", err)
+	}
+	// presenting the same value twice should not modify the RRset
+	patches := server.Patches()
+	if err := p.Present("example.com", testFqdn, "value1"); err != nil {
+		t.Fatalf("unexpected error re-presenting record: %v", err)
+	}
+	if server.Patches() != patches {
+		t.Errorf("expected no PATCH request when the value is already present")
+	}
+
+	if got, exp := server.TXTRecords(testZone, testFqdn), []string{`"value1"`, `"value2"`}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("unexpected TXT records, exp=%v got=%v", exp, got)
+	}
+
+	if err := p.CleanUp("example.com", testFqdn, "value1"); err != nil {
+		t.Fatalf("unexpected error cleaning up record: %v", err)
+	}
+	if got, exp := server.TXTRecords(testZone, testFqdn), []string{`"value2"`}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("unexpected TXT records, exp=%v got=%v", exp, got)
+	}
+
+	if err := p.CleanUp("*.example.com", testFqdn, "value2"); err != nil {
+		t.Fatalf("unexpected error cleaning up second record: %v", err)
+	}
+	if got := server.TXTRecords(testZone, testFqdn); len(got) != 0 {
+		t.Errorf("expected RRset to be deleted, got %v", got)
+	}
+
+	// cleaning up a record that no longer exists should succeed
+	if err := p.CleanUp("example.com", testFqdn, "value1"); err != nil {
+		t.Errorf("unexpected error cleaning up removed record: %v", err)
+	}
+}
+
+func TestPresentConcurrent(t *testing.T) {
+	server := newServer(t)
+	p, err := powerdns.NewDNSProviderCredentials(server.URL(), testAPIKey, "localhost", "cert-manager-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected []string
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		value := fmt.Sprintf("value%d", i)
+		expected = append(expected, fmt.Sprintf("%q", value))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- p.Present("example.com", testFqdn, value)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error presenting record: %v", err)
+		}
+	}
+
+	got := server.TXTRecords(testZone, testFqdn)
+	sort.Strings(got)
+	sort.Strings(expected)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected TXT records, exp=%v got=%v", expected, got)
+	}
+}
+
+func TestPresentUsesMostSpecificZone(t *testing.T) {
+	server := newServer(t)
+	p, err := powerdns.NewDNSProviderCredentials(server.URL(), testAPIKey, "", "cert-manager-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fqdn := "_acme-challenge.www.sub.example.com."
+	if err := p.Present("www.sub.example.com", fqdn, "value"); err != nil {
+		t.Fatalf("unexpected error presenting record: %v", err)
+	}
+	if got := server.TXTRecords(testSubZone, fqdn); !reflect.DeepEqual(got, []string{`"value"`}) {
+		t.Errorf("expected record to be created in %s, got %v", testSubZone, got)
+	}
+	if got := server.TXTRecords(testZone, fqdn); len(got) != 0 {
+		t.Errorf("expected no record to be created in %s, got %v", testZone, got)
+	}
+}
+
+func TestInvalidAPIKey(t *testing.T) {
+	server := newServer(t)
+	p, err := powerdns.NewDNSProviderCredentials(server.URL(), "wrong", "", "cert-manager-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Present("example.com", testFqdn, "value"); err == nil {
+		t.Errorf("expected error when using an invalid API key")
+	}
+}
@@ -104,3 +104,36 @@ func TestRunSuiteNoTSIG(t *testing.T) {
 
 	fixture.RunConformance(t)
 }
+
+func TestRunSuiteWithGSSTSIG(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	kdc, server := newGSSTSIGTestServers(t, ctx)
+
+	var validConfig = cmacme.ACMEIssuerDNS01ProviderRFC2136{
+		Nameserver: server.ListenAddr(),
+		GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+			Realm:    rfc2136TestRealm,
+			Username: rfc2136TestUsername,
+			PasswordSecretRef: &cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{
+					Name: "gss-tsig-credentials",
+				},
+				Key: "password",
+			},
+			KDCs: []string{kdc.ListenAddr()},
+		},
+	}
+
+	fixture := dns.NewFixture(rfc2136.New(rfc2136.InitializeResetLister()),
+		dns.SetResolvedZone(rfc2136TestZone),
+		dns.SetResolvedFQDN(rfc2136TestFqdn),
+		dns.SetAllowAmbientCredentials(false),
+		dns.SetConfig(validConfig),
+		dns.SetDNSServer(server.ListenAddr()),
+		dns.SetManifestPath("testdata"),
+		// Disable recursive NS lookups as we run a single authoritative NS per test
+		dns.SetUseAuthoritative(false),
+	)
+
+	fixture.RunConformance(t)
+}
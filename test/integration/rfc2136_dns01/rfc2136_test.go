@@ -47,9 +47,17 @@ var (
 	rfc2136TestTsigKeyName = "example.com."
 	rfc2136TestTTL         = 60
 	rfc2136TestTsigSecret  = "IwBTJx9wrDp4Y1RyC3H0gA=="
+	rfc2136TestRealm       = "EXAMPLE.COM"
+	rfc2136TestUsername    = "cert-manager"
+	rfc2136TestPassword    = "cert-manager-password"
 )
 
-const defaultPort = "53"
+const (
+	defaultPort = "53"
+	// testserverIP is the address test DNS servers listen on, used to build
+	// the GSS-TSIG service principal name.
+	testserverIP = "127.0.0.1"
+)
 
 func TestRFC2136CanaryLocalTestServer(t *testing.T) {
 	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
@@ -379,3 +387,141 @@ func (o *testHandlers) serverHandlerReturnErr(w dns.ResponseWriter, req *dns.Msg
 		assert.NoError(o.t, err)
 	}
 }
+
+func newGSSTSIGTestServers(t *testing.T, ctx context.Context) (*testserver.KDC, *testserver.BasicServer) {
+	kdc := &testserver.KDC{
+		Realm: rfc2136TestRealm,
+		Principals: map[string]string{
+			rfc2136TestUsername:   rfc2136TestPassword,
+			"DNS/" + testserverIP: "dns-service-password",
+		},
+	}
+	if err := kdc.Run(ctx); err != nil {
+		t.Fatalf("failed to start test KDC: %v", err)
+	}
+	t.Cleanup(func() { assert.NoError(t, kdc.Shutdown()) })
+
+	serviceKeytab, err := kdc.Keytab("DNS/" + testserverIP)
+	require.NoError(t, err)
+
+	server := &testserver.BasicServer{
+		Zones:         []string{rfc2136TestZone},
+		EnableGSSTSIG: true,
+		GSSTSIGKeytab: serviceKeytab,
+	}
+	if err := server.Run(ctx); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	t.Cleanup(func() { assert.NoError(t, server.Shutdown()) })
+
+	return kdc, server
+}
+
+func TestRFC2136GSSTSIGPassword(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	kdc, server := newGSSTSIGTestServers(t, ctx)
+
+	provider, err := rfc2136.NewDNSProviderGSSTSIG(server.ListenAddr(), rfc2136.GSSTSIGCredentials{
+		Realm:    rfc2136TestRealm,
+		Username: rfc2136TestUsername,
+		Password: rfc2136TestPassword,
+		KDCs:     []string{kdc.ListenAddr()},
+	})
+	require.NoError(t, err)
+
+	if err := provider.Present(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue); err != nil {
+		t.Fatalf("Expected Present() to return no error but the error was -> %v", err)
+	}
+	assertTXTRecord(t, server, rfc2136TestFqdn, rfc2136TestValue)
+
+	if err := provider.CleanUp(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue); err != nil {
+		t.Fatalf("Expected CleanUp() to return no error but the error was -> %v", err)
+	}
+	assertTXTRecord(t, server, rfc2136TestFqdn)
+}
+
+func TestRFC2136GSSTSIGKeytab(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	kdc, server := newGSSTSIGTestServers(t, ctx)
+
+	kt, err := kdc.Keytab(rfc2136TestUsername)
+	require.NoError(t, err)
+	ktBytes, err := kt.Marshal()
+	require.NoError(t, err)
+
+	provider, err := rfc2136.NewDNSProviderGSSTSIG(server.ListenAddr(), rfc2136.GSSTSIGCredentials{
+		Realm:    rfc2136TestRealm,
+		Username: rfc2136TestUsername,
+		Keytab:   ktBytes,
+		KDCs:     []string{kdc.ListenAddr()},
+	})
+	require.NoError(t, err)
+
+	if err := provider.Present(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue); err != nil {
+		t.Fatalf("Expected Present() to return no error but the error was -> %v", err)
+	}
+	assertTXTRecord(t, server, rfc2136TestFqdn, rfc2136TestValue)
+}
+
+func TestRFC2136GSSTSIGInvalidPassword(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	kdc, server := newGSSTSIGTestServers(t, ctx)
+
+	provider, err := rfc2136.NewDNSProviderGSSTSIG(server.ListenAddr(), rfc2136.GSSTSIGCredentials{
+		Realm:    rfc2136TestRealm,
+		Username: rfc2136TestUsername,
+		Password: "wrong",
+		KDCs:     []string{kdc.ListenAddr()},
+	})
+	require.NoError(t, err)
+
+	if err := provider.Present(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue); err == nil {
+		t.Errorf("Expected Present() to return an error when using an invalid password")
+	}
+	assertTXTRecord(t, server, rfc2136TestFqdn)
+}
+
+func TestRFC2136GSSTSIGUnsignedUpdateRefused(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	_, server := newGSSTSIGTestServers(t, ctx)
+
+	provider, err := rfc2136.NewDNSProviderCredentials(server.ListenAddr(), "", "", "")
+	require.NoError(t, err)
+
+	if err := provider.Present(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue); err == nil {
+		t.Errorf("Expected Present() to return an error for an unsigned update")
+	} else if !strings.Contains(err.Error(), "NOTAUTH") {
+		t.Errorf("Expected Present() to return an error with the 'NOTAUTH' rcode string but got: %v", err)
+	}
+}
+
+func TestRFC2136GSSTSIGMissingCredentials(t *testing.T) {
+	_, err := rfc2136.NewDNSProviderGSSTSIG("127.0.0.1", rfc2136.GSSTSIGCredentials{
+		Realm:    rfc2136TestRealm,
+		Username: rfc2136TestUsername,
+	})
+	assert.Error(t, err)
+
+	_, err = rfc2136.NewDNSProviderGSSTSIG("127.0.0.1", rfc2136.GSSTSIGCredentials{
+		Realm:    rfc2136TestRealm,
+		Username: rfc2136TestUsername,
+		Password: rfc2136TestPassword,
+		Keytab:   []byte("keytab"),
+	})
+	assert.Error(t, err)
+}
+
+// assertTXTRecord asserts that the TXT record with the given name served by
+// the test server has exactly the given values.
+func assertTXTRecord(t *testing.T, server *testserver.BasicServer, fqdn string, values ...string) {
+	m := new(dns.Msg)
+	m.SetQuestion(fqdn, dns.TypeTXT)
+	r, _, err := new(dns.Client).Exchange(m, server.ListenAddr())
+	require.NoError(t, err)
+
+	var got []string
+	for _, rr := range r.Answer {
+		got = append(got, rr.(*dns.TXT).Txt...)
+	}
+	assert.ElementsMatch(t, values, got)
+}
@@ -278,3 +278,21 @@ func SetCertificateAdditionalOutputFormats(additionalOutputFormats ...v1.Certifi
 		crt.Spec.AdditionalOutputFormats = additionalOutputFormats
 	}
 }
+
+func SetCertificateSuspend(suspend bool) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.Suspend = suspend
+	}
+}
+
+func SetCertificateRenewalWindows(rw *v1.RenewalWindows) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.RenewalWindows = rw
+	}
+}
+
+func SetCertificateHistory(history ...v1.CertificateHistoryEntry) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.History = history
+	}
+}
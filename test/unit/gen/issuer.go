@@ -385,3 +385,9 @@ func SetIssuerNamespace(namespace string) IssuerModifier {
 		iss.GetObjectMeta().Namespace = namespace
 	}
 }
+
+func SetIssuerRenewalWindows(rw *v1.RenewalWindows) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().RenewalWindows = rw
+	}
+}